@@ -0,0 +1,283 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+var (
+	optServices     []string
+	optServicesFile string
+	optParallelism  int
+)
+
+// allCmd is the command that generates the APIs and controllers of several
+// AWS services at once
+var allCmd = &cobra.Command{
+	Use:   "all",
+	Short: "Generate the Kubernetes API type definitions and the controllers of several AWS services",
+	RunE:  generateAll,
+}
+
+func init() {
+	allCmd.PersistentFlags().StringSliceVar(
+		&optServices, "services", nil, "Comma-separated aliases of the AWS services to generate. The code of each service is written to its directory in --services-dir, using the generator.yaml file found there",
+	)
+	allCmd.PersistentFlags().StringVar(
+		&optServicesFile, "services-file", "", "Path to a YAML file listing the services to generate, with their optional output directory and generator configuration file",
+	)
+	allCmd.PersistentFlags().IntVar(
+		&optParallelism, "parallelism", runtime.NumCPU(), "Maximum number of services generated at the same time",
+	)
+	allCmd.PersistentFlags().StringVar(
+		&optGenVersion, "version", "v1alpha1", "the resource API Version to use when generating API infrastructure and type definitions",
+	)
+	rootCmd.AddCommand(allCmd)
+}
+
+// servicesManifest is the file supplied with --services-file:
+//
+//   services:
+//   - name: ecr
+//   - name: s3
+//     output: ../s3-controller
+//     generator_config_path: ../s3-controller/generator.yaml
+//
+// Relative paths are relative to the directory of the manifest file.
+type servicesManifest struct {
+	Services []serviceTarget `json:"services"`
+}
+
+// serviceTarget is a service to generate the code of
+type serviceTarget struct {
+	// Name is the service alias
+	Name string `json:"name"`
+	// Output is the directory the code is written to. Defaults to the
+	// service's directory in --services-dir.
+	Output string `json:"output,omitempty"`
+	// GeneratorConfigPath is the path of the generator configuration file.
+	// Defaults to the generator.yaml file of the output directory.
+	GeneratorConfigPath string `json:"generator_config_path,omitempty"`
+}
+
+// serviceResult is the outcome of the generation of a service's code
+type serviceResult struct {
+	target   serviceTarget
+	files    int
+	duration time.Duration
+	err      error
+	// output collects what is printed while generating the service's code,
+	// i.e. the generated files with --dry-run or their diff with --check
+	output bytes.Buffer
+}
+
+// generateAll generates the APIs and the controllers of all the services
+// supplied with --services or --services-file. The services are generated
+// concurrently and a failure to generate a service doesn't stop the others.
+func generateAll(cmd *cobra.Command, args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("please specify the services with --services or --services-file")
+	}
+	if optOutputPath != "" || optGeneratorConfigPath != "" {
+		return fmt.Errorf(
+			"--output and --generator-config-path cannot be used to generate several services, use --services-file instead",
+		)
+	}
+	if optParallelism < 1 {
+		return fmt.Errorf("--parallelism must be at least 1")
+	}
+	targets, err := getServiceTargets()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := contextWithSigterm(context.Background())
+	defer cancel()
+	sdkHelper, err := newSDKHelper(ctx)
+	if err != nil {
+		return err
+	}
+
+	results := make([]*serviceResult, len(targets))
+	sem := make(chan struct{}, optParallelism)
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		results[i] = &serviceResult{target: target}
+		wg.Add(1)
+		go func(res *serviceResult) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			start := time.Now()
+			defer func() {
+				res.duration = time.Since(start)
+				// A service whose model or configuration crashes the
+				// generator must not stop the generation of the others
+				if r := recover(); r != nil {
+					res.err = fmt.Errorf("panic: %v", r)
+				}
+			}()
+			// Each service gets its own SDKHelper, which caches the API
+			// version of the first service it loads
+			res.files, res.err = generateService(
+				sdkHelper.Copy(), res.target, &res.output,
+			)
+		}(results[i])
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, res := range results {
+		io.Copy(os.Stdout, &res.output)
+		if res.err != nil {
+			failed++
+		}
+	}
+	printServiceResults(os.Stdout, results)
+	if failed > 0 {
+		return fmt.Errorf("failed to generate %d of %d service(s)", failed, len(results))
+	}
+	return nil
+}
+
+// getServiceTargets returns the services to generate, read from --services or
+// --services-file
+func getServiceTargets() ([]serviceTarget, error) {
+	var targets []serviceTarget
+	if optServicesFile != "" {
+		if len(optServices) != 0 {
+			return nil, fmt.Errorf("--services and --services-file cannot be used together")
+		}
+		content, err := ioutil.ReadFile(optServicesFile)
+		if err != nil {
+			return nil, err
+		}
+		manifest := servicesManifest{}
+		if err = yaml.Unmarshal(content, &manifest); err != nil {
+			return nil, fmt.Errorf("cannot decode %s: %v", optServicesFile, err)
+		}
+		baseDir := filepath.Dir(optServicesFile)
+		for _, target := range manifest.Services {
+			if target.Output != "" && !filepath.IsAbs(target.Output) {
+				target.Output = filepath.Join(baseDir, target.Output)
+			}
+			if target.GeneratorConfigPath != "" && !filepath.IsAbs(target.GeneratorConfigPath) {
+				target.GeneratorConfigPath = filepath.Join(baseDir, target.GeneratorConfigPath)
+			}
+			targets = append(targets, target)
+		}
+	} else {
+		for _, svcAlias := range optServices {
+			targets = append(targets, serviceTarget{Name: svcAlias})
+		}
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("please specify the services with --services or --services-file")
+	}
+
+	seen := map[string]bool{}
+	for i := range targets {
+		target := &targets[i]
+		target.Name = strings.ToLower(strings.TrimSpace(target.Name))
+		if target.Name == "" {
+			return nil, fmt.Errorf("a service alias cannot be empty")
+		}
+		if target.Output == "" {
+			target.Output = filepath.Join(optServicesDir, target.Name)
+		}
+		if target.GeneratorConfigPath == "" {
+			target.GeneratorConfigPath = filepath.Join(target.Output, "generator.yaml")
+		}
+		if seen[target.Output] {
+			return nil, fmt.Errorf("several services are generated in %s", target.Output)
+		}
+		seen[target.Output] = true
+	}
+	return targets, nil
+}
+
+// generateService generates the APIs and the controller of a service, like
+// the apis and controller commands do, and returns the number of generated
+// files. Anything printed is written to the supplied writer.
+func generateService(
+	sdkHelper *ackmodel.SDKHelper,
+	target serviceTarget,
+	w io.Writer,
+) (int, error) {
+	if _, err := os.Stat(target.GeneratorConfigPath); err != nil {
+		return 0, fmt.Errorf("cannot read generator configuration file: %v", err)
+	}
+	sdkAPI, err := sdkHelper.API(target.Name)
+	if err != nil {
+		newSvcAlias, err := FallBackFindServiceID(sdkHelper.ModelsPath(), target.Name)
+		if err != nil {
+			return 0, err
+		}
+		sdkAPI, err = sdkHelper.API(newSvcAlias) // retry with serviceID
+		if err != nil {
+			return 0, fmt.Errorf("service %s not found", target.Name)
+		}
+	}
+
+	files, err := generateServiceAPIs(
+		sdkAPI, target.Output, target.GeneratorConfigPath, w,
+	)
+	if err != nil {
+		return files, err
+	}
+	controllerFiles, err := generateServiceController(
+		sdkAPI, target.Output, target.GeneratorConfigPath, w,
+	)
+	return files + controllerFiles, err
+}
+
+// printServiceResults prints a summary line for each generated service
+func printServiceResults(w io.Writer, results []*serviceResult) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "SERVICE\tSTATUS\tFILES\tDURATION\tOUTPUT")
+	for _, res := range results {
+		status := "ok"
+		if res.err != nil {
+			status = "failed"
+		}
+		fmt.Fprintf(
+			tw, "%s\t%s\t%d\t%s\t%s\n",
+			res.target.Name, status, res.files,
+			res.duration.Round(time.Millisecond), res.target.Output,
+		)
+	}
+	tw.Flush()
+	for _, res := range results {
+		if res.err != nil {
+			fmt.Fprintf(w, "%s: %v\n", res.target.Name, res.err)
+		}
+	}
+}
@@ -16,7 +16,8 @@ package command
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
+	"io"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -39,15 +40,13 @@ const (
 var (
 	optGenVersion    string
 	optAPIsInputPath string
-	apisVersionPath  string
 )
 
 // apiCmd is the command that generates service API types
 var apisCmd = &cobra.Command{
-	Use:      "apis <service>",
-	Short:    "Generate Kubernetes API type definitions for an AWS service API",
-	RunE:     generateAPIs,
-	PostRunE: saveGeneratedMetadata,
+	Use:   "apis <service>",
+	Short: "Generate Kubernetes API type definitions for an AWS service API",
+	RunE:  generateAPIs,
 }
 
 func init() {
@@ -57,24 +56,25 @@ func init() {
 	rootCmd.AddCommand(apisCmd)
 }
 
-// saveGeneratedMetadata saves the parameters used to generate APIs and checksum
-// of the generated code.
-func saveGeneratedMetadata(cmd *cobra.Command, args []string) error {
+// saveAPIsMetadata saves the generation metadata of the APIs generated in the
+// supplied output directory, along with a copy of the generator configuration
+// file.
+func saveAPIsMetadata(outputPath string, generatorConfigPath string) error {
 	err := ackmetadata.CreateGenerationMetadata(
 		optGenVersion,
-		filepath.Join(optOutputPath, "apis"),
+		filepath.Join(outputPath, "apis"),
 		ackmetadata.UpdateReasonAPIGeneration,
 		optAWSSDKGoVersion,
-		optGeneratorConfigPath,
+		generatorConfigPath,
 	)
 	if err != nil {
 		return fmt.Errorf("cannot create generation metadata file: %v", err)
 	}
 
 	copyDest := filepath.Join(
-		optOutputPath, "apis", optGenVersion, "generator.yaml",
+		outputPath, "apis", optGenVersion, "generator.yaml",
 	)
-	err = util.CopyFile(optGeneratorConfigPath, copyDest)
+	err = util.CopyFile(generatorConfigPath, copyDest)
 	if err != nil {
 		return fmt.Errorf("cannot copy generator configuration file: %v", err)
 	}
@@ -94,13 +94,13 @@ func generateAPIs(cmd *cobra.Command, args []string) error {
 	}
 	ctx, cancel := contextWithSigterm(context.Background())
 	defer cancel()
-	if err := ensureSDKRepo(ctx, optCacheDir, optRefreshCache); err != nil {
+	sdkHelper, err := newSDKHelper(ctx)
+	if err != nil {
 		return err
 	}
-	sdkHelper := ackmodel.NewSDKHelper(sdkDir)
 	sdkAPI, err := sdkHelper.API(svcAlias)
 	if err != nil {
-		newSvcAlias, err := FallBackFindServiceID(sdkDir, svcAlias)
+		newSvcAlias, err := FallBackFindServiceID(sdkHelper.ModelsPath(), svcAlias)
 		if err != nil {
 			return err
 		}
@@ -109,36 +109,45 @@ func generateAPIs(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("service %s not found", svcAlias)
 		}
 	}
-	model, err := ackmodel.New(
-		sdkAPI, optGenVersion, optGeneratorConfigPath, ackgenerate.DefaultConfig,
+	_, err = generateServiceAPIs(
+		sdkAPI, optOutputPath, optGeneratorConfigPath, os.Stdout,
+	)
+	return err
+}
+
+// generateServiceAPIs generates the API type definitions of a service in the
+// apis/<version> directory of the supplied output directory, saves the
+// parameters used to generate them and returns the number of generated files.
+// Anything printed is written to the supplied writer.
+func generateServiceAPIs(
+	sdkAPI *ackmodel.SDKAPI,
+	outputPath string,
+	generatorConfigPath string,
+	w io.Writer,
+) (int, error) {
+	m, err := ackmodel.New(
+		sdkAPI, optGenVersion, generatorConfigPath, ackgenerate.DefaultConfig,
 	)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	ts, err := ackgenerate.APIs(model, optTemplateDirs)
+	ts, err := ackgenerate.APIs(m, optTemplateDirs)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	if err = ts.Execute(); err != nil {
-		return err
+		return 0, err
 	}
 
-	apisVersionPath = filepath.Join(optOutputPath, "apis", optGenVersion)
-	for path, contents := range ts.Executed() {
-		if optDryRun {
-			fmt.Printf("============================= %s ======================================\n", path)
-			fmt.Println(strings.TrimSpace(contents.String()))
-			continue
-		}
-		outPath := filepath.Join(apisVersionPath, path)
-		outDir := filepath.Dir(outPath)
-		if _, err := ensureDir(outDir); err != nil {
-			return err
-		}
-		if err = ioutil.WriteFile(outPath, contents.Bytes(), 0666); err != nil {
-			return err
-		}
+	files := len(ts.Executed())
+	apisVersionPath := filepath.Join(outputPath, "apis", optGenVersion)
+	if err = writeExecuted(w, ts.Executed(), apisVersionPath); err != nil {
+		return files, err
 	}
-	return nil
+	// Nothing is written with --dry-run or --check
+	if optDryRun || optCheck {
+		return files, nil
+	}
+	return files, saveAPIsMetadata(outputPath, generatorConfigPath)
 }
@@ -14,18 +14,24 @@
 package command
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"go/build"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"sort"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/pmezard/go-difflib/difflib"
 	"golang.org/x/mod/modfile"
 
+	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/util"
 )
 
@@ -78,6 +84,92 @@ func ensureDir(fp string) (bool, error) {
 	return true, nil
 }
 
+// writeExecuted writes the executed templates of a TemplateSet, keyed by their
+// path relative to the supplied base directory.
+//
+// With --dry-run, the files are printed to the supplied writer instead. With
+// --check, nothing is written: the files are compared with the ones already
+// found in the base directory, a unified diff is printed for each file that
+// differs and an error is returned if there is any difference.
+func writeExecuted(
+	w io.Writer,
+	executed map[string]*bytes.Buffer,
+	basePath string,
+) error {
+	paths := make([]string, 0, len(executed))
+	for path := range executed {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	drifted := 0
+	for _, path := range paths {
+		contents := executed[path]
+		if optDryRun {
+			fmt.Fprintf(w, "============================= %s ======================================\n", path)
+			fmt.Fprintln(w, strings.TrimSpace(contents.String()))
+			continue
+		}
+		outPath := filepath.Join(basePath, path)
+		if optCheck {
+			diff, err := diffExecuted(outPath, contents.Bytes())
+			if err != nil {
+				return err
+			}
+			if diff != "" {
+				fmt.Fprint(w, diff)
+				drifted++
+			}
+			continue
+		}
+		outDir := filepath.Dir(outPath)
+		if _, err := ensureDir(outDir); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(outPath, contents.Bytes(), 0666); err != nil {
+			return err
+		}
+	}
+	if drifted > 0 {
+		return fmt.Errorf(
+			"%d generated file(s) differ from the files in %s", drifted, basePath,
+		)
+	}
+	return nil
+}
+
+// diffExecuted returns the unified diff between the file at the supplied path
+// and the supplied generated contents, or an empty string if they are the
+// same. A missing file is diffed as an empty one.
+func diffExecuted(path string, generated []byte) (string, error) {
+	fromFile := path
+	current, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		fromFile = "/dev/null"
+	}
+	if bytes.Equal(current, generated) {
+		return "", nil
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(current),
+		B:        splitLines(generated),
+		FromFile: fromFile,
+		ToFile:   path + " (generated)",
+		Context:  3,
+	})
+}
+
+// splitLines splits the supplied contents into lines that keep their trailing
+// newline, as expected by difflib
+func splitLines(contents []byte) []string {
+	if len(contents) == 0 {
+		return nil
+	}
+	return difflib.SplitLines(string(contents))
+}
+
 // isDirWriteable returns true if the supplied directory path is writeable,
 // false otherwise
 func isDirWriteable(fp string) bool {
@@ -91,6 +183,63 @@ func isDirWriteable(fp string) bool {
 	return true
 }
 
+// newSDKHelper returns an SDKHelper reading the aws-sdk-go model files from
+// the source selected with the command line flags:
+//
+// - --sdk-smithy-models-path: a local directory of aws-sdk-go-v2 Smithy models
+// - --sdk-models-path: a local aws-sdk-go models directory
+// - --sdk-go-mod-cache: the aws-sdk-go module in the Go module cache
+// - by default, a git clone'd copy of the aws-sdk-go repository (see
+//   ensureSDKRepo)
+//
+// Only the last one requires git and network access.
+func newSDKHelper(ctx context.Context) (*ackmodel.SDKHelper, error) {
+	if optSDKSmithyModelsPath != "" {
+		if _, err := os.Stat(optSDKSmithyModelsPath); err != nil {
+			return nil, fmt.Errorf("invalid Smithy models path: %v", err)
+		}
+		return ackmodel.NewSDKHelperFromSmithyModelsPath(optSDKSmithyModelsPath), nil
+	}
+	if optSDKModelsPath != "" {
+		if _, err := os.Stat(filepath.Join(optSDKModelsPath, "apis")); err != nil {
+			return nil, fmt.Errorf("invalid aws-sdk-go models path: %v", err)
+		}
+		return ackmodel.NewSDKHelperFromModelsPath(optSDKModelsPath), nil
+	}
+	if optSDKGoModCache {
+		sdkVersion, err := getSDKVersion("")
+		if err != nil {
+			return nil, err
+		}
+		sdkVersion = ensureSemverPrefix(sdkVersion)
+		sdkHelper := ackmodel.NewSDKHelperFromGoModCache(
+			getGoModCachePath(), sdkVersion,
+		)
+		// Make sure the requested version is found in the module cache
+		if err = sdkHelper.WithSDKVersion(sdkVersion); err != nil {
+			return nil, err
+		}
+		return sdkHelper, nil
+	}
+	if err := ensureSDKRepo(ctx, optCacheDir, optRefreshCache); err != nil {
+		return nil, err
+	}
+	return ackmodel.NewSDKHelper(sdkDir), nil
+}
+
+// getGoModCachePath returns the path of the Go module cache. Like the go
+// command, it uses $GOMODCACHE and falls back to $GOPATH/pkg/mod.
+func getGoModCachePath() string {
+	if goModCache := os.Getenv("GOMODCACHE"); goModCache != "" {
+		return goModCache
+	}
+	goPaths := filepath.SplitList(build.Default.GOPATH)
+	if len(goPaths) == 0 {
+		return ""
+	}
+	return filepath.Join(goPaths[0], "pkg", "mod")
+}
+
 // ensureSDKRepo ensures that we have a git clone'd copy of the aws-sdk-go
 // repository, which we use model JSON files from. Upon successful return of
 // this function, the sdkDir global variable will be set to the directory where
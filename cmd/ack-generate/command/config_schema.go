@@ -0,0 +1,58 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package command
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+)
+
+var (
+	optConfigSchemaOutputPath string
+)
+
+// configSchemaCmd is the command that outputs the JSON Schema of the
+// generator config file
+var configSchemaCmd = &cobra.Command{
+	Use:   "config-schema",
+	Short: "Output the JSON Schema of the generator config file",
+	RunE:  generateConfigSchema,
+}
+
+func init() {
+	configSchemaCmd.PersistentFlags().StringVar(
+		&optConfigSchemaOutputPath, "schema-output", "", "path of the file to write the JSON Schema to. Defaults to stdout",
+	)
+	rootCmd.AddCommand(configSchemaCmd)
+}
+
+// generateConfigSchema writes the JSON Schema of the generator config file,
+// which YAML language servers can use to validate and autocomplete
+// generator.yaml files.
+func generateConfigSchema(cmd *cobra.Command, args []string) error {
+	schema, err := ackgenconfig.MarshalSchema()
+	if err != nil {
+		return err
+	}
+	schema = append(schema, '\n')
+	if optConfigSchemaOutputPath == "" {
+		fmt.Print(string(schema))
+		return nil
+	}
+	return ioutil.WriteFile(optConfigSchemaOutputPath, schema, 0666)
+}
@@ -17,6 +17,7 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -59,13 +60,13 @@ func generateController(cmd *cobra.Command, args []string) error {
 
 	ctx, cancel := contextWithSigterm(context.Background())
 	defer cancel()
-	if err := ensureSDKRepo(ctx, optCacheDir, optRefreshCache); err != nil {
+	sdkHelper, err := newSDKHelper(ctx)
+	if err != nil {
 		return err
 	}
-	sdkHelper := ackmodel.NewSDKHelper(sdkDir)
 	sdkAPI, err := sdkHelper.API(svcAlias)
 	if err != nil {
-		newSvcAlias, err := FallBackFindServiceID(sdkDir, svcAlias)
+		newSvcAlias, err := FallBackFindServiceID(sdkHelper.ModelsPath(), svcAlias)
 		if err != nil {
 			return err
 		}
@@ -74,48 +75,56 @@ func generateController(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("service %s not found", svcAlias)
 		}
 	}
-	latestAPIVersion, err = getLatestAPIVersion()
-	if err != nil {
-		return err
+	_, err = generateServiceController(
+		sdkAPI, optOutputPath, optGeneratorConfigPath, os.Stdout,
+	)
+	return err
+}
+
+// generateServiceController generates the controller of a service in the
+// supplied output directory, for the latest API version found in its apis
+// directory, and returns the number of generated files. Anything printed is
+// written to the supplied writer.
+func generateServiceController(
+	sdkAPI *ackmodel.SDKAPI,
+	outputPath string,
+	generatorConfigPath string,
+	w io.Writer,
+) (int, error) {
+	latestAPIVersion, err := getLatestAPIVersion(outputPath)
+	if os.IsNotExist(err) && (optDryRun || optCheck) {
+		// The APIs generated along with the controller weren't written
+		latestAPIVersion = optGenVersion
+	} else if err != nil {
+		return 0, err
 	}
 	m, err := ackmodel.New(
-		sdkAPI, latestAPIVersion, optGeneratorConfigPath, ackgenerate.DefaultConfig,
+		sdkAPI, latestAPIVersion, generatorConfigPath, ackgenerate.DefaultConfig,
 	)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	ts, err := ackgenerate.Controller(m, optTemplateDirs)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	if err = ts.Execute(); err != nil {
-		return err
+		return 0, err
 	}
 
-	for path, contents := range ts.Executed() {
-		if optDryRun {
-			fmt.Printf("============================= %s ======================================\n", path)
-			fmt.Println(strings.TrimSpace(contents.String()))
-			continue
-		}
-		outPath := filepath.Join(optOutputPath, path)
-		outDir := filepath.Dir(outPath)
-		if _, err := ensureDir(outDir); err != nil {
-			return err
-		}
-		if err = ioutil.WriteFile(outPath, contents.Bytes(), 0666); err != nil {
-			return err
-		}
+	files := len(ts.Executed())
+	if err = writeExecuted(w, ts.Executed(), outputPath); err != nil {
+		return files, err
 	}
-	return nil
+	return files, nil
 }
 
 // getLatestAPIVersion looks in a target output directory to determine what the
 // latest Kubernetes API version for CRDs exposed by the generated service
 // controller.
-func getLatestAPIVersion() (string, error) {
-	apisPath := filepath.Join(optOutputPath, "apis")
+func getLatestAPIVersion(outputPath string) (string, error) {
+	apisPath := filepath.Join(outputPath, "apis")
 	versions := []string{}
 	subdirs, err := ioutil.ReadDir(apisPath)
 	if err != nil {
@@ -134,8 +143,8 @@ func getLatestAPIVersion() (string, error) {
 // FallBackFindServiceID reads through aws-sdk-go/models/apis/*/*/api-2.json
 // Returns ServiceID (as newSuppliedAlias) if supplied service Alias matches with serviceID in api-2.json
 // If not a match, return the supllied alias.
-func FallBackFindServiceID(sdkDir, svcAlias string) (string, error) {
-	basePath := filepath.Join(sdkDir, "models", "apis")
+func FallBackFindServiceID(sdkModelsDir, svcAlias string) (string, error) {
+	basePath := filepath.Join(sdkModelsDir, "apis")
 	var files []string
 	err := filepath.Walk(basePath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
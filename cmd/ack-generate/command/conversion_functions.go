@@ -0,0 +1,167 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	ackgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+	ackmetadata "github.com/aws-controllers-k8s/code-generator/pkg/metadata"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model/multiversion"
+)
+
+var (
+	optHubVersion string
+	// the API infos of the versions touched by the conversion functions
+	// generator, keyed by API version.
+	conversionAPIInfos map[string]ackmetadata.APIInfo
+)
+
+// conversionFunctionsCmd is the command that generates the conversion
+// functions of each non-deprecated API version of a service controller
+var conversionFunctionsCmd = &cobra.Command{
+	Use:      "conversion-functions <service>",
+	Short:    "Generate conversion functions between the hub and spoke API versions of an AWS service controller",
+	RunE:     generateConversionFunctions,
+	PostRunE: saveConversionFunctionsMetadata,
+}
+
+func init() {
+	conversionFunctionsCmd.PersistentFlags().StringVar(
+		&optHubVersion, "hub-version", "", "the hub API version. Defaults to the latest available API version found in the service metadata",
+	)
+	rootCmd.AddCommand(conversionFunctionsCmd)
+}
+
+// saveConversionFunctionsMetadata updates the generation metadata of every
+// API version modified by the conversion functions generator.
+func saveConversionFunctionsMetadata(cmd *cobra.Command, args []string) error {
+	if optDryRun || optCheck {
+		return nil
+	}
+	apisPath := filepath.Join(optOutputPath, "apis")
+	for apiVersion, apiInfo := range conversionAPIInfos {
+		if apiInfo.Status != ackmetadata.APIStatusAvailable {
+			continue
+		}
+		err := ackmetadata.CreateGenerationMetadata(
+			apiVersion,
+			apisPath,
+			ackmetadata.UpdateReasonConversionFunctionsGeneration,
+			apiInfo.AWSSDKVersion,
+			apiInfo.GeneratorConfigPath,
+		)
+		if err != nil {
+			return fmt.Errorf("cannot update generation metadata file of %s: %v", apiVersion, err)
+		}
+	}
+	return nil
+}
+
+// generateConversionFunctions generates the Go files containing the hub and
+// spoke conversion functions for each resource in the AWS service API.
+func generateConversionFunctions(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("please specify the service alias for the AWS service API to generate")
+	}
+	svcAlias := strings.ToLower(args[0])
+	if optOutputPath == "" {
+		optOutputPath = filepath.Join(optServicesDir, svcAlias)
+	}
+	metadata, err := ackmetadata.NewServiceMetadata(optMetadataConfigPath)
+	if err != nil {
+		return err
+	}
+	hubVersion := optHubVersion
+	if hubVersion == "" {
+		hubVersion, err = metadata.GetLatestAPIVersion()
+		if err != nil {
+			return err
+		}
+	}
+
+	apisPath := filepath.Join(optOutputPath, "apis")
+	conversionAPIInfos, err = loadAPIInfos(apisPath, metadata)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := contextWithSigterm(context.Background())
+	defer cancel()
+	sdkHelper, err := newSDKHelper(ctx)
+	if err != nil {
+		return err
+	}
+	mgr, err := multiversion.NewAPIVersionManager(
+		sdkHelper,
+		optMetadataConfigPath,
+		svcAlias,
+		hubVersion,
+		conversionAPIInfos,
+		ackgenerate.DefaultConfig,
+	)
+	if err != nil {
+		return err
+	}
+	ts, err := ackgenerate.ConversionFunctions(mgr, optTemplateDirs)
+	if err != nil {
+		return err
+	}
+
+	if err = ts.Execute(); err != nil {
+		return err
+	}
+
+	if err = writeExecuted(os.Stdout, ts.Executed(), apisPath); err != nil {
+		return err
+	}
+	return nil
+}
+
+// loadAPIInfos returns the APIInfo of every API version listed in the service
+// metadata. The aws-sdk-go version and generator config of each API version
+// are read from the files `ack-generate apis` saved in the API version
+// directory.
+func loadAPIInfos(
+	apisPath string,
+	metadata *ackmetadata.ServiceMetadata,
+) (map[string]ackmetadata.APIInfo, error) {
+	apiInfos := map[string]ackmetadata.APIInfo{}
+	for _, version := range metadata.APIVersions {
+		apiInfo := ackmetadata.APIInfo{
+			Status:     version.Status,
+			APIVersion: version.APIVersion,
+		}
+		if version.Status == ackmetadata.APIStatusAvailable {
+			generationMetadata, err := ackmetadata.LoadGenerationMetadata(
+				apisPath, version.APIVersion,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("cannot load generation metadata of %s: %v", version.APIVersion, err)
+			}
+			apiInfo.AWSSDKVersion = ensureSemverPrefix(generationMetadata.AWSSDKGoVersion)
+			apiInfo.GeneratorConfigPath = filepath.Join(
+				apisPath, version.APIVersion, "generator.yaml",
+			)
+		}
+		apiInfos[version.APIVersion] = apiInfo
+	}
+	return apiInfos, nil
+}
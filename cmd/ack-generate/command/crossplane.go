@@ -16,7 +16,6 @@ package command
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -50,6 +49,11 @@ func generateCrossplane(_ *cobra.Command, args []string) error {
 	if len(args) != 1 {
 		return fmt.Errorf("please specify the service alias for the AWS service API to generate")
 	}
+	if optCheck {
+		// The files on disk are reformatted with goimports once written, so
+		// they would never match the executed templates
+		return fmt.Errorf("--check is not supported for Crossplane providers")
+	}
 	ctx, cancel := contextWithSigterm(context.Background())
 	defer cancel()
 	if err := ensureSDKRepo(ctx, optCacheDir, optRefreshCache); err != nil {
@@ -60,7 +64,7 @@ func generateCrossplane(_ *cobra.Command, args []string) error {
 	sdkHelper.APIGroupSuffix = "aws.crossplane.io"
 	sdkAPI, err := sdkHelper.API(svcAlias)
 	if err != nil {
-		newSvcAlias, err := FallBackFindServiceID(sdkDir, svcAlias)
+		newSvcAlias, err := FallBackFindServiceID(sdkHelper.ModelsPath(), svcAlias)
 		if err != nil {
 			return err
 		}
@@ -93,20 +97,8 @@ func generateCrossplane(_ *cobra.Command, args []string) error {
 		return err
 	}
 
-	for path, contents := range ts.Executed() {
-		if optDryRun {
-			fmt.Printf("============================= %s ======================================\n", path)
-			fmt.Println(strings.TrimSpace(contents.String()))
-			continue
-		}
-		outPath := filepath.Join(providerDir, path)
-		outDir := filepath.Dir(outPath)
-		if _, err := ensureDir(outDir); err != nil {
-			return err
-		}
-		if err = ioutil.WriteFile(outPath, contents.Bytes(), 0666); err != nil {
-			return err
-		}
+	if err = writeExecuted(os.Stdout, ts.Executed(), providerDir); err != nil {
+		return err
 	}
 	apiPath := filepath.Join(providerDir, "apis", svcAlias, optGenVersion)
 	controllerPath := filepath.Join(providerDir, "pkg", "controller", svcAlias)
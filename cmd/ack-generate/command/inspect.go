@@ -0,0 +1,167 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	ackgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+var (
+	optInspectFormat string
+)
+
+// inspectCmd is the command that explains how the operations and shapes of
+// an AWS service API are mapped to custom resources
+var inspectCmd = &cobra.Command{
+	Use:   "inspect <service>",
+	Short: "Print how each operation and field of an AWS service API is mapped to the custom resources",
+	RunE:  inspectService,
+}
+
+func init() {
+	inspectCmd.PersistentFlags().StringVar(
+		&optInspectFormat, "format", "table", "output format: table or json",
+	)
+	rootCmd.AddCommand(inspectCmd)
+}
+
+// inspectService prints the operations of the AWS service API along with the
+// operation type and resource name inferred for them, then the Spec and
+// Status fields of every custom resource and where they come from.
+func inspectService(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("please specify the service alias for the AWS service API to inspect")
+	}
+	if optInspectFormat != "table" && optInspectFormat != "json" {
+		return fmt.Errorf("unsupported format %q: must be table or json", optInspectFormat)
+	}
+	svcAlias := strings.ToLower(args[0])
+	ctx, cancel := contextWithSigterm(context.Background())
+	defer cancel()
+	sdkHelper, err := newSDKHelper(ctx)
+	if err != nil {
+		return err
+	}
+	sdkAPI, err := sdkHelper.API(svcAlias)
+	if err != nil {
+		newSvcAlias, err := FallBackFindServiceID(sdkHelper.ModelsPath(), svcAlias)
+		if err != nil {
+			return err
+		}
+		sdkAPI, err = sdkHelper.API(newSvcAlias) // retry with serviceID
+		if err != nil {
+			return fmt.Errorf("service %s not found", svcAlias)
+		}
+	}
+	model, err := ackmodel.New(
+		sdkAPI, optGenVersion, optGeneratorConfigPath, ackgenerate.DefaultConfig,
+	)
+	if err != nil {
+		return err
+	}
+	inspection, err := model.Inspect()
+	if err != nil {
+		return err
+	}
+	if optInspectFormat == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(inspection)
+	}
+	return printInspection(os.Stdout, inspection)
+}
+
+// printInspection writes the tables of the operations and custom resource
+// fields of an inspection
+func printInspection(out io.Writer, inspection *ackmodel.Inspection) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "OPERATION\tINFERRED\tOP TYPES\tRESOURCE\tCRDS\tOVERRIDES\tIGNORED")
+	for _, op := range inspection.Operations {
+		fmt.Fprintf(
+			w, "%s\t%s %s\t%s\t%s\t%s\t%s\t%s\n",
+			op.Name,
+			op.InferredOpType, op.InferredResourceName,
+			strings.Join(op.OpTypes, ","),
+			op.ResourceName,
+			orNone(strings.Join(op.CRDs, ", ")),
+			orNone(strings.Join(op.Overrides, "; ")),
+			orNone(op.Ignored),
+		)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, crd := range inspection.CRDs {
+		fmt.Fprintf(out, "\nCRD %s\n", crd.Kind)
+		opTypes := make([]string, 0, len(crd.Operations))
+		for opType := range crd.Operations {
+			opTypes = append(opTypes, opType)
+		}
+		sort.Strings(opTypes)
+		for _, opType := range opTypes {
+			fmt.Fprintf(out, "  %s: %s\n", opType, crd.Operations[opType])
+		}
+		fmt.Fprintln(out)
+		w = tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "  FIELD\tGO TYPE\tSOURCE\tRENAMED FROM\tRULE")
+		for _, fields := range []struct {
+			prefix string
+			fields []*ackmodel.FieldInspection
+		}{
+			{"Spec.", crd.SpecFields},
+			{"Status.", crd.StatusFields},
+			{"(ignored) ", crd.IgnoredFields},
+		} {
+			for _, f := range fields.fields {
+				source := f.SourceOperation
+				if f.SourcePath != "" {
+					source += ":" + f.SourcePath
+				}
+				fmt.Fprintf(
+					w, "  %s%s\t%s\t%s\t%s\t%s\n",
+					fields.prefix, f.Name,
+					orNone(f.GoType),
+					orNone(source),
+					orNone(f.RenamedFrom),
+					orNone(f.Rule),
+				)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// orNone returns "-" for empty table cells
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
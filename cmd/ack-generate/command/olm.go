@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -83,13 +84,13 @@ func generateOLMAssets(cmd *cobra.Command, args []string) error {
 	// get the generator inputs
 	ctx, cancel := contextWithSigterm(context.Background())
 	defer cancel()
-	if err := ensureSDKRepo(ctx, optCacheDir, optRefreshCache); err != nil {
+	sdkHelper, err := newSDKHelper(ctx)
+	if err != nil {
 		return err
 	}
-	sdkHelper := ackmodel.NewSDKHelper(sdkDir)
 	sdkAPI, err := sdkHelper.API(svcAlias)
 	if err != nil {
-		newSvcAlias, err := FallBackFindServiceID(sdkDir, svcAlias)
+		newSvcAlias, err := FallBackFindServiceID(sdkHelper.ModelsPath(), svcAlias)
 		if err != nil {
 			return err
 		}
@@ -99,7 +100,7 @@ func generateOLMAssets(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	latestAPIVersion, err = getLatestAPIVersion()
+	latestAPIVersion, err = getLatestAPIVersion(optOutputPath)
 	if err != nil {
 		return err
 	}
@@ -149,20 +150,8 @@ func generateOLMAssets(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	for path, contents := range ts.Executed() {
-		if optDryRun {
-			fmt.Printf("============================= %s ======================================\n", path)
-			fmt.Println(strings.TrimSpace(contents.String()))
-			continue
-		}
-		outPath := filepath.Join(optOutputPath, path)
-		outDir := filepath.Dir(outPath)
-		if _, err := ensureDir(outDir); err != nil {
-			return err
-		}
-		if err = ioutil.WriteFile(outPath, contents.Bytes(), 0666); err != nil {
-			return err
-		}
+	if err = writeExecuted(os.Stdout, ts.Executed(), optOutputPath); err != nil {
+		return err
 	}
 
 	return nil
@@ -39,7 +39,11 @@ var (
 	defaultServicesDir     string
 	optServicesDir         string
 	optDryRun              bool
+	optCheck               bool
 	sdkDir                 string
+	optSDKModelsPath       string
+	optSDKGoModCache       bool
+	optSDKSmithyModelsPath string
 	optGeneratorConfigPath string
 	optMetadataConfigPath  string
 	optOutputPath          string
@@ -97,6 +101,9 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(
 		&optDryRun, "dry-run", false, "If true, outputs all files to stdout",
 	)
+	rootCmd.PersistentFlags().BoolVar(
+		&optCheck, "check", false, "If true, writes no file but prints a unified diff of the generated files against the files in the output directory, and fails if they differ",
+	)
 	rootCmd.PersistentFlags().StringSliceVar(
 		&optTemplateDirs, "template-dirs", defaultTemplateDirs, "Paths to directories with templates to use in code generation. Note that the order in which directories is specified will be used to provide override functionality.",
 	)
@@ -118,6 +125,15 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(
 		&optOutputPath, "output", "o", "", "Path to directory to output generated files.",
 	)
+	rootCmd.PersistentFlags().StringVar(
+		&optSDKModelsPath, "sdk-models-path", "", "Path to a local aws-sdk-go models directory (containing apis/). When set, the aws-sdk-go repository is not cloned",
+	)
+	rootCmd.PersistentFlags().BoolVar(
+		&optSDKGoModCache, "sdk-go-mod-cache", false, "If true, read the aws-sdk-go models from the Go module cache ($GOMODCACHE/github.com/aws/aws-sdk-go@$VERSION) instead of cloning the aws-sdk-go repository",
+	)
+	rootCmd.PersistentFlags().StringVar(
+		&optSDKSmithyModelsPath, "sdk-smithy-models-path", "", "Path to a local directory of Smithy JSON AST models, as used by the aws-sdk-go-v2 (e.g. aws-sdk-go-v2/codegen/sdk-codegen/aws-models). When set, the aws-sdk-go repository is not cloned",
+	)
 	rootCmd.PersistentFlags().StringVar(
 		&optAWSSDKGoVersion, "aws-sdk-go-version", "", "Version of github.com/aws/aws-sdk-go used to generate apis and controllers files",
 	)
@@ -0,0 +1,104 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	ackgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// validateConfigCmd is the command that checks a generator config file
+// against an AWS service API
+var validateConfigCmd = &cobra.Command{
+	Use:   "validate-config <service>",
+	Short: "Validate a generator config file against an AWS service API",
+	RunE:  validateConfig,
+}
+
+// optStrict makes validate-config fail on warnings, such as unknown keys
+var optStrict bool
+
+func init() {
+	validateConfigCmd.PersistentFlags().BoolVar(
+		&optStrict, "strict", false, "If true, also fails on warnings, such as unknown keys",
+	)
+	rootCmd.AddCommand(validateConfigCmd)
+}
+
+// validateConfig prints all the problems found in the generator config file,
+// including the ones found while building the resources, and fails if any of
+// them isn't a warning. With --strict, it fails on warnings too.
+func validateConfig(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("please specify the service alias for the AWS service API to validate against")
+	}
+	svcAlias := strings.ToLower(args[0])
+	ctx, cancel := contextWithSigterm(context.Background())
+	defer cancel()
+	sdkHelper, err := newSDKHelper(ctx)
+	if err != nil {
+		return err
+	}
+	sdkAPI, err := sdkHelper.API(svcAlias)
+	if err != nil {
+		newSvcAlias, err := FallBackFindServiceID(sdkHelper.ModelsPath(), svcAlias)
+		if err != nil {
+			return err
+		}
+		sdkAPI, err = sdkHelper.API(newSvcAlias) // retry with serviceID
+		if err != nil {
+			return fmt.Errorf("service %s not found", svcAlias)
+		}
+	}
+	cfg, err := ackgenconfig.New(optGeneratorConfigPath, ackgenerate.DefaultConfig)
+	if err != nil {
+		return err
+	}
+	problems := ackmodel.ValidateConfig(sdkAPI, &cfg)
+	for _, problem := range problems {
+		fmt.Println(problem.Error())
+	}
+	if errs := problems.Errors(); len(errs) > 0 {
+		return fmt.Errorf("found %d error(s) in generator config", len(errs))
+	}
+	// Some problems, such as `child` configs that don't match the API's
+	// shapes, are only found while building the resources
+	m, err := ackmodel.New(
+		sdkAPI, optGenVersion, optGeneratorConfigPath, ackgenerate.DefaultConfig,
+	)
+	if err != nil {
+		return err
+	}
+	if _, err := m.GetCRDs(); err != nil {
+		errs, ok := err.(ackgenconfig.ValidationErrors)
+		if !ok {
+			return err
+		}
+		for _, problem := range errs {
+			fmt.Println(problem.Error())
+		}
+		return fmt.Errorf("found %d error(s) in generator config", len(errs))
+	}
+	if optStrict && len(problems) > 0 {
+		return fmt.Errorf("found %d warning(s) in generator config", len(problems))
+	}
+	return nil
+}
@@ -14,10 +14,12 @@
 package ack
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 	ttpl "text/template"
 
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
 	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
 	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/iancoleman/strcase"
@@ -38,6 +40,9 @@ var (
 	apisCopyPaths = []string{}
 	apisFuncMap   = ttpl.FuncMap{
 		"Join": strings.Join,
+		"GoCodeValidateImmutableFields": func(r *ackmodel.CRD, oldVarName string, newVarName string, errsVarName string, indentLevel int) (string, error) {
+			return code.ValidateImmutableFields(r.Config(), r, oldVarName, newVarName, errsVarName, indentLevel)
+		},
 	}
 )
 
@@ -80,6 +85,16 @@ func APIs(
 		}
 	}
 
+	for _, crd := range crds {
+		if crd.HasReferenceFields() {
+			// The types of the fields referring to other custom resources
+			if err = ts.Add("references.go", "apis/references.go.tpl", apiVars); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
 	for _, crd := range crds {
 		crdFileName := strcase.ToSnake(crd.Kind) + ".go"
 		crdVars := &templateCRDVars{
@@ -90,6 +105,14 @@ func APIs(
 		if err = ts.Add(crdFileName, "apis/crd.go.tpl", crdVars); err != nil {
 			return nil, err
 		}
+		if len(crd.ImmutableFields()) > 0 {
+			// The validating webhook rejecting changes to immutable fields
+			webhookFileName := strcase.ToSnake(crd.Kind) + "_webhook.go"
+			webhookVars := newTemplateValidationWebhookVars(metaVars, crd)
+			if err = ts.Add(webhookFileName, "apis/webhooks/validation.go.tpl", webhookVars); err != nil {
+				return nil, err
+			}
+		}
 	}
 	return ts, nil
 }
@@ -109,3 +132,39 @@ type templateCRDVars struct {
 	SDKAPI *ackmodel.SDKAPI
 	CRD    *ackmodel.CRD
 }
+
+// templateValidationWebhookVars contains template variables for the template
+// that outputs the validating webhook of a single top-level resource
+type templateValidationWebhookVars struct {
+	templateset.MetaVars
+	CRD *ackmodel.CRD
+	// Resource is the lowercased plural name of the resource, e.g.
+	// "repositories"
+	Resource string
+	// WebhookPath is the path the webhook is served at by the
+	// controller-runtime webhook server, e.g.
+	// "/validate-ecr-services-k8s-aws-v1alpha1-repository"
+	WebhookPath string
+	// WebhookName is the name of the webhook in the
+	// ValidatingWebhookConfiguration, e.g. "vrepository.ecr.services.k8s.aws"
+	WebhookName string
+}
+
+// newTemplateValidationWebhookVars returns the template variables of the
+// validating webhook of the supplied CRD
+func newTemplateValidationWebhookVars(
+	metaVars templateset.MetaVars,
+	crd *ackmodel.CRD,
+) *templateValidationWebhookVars {
+	kind := strings.ToLower(crd.Kind)
+	return &templateValidationWebhookVars{
+		MetaVars: metaVars,
+		CRD:      crd,
+		Resource: strings.ToLower(crd.Plural),
+		WebhookPath: fmt.Sprintf(
+			"/validate-%s-%s-%s",
+			strings.ReplaceAll(metaVars.APIGroup, ".", "-"), metaVars.APIVersion, kind,
+		),
+		WebhookName: fmt.Sprintf("v%s.%s", kind, metaVars.APIGroup),
+	}
+}
@@ -49,6 +49,7 @@ var (
 		"pkg/resource/sdk_update_custom.go.tpl",
 		"pkg/resource/sdk_update_set_attributes.go.tpl",
 		"pkg/resource/sdk_update_not_implemented.go.tpl",
+		"pkg/resource/type_override_imports.go.tpl",
 	}
 	controllerCopyPaths = []string{}
 	controllerFuncMap   = ttpl.FuncMap{
@@ -59,40 +60,58 @@ var (
 		"GoCodeSetExceptionMessageCheck": func(r *ackmodel.CRD, httpStatusCode int) string {
 			return code.CheckExceptionMessage(r.Config(), r, httpStatusCode)
 		},
-		"GoCodeSetReadOneOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeSetReadOneOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetResource(r.Config(), r, ackmodel.OpTypeGet, sourceVarName, targetVarName, indentLevel)
 		},
-		"GoCodeSetReadOneInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeSetReadOneInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetSDK(r.Config(), r, ackmodel.OpTypeGet, sourceVarName, targetVarName, indentLevel)
 		},
-		"GoCodeSetReadManyOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeSetReadManyOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetResource(r.Config(), r, ackmodel.OpTypeList, sourceVarName, targetVarName, indentLevel)
 		},
-		"GoCodeSetReadManyInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeSetReadManyInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetSDK(r.Config(), r, ackmodel.OpTypeList, sourceVarName, targetVarName, indentLevel)
 		},
-		"GoCodeGetAttributesSetInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeReadManyHasMatch": func(r *ackmodel.CRD, koVarName string, sourceVarName string, indentLevel int) (string, error) {
+			return code.ReadManyHasMatch(r.Config(), r, koVarName, sourceVarName, indentLevel)
+		},
+		"GoCodeSyncedStatus": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
+			return code.SyncedStatus(r.Config(), r, koVarName, indentLevel)
+		},
+		"GoCodeWaiterAcceptors": func(r *ackmodel.CRD, indentLevel int) string {
+			return code.WaiterAcceptors(r, indentLevel)
+		},
+		"GoCodeIsDeleting": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
+			return code.IsDeleting(r.Config(), r, koVarName, indentLevel)
+		},
+		"GoCodeSetPreDeleteUpdates": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
+			return code.SetPreDeleteUpdates(r.Config(), r, koVarName, indentLevel)
+		},
+		"GoCodeClassifyAWSError": func(r *ackmodel.CRD, opNameVarName string, awsErrVarName string, retryKeyVarName string, indentLevel int) string {
+			return code.ClassifyAWSError(r.Config(), r, opNameVarName, awsErrVarName, retryKeyVarName, indentLevel)
+		},
+		"GoCodeGetAttributesSetInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetSDKGetAttributes(r.Config(), r, sourceVarName, targetVarName, indentLevel)
 		},
-		"GoCodeSetAttributesSetInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeSetAttributesSetInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetSDKSetAttributes(r.Config(), r, sourceVarName, targetVarName, indentLevel)
 		},
-		"GoCodeGetAttributesSetOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeGetAttributesSetOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetResourceGetAttributes(r.Config(), r, sourceVarName, targetVarName, indentLevel)
 		},
-		"GoCodeSetCreateOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeSetCreateOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetResource(r.Config(), r, ackmodel.OpTypeCreate, sourceVarName, targetVarName, indentLevel)
 		},
-		"GoCodeSetCreateInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeSetCreateInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetSDK(r.Config(), r, ackmodel.OpTypeCreate, sourceVarName, targetVarName, indentLevel)
 		},
-		"GoCodeSetUpdateOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeSetUpdateOutput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetResource(r.Config(), r, ackmodel.OpTypeUpdate, sourceVarName, targetVarName, indentLevel)
 		},
-		"GoCodeSetUpdateInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeSetUpdateInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetSDK(r.Config(), r, ackmodel.OpTypeUpdate, sourceVarName, targetVarName, indentLevel)
 		},
-		"GoCodeSetDeleteInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeSetDeleteInput": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetSDK(r.Config(), r, ackmodel.OpTypeDelete, sourceVarName, targetVarName, indentLevel)
 		},
 		"GoCodeSetSDKForStruct": func(r *ackmodel.CRD, targetFieldName string, targetVarName string, targetShapeRef *awssdkmodel.ShapeRef, sourceFieldPath string, sourceVarName string, indentLevel int) string {
@@ -101,25 +120,34 @@ var (
 		"GoCodeSetResourceForStruct": func(r *ackmodel.CRD, targetFieldName string, targetVarName string, targetShapeRef *awssdkmodel.ShapeRef, sourceVarName string, sourceShapeRef *awssdkmodel.ShapeRef, indentLevel int) string {
 			return code.SetResourceForStruct(r.Config(), r, targetFieldName, targetVarName, targetShapeRef, sourceVarName, sourceShapeRef, indentLevel)
 		},
-		"GoCodeCompare": func(r *ackmodel.CRD, deltaVarName string, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeCompare": func(r *ackmodel.CRD, deltaVarName string, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.CompareResource(r.Config(), r, deltaVarName, sourceVarName, targetVarName, indentLevel)
 		},
+		"GoCodeGetChildren": func(r *ackmodel.CRD, child *ackmodel.ChildField, sourceVarName string, targetVarName string, indentLevel int) string {
+			return code.GetChildren(r.Config(), r, child, sourceVarName, targetVarName, indentLevel)
+		},
+		"GoCodeSetSDKSubResource": func(r *ackmodel.CRD, sub *ackmodel.SubResource, sourceVarName string, targetVarName string, indentLevel int) string {
+			return code.SetSDKSubResource(r.Config(), r, sub, sourceVarName, targetVarName, indentLevel)
+		},
+		"GoCodeSetResourceSubResource": func(r *ackmodel.CRD, sub *ackmodel.SubResource, sourceVarName string, targetVarName string, indentLevel int) string {
+			return code.SetResourceSubResource(r.Config(), r, sub, sourceVarName, targetVarName, indentLevel)
+		},
 		"Empty": func(subject string) bool {
 			return strings.TrimSpace(subject) == ""
 		},
-		"GoCodeRequiredFieldsMissingFromReadOneInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
+		"GoCodeRequiredFieldsMissingFromReadOneInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) (string, error) {
 			return code.CheckRequiredFieldsMissingFromShape(r, ackmodel.OpTypeGet, koVarName, indentLevel)
 		},
-		"GoCodeRequiredFieldsMissingFromReadManyInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
+		"GoCodeRequiredFieldsMissingFromReadManyInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) (string, error) {
 			return code.CheckRequiredFieldsMissingFromShape(r, ackmodel.OpTypeList, koVarName, indentLevel)
 		},
-		"GoCodeRequiredFieldsMissingFromGetAttributesInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
+		"GoCodeRequiredFieldsMissingFromGetAttributesInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) (string, error) {
 			return code.CheckRequiredFieldsMissingFromShape(r, ackmodel.OpTypeGetAttributes, koVarName, indentLevel)
 		},
-		"GoCodeRequiredFieldsMissingFromSetAttributesInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) string {
+		"GoCodeRequiredFieldsMissingFromSetAttributesInput": func(r *ackmodel.CRD, koVarName string, indentLevel int) (string, error) {
 			return code.CheckRequiredFieldsMissingFromShape(r, ackmodel.OpTypeSetAttributes, koVarName, indentLevel)
 		},
-		"GoCodeSetResourceIdentifiers": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) string {
+		"GoCodeSetResourceIdentifiers": func(r *ackmodel.CRD, sourceVarName string, targetVarName string, indentLevel int) (string, error) {
 			return code.SetResourceIdentifiers(r.Config(), r, sourceVarName, targetVarName, indentLevel)
 		},
 		"GoCodeFindLateInitializedFieldNames": func(r *ackmodel.CRD, resVarName string, indentLevel int) string {
@@ -131,6 +159,24 @@ var (
 		"GoCodeIncompleteLateInitialization": func(r *ackmodel.CRD, resVarName string, indentLevel int) string {
 			return code.IncompleteLateInitialization(r.Config(), r, resVarName, indentLevel)
 		},
+		"SDKTestable": func(r *ackmodel.CRD, opType string) bool {
+			return code.SDKTestable(r.Config(), r, ackmodel.OpTypeFromString(opType))
+		},
+		"GoCodeSDKTestSetResource": func(r *ackmodel.CRD, targetVarName string, seed int, indentLevel int) string {
+			return code.SDKTestSetResource(r.Config(), r, targetVarName, seed, indentLevel)
+		},
+		"GoCodeSDKTestSetOutput": func(r *ackmodel.CRD, opType string, targetVarName string, seed int, indentLevel int) string {
+			return code.SDKTestSetOutput(r.Config(), r, ackmodel.OpTypeFromString(opType), targetVarName, seed, indentLevel)
+		},
+		"GoCodeSDKTestSetReadManyMatchFields": func(r *ackmodel.CRD, targetVarName string, koVarName string, indentLevel int) string {
+			return code.SDKTestSetReadManyMatchFields(r.Config(), r, targetVarName, koVarName, indentLevel)
+		},
+		"GoCodeSDKTestInputCases": func(r *ackmodel.CRD, opType string, inputVarName string, koVarName string, indentLevel int) string {
+			return code.SDKTestInputCases(r.Config(), r, ackmodel.OpTypeFromString(opType), inputVarName, koVarName, indentLevel)
+		},
+		"GoCodeSDKTestOutputCases": func(r *ackmodel.CRD, opType string, outputVarName string, koVarName string, indentLevel int) string {
+			return code.SDKTestOutputCases(r.Config(), r, ackmodel.OpTypeFromString(opType), outputVarName, koVarName, indentLevel)
+		},
 	}
 )
 
@@ -147,15 +193,22 @@ func Controller(
 
 	metaVars := m.MetaVars()
 
+	// The Hook function depends on the model, so it's added to a copy of the
+	// function map: controllers of several services can be generated
+	// concurrently.
+	funcMap := ttpl.FuncMap{}
+	for name, fn := range controllerFuncMap {
+		funcMap[name] = fn
+	}
 	// Hook code can reference a template path, and we can look up the template
 	// in any of our base paths...
-	controllerFuncMap["Hook"] = func(r *ackmodel.CRD, hookID string) string {
+	funcMap["Hook"] = func(r *ackmodel.CRD, hookID string) string {
 		crdVars := &templateCRDVars{
 			metaVars,
 			m.SDKAPI,
 			r,
 		}
-		code, err := ResourceHookCode(templateBasePaths, r, hookID, crdVars, controllerFuncMap)
+		code, err := ResourceHookCode(templateBasePaths, r, hookID, crdVars, funcMap)
 		if err != nil {
 			// It's a compile-time error, so just panic...
 			panic(err)
@@ -167,7 +220,7 @@ func Controller(
 		templateBasePaths,
 		controllerIncludePaths,
 		controllerCopyPaths,
-		controllerFuncMap,
+		funcMap,
 	)
 
 	// First add all the CRD pkg/resource templates
@@ -193,6 +246,66 @@ func Controller(
 				return nil, err
 			}
 		}
+		if crd.HasReferenceFields() {
+			outPath := filepath.Join("pkg/resource", crd.Names.Snake, "references.go")
+			crdVars := &templateCRDVars{
+				metaVars,
+				m.SDKAPI,
+				crd,
+			}
+			if err = ts.Add(outPath, "pkg/resource/references.go.tpl", crdVars); err != nil {
+				return nil, err
+			}
+		}
+		if crd.HasChildFields() {
+			outPath := filepath.Join("pkg/resource", crd.Names.Snake, "children.go")
+			crdVars := &templateCRDVars{
+				metaVars,
+				m.SDKAPI,
+				crd,
+			}
+			if err = ts.Add(outPath, "pkg/resource/children.go.tpl", crdVars); err != nil {
+				return nil, err
+			}
+		}
+		if crd.HasSubResources() {
+			outPath := filepath.Join("pkg/resource", crd.Names.Snake, "sub_resources.go")
+			crdVars := &templateCRDVars{
+				metaVars,
+				m.SDKAPI,
+				crd,
+			}
+			if err = ts.Add(outPath, "pkg/resource/sub_resources.go.tpl", crdVars); err != nil {
+				return nil, err
+			}
+		}
+		if crd.HasNormalizedTags() {
+			outPath := filepath.Join("pkg/resource", crd.Names.Snake, "tags.go")
+			crdVars := &templateCRDVars{
+				metaVars,
+				m.SDKAPI,
+				crd,
+			}
+			if err = ts.Add(outPath, "pkg/resource/tags.go.tpl", crdVars); err != nil {
+				return nil, err
+			}
+		}
+		cfg := crd.Config()
+		if code.SDKTestable(cfg, crd, ackmodel.OpTypeCreate) ||
+			code.SDKTestable(cfg, crd, ackmodel.OpTypeGet) ||
+			code.SDKTestable(cfg, crd, ackmodel.OpTypeList) ||
+			code.SDKTestable(cfg, crd, ackmodel.OpTypeGetAttributes) ||
+			code.SDKTestable(cfg, crd, ackmodel.OpTypeUpdate) {
+			outPath := filepath.Join("pkg/resource", crd.Names.Snake, "sdk_test.go")
+			crdVars := &templateCRDVars{
+				metaVars,
+				m.SDKAPI,
+				crd,
+			}
+			if err = ts.Add(outPath, "pkg/resource/sdk_test.go.tpl", crdVars); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	configVars := &templateConfigVars{
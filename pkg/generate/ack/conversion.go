@@ -0,0 +1,147 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ack
+
+import (
+	"fmt"
+	"path/filepath"
+	ttpl "text/template"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
+	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model/multiversion"
+)
+
+var (
+	conversionIncludePaths = []string{
+		"boilerplate.go.tpl",
+	}
+	conversionCopyPaths = []string{}
+	conversionFuncMap   = ttpl.FuncMap{
+		"GoCodeConvertTo": func(hub *ackmodel.CRD, delta *multiversion.CRDDelta, hubPkgName string, srcVarName string, dstVarName string, restoredVarName string, keptVarName string, indentLevel int) (string, error) {
+			return code.ConvertTo(hub, delta, hubPkgName, srcVarName, dstVarName, restoredVarName, keptVarName, indentLevel)
+		},
+		"GoCodeConvertFrom": func(spoke *ackmodel.CRD, delta *multiversion.CRDDelta, srcVarName string, dstVarName string, restoredVarName string, keptVarName string, indentLevel int) (string, error) {
+			return code.ConvertFrom(spoke, delta, srcVarName, dstVarName, restoredVarName, keptVarName, indentLevel)
+		},
+		"GoCodeConversionKeepsFields": func(delta *multiversion.CRDDelta) bool {
+			return code.ConversionKeepsFields(delta)
+		},
+	}
+)
+
+// ConversionFunctions returns a pointer to a TemplateSet containing all the
+// templates for generating the conversion functions of an ACK service
+// controller's apis/ contents. The hub version CRDs are marked as conversion
+// hubs and every spoke version CRD gets `ConvertTo` and `ConvertFrom`
+// methods. Output paths are relative to the apis/ directory.
+func ConversionFunctions(
+	mgr *multiversion.APIVersionManager,
+	templateBasePaths []string,
+) (*templateset.TemplateSet, error) {
+	hubVersion := mgr.GetHubVersion()
+	hubModel, err := mgr.GetModel(hubVersion)
+	if err != nil {
+		return nil, err
+	}
+	hubCRDs, err := hubModel.GetCRDs()
+	if err != nil {
+		return nil, err
+	}
+
+	ts := templateset.New(
+		templateBasePaths,
+		conversionIncludePaths,
+		conversionCopyPaths,
+		conversionFuncMap,
+	)
+
+	hubMetaVars := hubModel.MetaVars()
+	hubCRDsByName := make(map[string]*ackmodel.CRD, len(hubCRDs))
+	for _, crd := range hubCRDs {
+		hubCRDsByName[crd.Names.Camel] = crd
+		outPath := filepath.Join(hubVersion, strcase.ToSnake(crd.Kind)+"_conversion.go")
+		crdVars := &templateConversionHubVars{
+			hubMetaVars,
+			crd,
+		}
+		if err = ts.Add(outPath, "apis/webhooks/conversion_hub.go.tpl", crdVars); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, spokeVersion := range mgr.GetSpokeVersions() {
+		spokeModel, err := mgr.GetModel(spokeVersion)
+		if err != nil {
+			return nil, err
+		}
+		spokeCRDs, err := spokeModel.GetCRDs()
+		if err != nil {
+			return nil, err
+		}
+		deltas, err := mgr.CompareHubWith(spokeVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		spokeMetaVars := spokeModel.MetaVars()
+		outPath := filepath.Join(spokeVersion, "conversion_data.go")
+		if err = ts.Add(outPath, "apis/webhooks/conversion_data.go.tpl", spokeMetaVars); err != nil {
+			return nil, err
+		}
+		for _, crd := range spokeCRDs {
+			hubCRD, found := hubCRDsByName[crd.Names.Camel]
+			delta, deltaFound := deltas[crd.Names.Camel]
+			if !found || !deltaFound {
+				return nil, fmt.Errorf(
+					"cannot find %s deltas between %s and %s",
+					crd.Names.Camel, spokeVersion, hubVersion,
+				)
+			}
+			outPath := filepath.Join(spokeVersion, strcase.ToSnake(crd.Kind)+"_conversion.go")
+			crdVars := &templateConversionSpokeVars{
+				spokeMetaVars,
+				hubVersion,
+				crd,
+				hubCRD,
+				delta,
+			}
+			if err = ts.Add(outPath, "apis/webhooks/conversion_spoke.go.tpl", crdVars); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ts, nil
+}
+
+// templateConversionHubVars contains template variables for the template that
+// outputs Go code marking a single top-level resource as a conversion hub
+type templateConversionHubVars struct {
+	templateset.MetaVars
+	CRD *ackmodel.CRD
+}
+
+// templateConversionSpokeVars contains template variables for the template
+// that outputs the conversion functions of a single top-level resource
+type templateConversionSpokeVars struct {
+	templateset.MetaVars
+	// HubVersion is the API version of the conversion hub, e.g. "v1alpha2"
+	HubVersion string
+	CRD        *ackmodel.CRD
+	HubCRD     *ackmodel.CRD
+	Delta      *multiversion.CRDDelta
+}
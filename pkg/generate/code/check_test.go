@@ -43,13 +43,13 @@ func TestCheckRequiredFields_Attributes_ARNField(t *testing.T) {
 	expReqFieldsInShape := `
 	return (ko.Status.ACKResourceMetadata == nil || ko.Status.ACKResourceMetadata.ARN == nil)
 `
+	gotCode, err := code.CheckRequiredFieldsMissingFromShape(
+		crd, model.OpTypeGetAttributes, "ko", 1,
+	)
+	require.Nil(err)
 	assert.Equal(
 		strings.TrimSpace(expReqFieldsInShape),
-		strings.TrimSpace(
-			code.CheckRequiredFieldsMissingFromShape(
-				crd, model.OpTypeGetAttributes, "ko", 1,
-			),
-		),
+		strings.TrimSpace(gotCode),
 	)
 }
 
@@ -65,9 +65,10 @@ func TestCheckRequiredFields_Attributes_StatusField(t *testing.T) {
 	expRequiredFieldsCode := `
 	return r.ko.Status.QueueURL == nil
 `
-	gotCode := code.CheckRequiredFieldsMissingFromShape(
+	gotCode, err := code.CheckRequiredFieldsMissingFromShape(
 		crd, model.OpTypeGetAttributes, "r.ko", 1,
 	)
+	require.Nil(err)
 	assert.Equal(
 		strings.TrimSpace(expRequiredFieldsCode),
 		strings.TrimSpace(gotCode),
@@ -86,9 +87,10 @@ func TestCheckRequiredFields_Attributes_StatusAndSpecField(t *testing.T) {
 	expRequiredFieldsCode := `
 	return r.ko.Spec.APIID == nil || r.ko.Status.RouteID == nil
 `
-	gotCode := code.CheckRequiredFieldsMissingFromShape(
+	gotCode, err := code.CheckRequiredFieldsMissingFromShape(
 		crd, model.OpTypeGet, "r.ko", 1,
 	)
+	require.Nil(err)
 	assert.Equal(
 		strings.TrimSpace(expRequiredFieldsCode),
 		strings.TrimSpace(gotCode),
@@ -107,9 +109,10 @@ func TestCheckRequiredFields_RenamedSpecField(t *testing.T) {
 	expRequiredFieldsCode := `
 	return r.ko.Spec.ClusterName == nil || r.ko.Spec.Name == nil
 `
-	gotCode := code.CheckRequiredFieldsMissingFromShape(
+	gotCode, err := code.CheckRequiredFieldsMissingFromShape(
 		crd, model.OpTypeGet, "r.ko", 1,
 	)
+	require.Nil(err)
 	assert.Equal(
 		strings.TrimSpace(expRequiredFieldsCode),
 		strings.TrimSpace(gotCode),
@@ -128,9 +131,10 @@ func TestCheckRequiredFields_StatusField_ReadMany(t *testing.T) {
 	expRequiredFieldsCode := `
 	return r.ko.Status.VPCID == nil
 `
-	gotCode := code.CheckRequiredFieldsMissingFromShape(
+	gotCode, err := code.CheckRequiredFieldsMissingFromShape(
 		crd, model.OpTypeList, "r.ko", 1,
 	)
+	require.Nil(err)
 	assert.Equal(
 		strings.TrimSpace(expRequiredFieldsCode),
 		strings.TrimSpace(gotCode),
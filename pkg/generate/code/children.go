@@ -0,0 +1,121 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+// GetChildren returns Go code that appends to a `[]*string` variable the
+// child identifiers found at the path of a child field. The source variable is
+// the output shape of the child field's list operation or, when the children
+// are read from the Status of the resource, the CR.
+//
+// Output code will look something like this:
+//
+// for _, iter0 := range ko.Status.AssociatedRoles {
+//     if iter0 != nil {
+//         if iter0.RoleARN != nil {
+//             children = append(children, iter0.RoleARN)
+//         }
+//     }
+// }
+func GetChildren(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	child *model.ChildField,
+	// String representing the name of the variable that we will grab the
+	// child identifiers from. This will typically be "resp" or "r.ko".
+	sourceVarName string,
+	// String representing the name of the `[]*string` variable the child
+	// identifiers are appended to
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	var shapeRef *awssdkmodel.ShapeRef
+	var sourceAdaptedVarName string
+	if child.ListOp != nil {
+		shapeRef = child.ListOp.Operation.OutputRef.Shape.MemberRefs[child.Path[0]]
+		sourceAdaptedVarName = sourceVarName + "." + child.Path[0]
+	} else {
+		statusField := r.StatusFields[child.Path[0]]
+		shapeRef = statusField.ShapeRef
+		sourceAdaptedVarName = sourceVarName + cfg.PrefixConfig.StatusField +
+			"." + statusField.Names.Camel
+	}
+	// Members of the aws-sdk-go structures keep their original names while
+	// the members of the CRD's type definitions are Camel-cased
+	return getChildrenFromPath(
+		shapeRef, sourceAdaptedVarName, child.Path[1:], child.ListOp == nil,
+		targetVarName, indentLevel, 0,
+	)
+}
+
+// getChildrenFromPath returns Go code appending to the target variable the
+// strings found by walking the supplied member path from the source variable
+func getChildrenFromPath(
+	shapeRef *awssdkmodel.ShapeRef,
+	sourceVarName string,
+	path []string,
+	cleanNames bool,
+	targetVarName string,
+	indentLevel int,
+	depth int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	shape := shapeRef.Shape
+	if shape.Type == "list" {
+		//   for _, iter0 := range resp.AttachedPolicies {
+		//       if iter0 != nil {
+		iterVarName := fmt.Sprintf("iter%d", depth)
+		out += fmt.Sprintf(
+			"%sfor _, %s := range %s {\n", indent, iterVarName, sourceVarName,
+		)
+		out += getChildrenFromPath(
+			&shape.MemberRef, iterVarName, path, cleanNames,
+			targetVarName, indentLevel+1, depth+1,
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+		return out
+	}
+	out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceVarName)
+	if len(path) == 0 {
+		//   children = append(children, iter0.PolicyArn)
+		out += fmt.Sprintf(
+			"%s\t%s = append(%s, %s)\n",
+			indent, targetVarName, targetVarName, sourceVarName,
+		)
+	} else {
+		memberName := path[0]
+		fieldName := memberName
+		if cleanNames {
+			fieldName = names.New(memberName).Camel
+		}
+		out += getChildrenFromPath(
+			shape.MemberRefs[memberName], sourceVarName+"."+fieldName,
+			path[1:], cleanNames, targetVarName, indentLevel+1, depth,
+		)
+	}
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
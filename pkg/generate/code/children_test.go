@@ -0,0 +1,50 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestGetChildren_RDS_DBCluster_Roles(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{GeneratorConfigFile: "generator-with-children.yaml"})
+
+	crd := testutil.GetCRDByName(t, g, "DBCluster")
+	require.NotNil(crd)
+
+	children := crd.ChildFields()
+	require.Len(children, 1)
+
+	expected := `	for _, iter0 := range r.ko.Status.AssociatedRoles {
+		if iter0 != nil {
+			if iter0.RoleARN != nil {
+				children = append(children, iter0.RoleARN)
+			}
+		}
+	}
+`
+	assert.Equal(
+		expected,
+		code.GetChildren(crd.Config(), crd, children[0], "r.ko", "children", 1),
+	)
+}
@@ -14,6 +14,7 @@
 package code
 
 import (
+	"fmt"
 	"strings"
 
 	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
@@ -128,7 +129,7 @@ func FindPrimaryIdentifierFieldNames(
 	cfg *ackgenconfig.Config,
 	r *model.CRD,
 	op *awssdkmodel.Operation,
-) (crField string, shapeField string) {
+) (crField string, shapeField string, err error) {
 	shape := op.InputRef.Shape
 
 	if shapeField == "" {
@@ -143,10 +144,11 @@ func FindPrimaryIdentifierFieldNames(
 			case 1:
 				shapeField = identifiers[0]
 			default:
-				panic("Found multiple possible primary identifiers for " +
-					r.Names.Original + ". Set " +
-					"`is_primary_key` for the primary field in the " +
-					r.Names.Camel + " resource.")
+				return "", "", fmt.Errorf(
+					"found multiple possible primary identifiers for %s: "+
+						"set `is_primary_key` for the primary field in the %s resource",
+					r.Names.Original, r.Names.Camel,
+				)
 			}
 		} else {
 			// For ReadMany, search for pluralized identifiers
@@ -155,14 +157,16 @@ func FindPrimaryIdentifierFieldNames(
 
 		// Require override if still can't find any identifiers
 		if shapeField == "" {
-			panic("Could not find primary identifier for " + r.Names.Original +
-				". Set `is_primary_key` for the primary field in the " +
-				r.Names.Camel + " resource.")
+			return "", "", fmt.Errorf(
+				"could not find primary identifier for %s: "+
+					"set `is_primary_key` for the primary field in the %s resource",
+				r.Names.Original, r.Names.Camel,
+			)
 		}
 	}
 
 	if r.IsPrimaryARNField(shapeField) {
-		return "", PrimaryIdentifierARNOverride
+		return "", PrimaryIdentifierARNOverride, nil
 	}
 
 	if crField == "" {
@@ -175,9 +179,12 @@ func FindPrimaryIdentifierFieldNames(
 		if inSpec || inStatus {
 			crField = renamedName
 		} else {
-			panic("Could not find corresponding spec or status field for primary identifier " + shapeField)
+			return "", "", fmt.Errorf(
+				"could not find corresponding %s spec or status field for primary identifier %s",
+				r.Names.Camel, shapeField,
+			)
 		}
 	}
 
-	return crField, shapeField
+	return crField, shapeField, nil
 }
@@ -15,6 +15,7 @@ package code
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -91,7 +92,7 @@ func CompareResource(
 	secondResVarName string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	out := "\n"
 
 	fieldConfigs := cfg.ResourceFields(r.Names.Original)
@@ -131,10 +132,51 @@ func CompareResource(
 		memberShapeRef := specField.ShapeRef
 		memberShape := memberShapeRef.Shape
 
+		// Tags are compared regardless of their order
+		if tagsCode := compareTags(
+			r, specField,
+			deltaVarName,
+			firstResAdaptedVarName,
+			secondResAdaptedVarName,
+			fieldPath,
+			indentLevel,
+		); tagsCode != "" {
+			out += tagsCode
+			continue
+		}
+
+		// Fields whose Go type is overridden are compared semantically
+		if overrideCode := compareTypeOverride(
+			specField,
+			deltaVarName,
+			firstResAdaptedVarName,
+			secondResAdaptedVarName,
+			fieldPath,
+			indentLevel,
+		); overrideCode != "" {
+			out += overrideCode
+			continue
+		}
+
+		// Fields with a default value aren't compared when they are unset in
+		// the first resource
+		defaultCode := compareDefault(
+			specField.Default(),
+			deltaVarName,
+			firstResAdaptedVarName,
+			secondResAdaptedVarName,
+			fieldPath,
+			indentLevel,
+		)
+		if defaultCode != "" {
+			out += defaultCode
+			indentLevel++
+		}
+
 		// if ackcompare.HasNilDifference(a.ko.Spec.Name, b.ko.Spec.Name == nil) {
 		//   delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 		// }
-		nilCode := compareNil(
+		nilCode, err := compareNil(
 			compareConfig,
 			memberShape,
 			deltaVarName,
@@ -143,6 +185,11 @@ func CompareResource(
 			fieldPath,
 			indentLevel,
 		)
+		if err != nil {
+			return "", fmt.Errorf(
+				"cannot compare resource %s: %v", r.Names.Original, err,
+			)
+		}
 
 		if nilCode != "" {
 			// else if a.ko.Spec.Name != nil && b.ko.Spec.Name != nil {
@@ -157,7 +204,7 @@ func CompareResource(
 		case "structure":
 			// Recurse through all the struct's fields and subfields, building
 			// nested conditionals and calls to `delta.Add()`...
-			out += compareStruct(
+			code, err := compareStruct(
 				cfg, r,
 				compareConfig,
 				memberShape,
@@ -167,9 +214,15 @@ func CompareResource(
 				fieldPath,
 				indentLevel,
 			)
+			if err != nil {
+				return "", fmt.Errorf(
+					"cannot compare resource %s: %v", r.Names.Original, err,
+				)
+			}
+			out += code
 		case "list":
 			// Returns Go code that compares all the elements of the slice fields...
-			out += compareSlice(
+			code, err := compareSlice(
 				cfg, r,
 				compareConfig,
 				memberShape,
@@ -179,9 +232,15 @@ func CompareResource(
 				fieldPath,
 				indentLevel,
 			)
+			if err != nil {
+				return "", fmt.Errorf(
+					"cannot compare resource %s: %v", r.Names.Original, err,
+				)
+			}
+			out += code
 		case "map":
 			// Returns Go code that compares all the elements of the map fields...
-			out += compareMap(
+			code, err := compareMap(
 				cfg, r,
 				compareConfig,
 				memberShape,
@@ -191,11 +250,17 @@ func CompareResource(
 				fieldPath,
 				indentLevel,
 			)
+			if err != nil {
+				return "", fmt.Errorf(
+					"cannot compare resource %s: %v", r.Names.Original, err,
+				)
+			}
+			out += code
 		default:
 			//   if *a.ko.Spec.Name != *b.ko.Spec.Name) {
 			//     delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 			//   }
-			out += compareScalar(
+			code, err := compareScalar(
 				compareConfig,
 				memberShape,
 				deltaVarName,
@@ -204,8 +269,21 @@ func CompareResource(
 				fieldPath,
 				indentLevel,
 			)
+			if err != nil {
+				return "", fmt.Errorf(
+					"cannot compare resource %s: %v", r.Names.Original, err,
+				)
+			}
+			out += code
 		}
 		if nilCode != "" {
+			// }
+			out += fmt.Sprintf(
+				"%s}\n", strings.Repeat("\t", indentLevel-1),
+			)
+			indentLevel--
+		}
+		if defaultCode != "" {
 			// }
 			out += fmt.Sprintf(
 				"%s}\n", indent,
@@ -213,7 +291,7 @@ func CompareResource(
 			indentLevel--
 		}
 	}
-	return out
+	return out, nil
 }
 
 // compareNil outputs Go code that compares two field values for nullability
@@ -248,7 +326,7 @@ func compareNil(
 	fieldPath string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	out := ""
 	indent := strings.Repeat("\t", indentLevel)
 
@@ -256,7 +334,7 @@ func compareNil(
 	case "list", "blob":
 		// for slice types, there is no nilability test. Instead, the normal
 		// value test checks length of slices.
-		return ""
+		return "", nil
 	case "boolean", "string", "character", "byte", "short", "integer", "long",
 		"float", "double", "timestamp", "structure", "map", "jsonvalue":
 		// if ackcompare.HasNilDifference(a.ko.Spec.Name, b.ko.Spec.Name) {
@@ -265,7 +343,10 @@ func compareNil(
 			indent, firstResVarName, secondResVarName,
 		)
 	default:
-		panic("Unsupported shape type in generate.code.compareNil: " + shape.Type)
+		return "", fmt.Errorf(
+			"cannot compare field %s: unsupported shape type %s",
+			fieldPath, shape.Type,
+		)
 	}
 	//   delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 	out += fmt.Sprintf(
@@ -277,7 +358,7 @@ func compareNil(
 		"%s}", indent,
 	)
 
-	return out
+	return out, nil
 }
 
 // compareScalar outputs Go code that compares two scalar values from two
@@ -312,7 +393,7 @@ func compareScalar(
 	fieldPath string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	out := ""
 	indent := strings.Repeat("\t", indentLevel)
 
@@ -335,7 +416,10 @@ func compareScalar(
 			indent, firstResVarName, secondResVarName,
 		)
 	default:
-		panic("Unsupported shape type in generate.code.compareScalar: " + shape.Type)
+		return "", fmt.Errorf(
+			"cannot compare field %s: unsupported shape type %s",
+			fieldPath, shape.Type,
+		)
 	}
 	//   delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 	out += fmt.Sprintf(
@@ -347,7 +431,7 @@ func compareScalar(
 		"%s}\n", indent,
 	)
 
-	return out
+	return out, nil
 }
 
 // compareMap outputs Go code that compares two map values from two resource
@@ -384,14 +468,17 @@ func compareMap(
 	fieldPath string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	out := ""
 	indent := strings.Repeat("\t", indentLevel)
 
 	keyType := shape.KeyRef.Shape.Type
 
 	if keyType != "string" {
-		panic("generate.code.compareMap cannot deal with non-string key types: " + keyType)
+		return "", fmt.Errorf(
+			"cannot compare field %s: unsupported map key type %s, only string keys are supported",
+			fieldPath, keyType,
+		)
 	}
 
 	valType := shape.ValueRef.Shape.Type
@@ -404,6 +491,19 @@ func compareMap(
 			indent, firstResVarName, secondResVarName,
 		)
 	case "structure":
+		if r.HasNestedDefaults(fieldConfigPath(cfg, fieldPath)) {
+			// The struct values need to be compared member by member
+			return compareStructElements(
+				cfg, r,
+				shape.ValueRef.Shape,
+				deltaVarName,
+				firstResVarName,
+				secondResVarName,
+				fieldPath,
+				true,
+				indentLevel,
+			)
+		}
 		// NOTE(jaypipes): Using reflect here is really punting. We should
 		// implement this in a cleaner, more efficient fashion by walking the
 		// keys and struct values and comparing each struct individually,
@@ -414,7 +514,10 @@ func compareMap(
 			indent, firstResVarName, secondResVarName,
 		)
 	default:
-		panic("Unsupported shape type in generate.code.compareMap: " + shape.Type)
+		return "", fmt.Errorf(
+			"cannot compare field %s: unsupported map value type %s",
+			fieldPath, valType,
+		)
 	}
 	//   delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 	out += fmt.Sprintf(
@@ -426,7 +529,7 @@ func compareMap(
 		"%s}\n", indent,
 	)
 
-	return out
+	return out, nil
 }
 
 // compareSlice outputs Go code that compares two slice values from two
@@ -463,7 +566,7 @@ func compareSlice(
 	fieldPath string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	out := ""
 	indent := strings.Repeat("\t", indentLevel)
 
@@ -477,6 +580,43 @@ func compareSlice(
 			indent, firstResVarName, secondResVarName,
 		)
 	case "structure":
+		if compareConfig != nil && compareConfig.Key != "" {
+			// The struct elements are matched by key and compared member by
+			// member
+			return compareKeyedElements(
+				cfg, r,
+				shape.MemberRef.Shape,
+				compareConfig.Key,
+				deltaVarName,
+				firstResVarName,
+				secondResVarName,
+				fieldPath,
+				indentLevel,
+			)
+		}
+		if compareConfig != nil && compareConfig.IsSet {
+			// The struct elements can be in any order
+			return compareSetElements(
+				deltaVarName,
+				firstResVarName,
+				secondResVarName,
+				fieldPath,
+				indentLevel,
+			), nil
+		}
+		if r.HasNestedDefaults(fieldConfigPath(cfg, fieldPath)) {
+			// The struct elements need to be compared member by member
+			return compareStructElements(
+				cfg, r,
+				shape.MemberRef.Shape,
+				deltaVarName,
+				firstResVarName,
+				secondResVarName,
+				fieldPath,
+				false,
+				indentLevel,
+			)
+		}
 		// NOTE(jaypipes): Using reflect here is really punting. We should
 		// implement this in a cleaner, more efficient fashion by walking the
 		// struct values and comparing each struct individually, building up
@@ -488,7 +628,10 @@ func compareSlice(
 			indent, firstResVarName, secondResVarName,
 		)
 	default:
-		panic("Unsupported shape type in generate.code.compareSlice: " + shape.Type)
+		return "", fmt.Errorf(
+			"cannot compare field %s: unsupported list element type %s",
+			fieldPath, elemType,
+		)
 	}
 	//   delta.Add("Spec.SecurityGroupIDs", a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs)
 	out += fmt.Sprintf(
@@ -500,7 +643,7 @@ func compareSlice(
 		"%s}\n", indent,
 	)
 
-	return out
+	return out, nil
 }
 
 // compareStruct outputs Go code that compares two struct values from two
@@ -531,7 +674,7 @@ func compareStruct(
 	fieldPath string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	out := ""
 
 	fieldConfigs := cfg.ResourceFields(r.Names.Original)
@@ -552,7 +695,8 @@ func compareStruct(
 		secondResAdaptedVarName := secondResVarName + "." + memberNameClean
 
 		var compareConfig *ackgenconfig.CompareFieldConfig
-		fieldConfig := fieldConfigs[memberFieldPath]
+		configPath := fieldConfigPath(cfg, memberFieldPath)
+		fieldConfig := fieldConfigs[configPath]
 		if fieldConfig != nil {
 			compareConfig = fieldConfig.Compare
 		}
@@ -563,10 +707,29 @@ func compareStruct(
 
 		memberShape := memberShapeRef.Shape
 
+		// Fields with a default value aren't compared when they are unset in
+		// the first resource
+		var memberDefault *model.FieldDefault
+		if field, found := r.Fields[configPath]; found {
+			memberDefault = field.Default()
+		}
+		defaultCode := compareDefault(
+			memberDefault,
+			deltaVarName,
+			firstResAdaptedVarName,
+			secondResAdaptedVarName,
+			memberFieldPath,
+			indentLevel,
+		)
+		if defaultCode != "" {
+			out += defaultCode
+			indentLevel++
+		}
+
 		// if ackcompare.HasNilDifference(a.ko.Spec.Name, b.ko.Spec.Name == nil) {
 		//   delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 		// }
-		nilCode := compareNil(
+		nilCode, err := compareNil(
 			compareConfig,
 			memberShape,
 			deltaVarName,
@@ -575,6 +738,9 @@ func compareStruct(
 			memberFieldPath,
 			indentLevel,
 		)
+		if err != nil {
+			return "", err
+		}
 
 		if nilCode != "" {
 			// else if a.ko.Spec.Name != nil && b.ko.Spec.Name != nil {
@@ -588,7 +754,7 @@ func compareStruct(
 		case "structure":
 			// Recurse through all the struct's fields and subfields, building
 			// nested conditionals and calls to `delta.Add()`...
-			out += compareStruct(
+			code, err := compareStruct(
 				cfg, r,
 				compareConfig,
 				memberShape,
@@ -598,9 +764,13 @@ func compareStruct(
 				memberFieldPath,
 				indentLevel,
 			)
+			if err != nil {
+				return "", err
+			}
+			out += code
 		case "list":
 			// Returns Go code that compares all the elements of the slice fields...
-			out += compareSlice(
+			code, err := compareSlice(
 				cfg, r,
 				compareConfig,
 				memberShape,
@@ -610,9 +780,13 @@ func compareStruct(
 				memberFieldPath,
 				indentLevel,
 			)
+			if err != nil {
+				return "", err
+			}
+			out += code
 		case "map":
 			// Returns Go code that compares all the elements of the map fields...
-			out += compareMap(
+			code, err := compareMap(
 				cfg, r,
 				compareConfig,
 				memberShape,
@@ -622,11 +796,15 @@ func compareStruct(
 				memberFieldPath,
 				indentLevel,
 			)
+			if err != nil {
+				return "", err
+			}
+			out += code
 		default:
 			//   if *a.ko.Spec.Name != *b.ko.Spec.Name {
 			//     delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 			//   }
-			out += compareScalar(
+			code, err := compareScalar(
 				compareConfig,
 				memberShape,
 				deltaVarName,
@@ -635,8 +813,19 @@ func compareStruct(
 				memberFieldPath,
 				indentLevel,
 			)
+			if err != nil {
+				return "", err
+			}
+			out += code
 		}
 		if nilCode != "" {
+			// }
+			out += fmt.Sprintf(
+				"%s}\n", strings.Repeat("\t", indentLevel-1),
+			)
+			indentLevel--
+		}
+		if defaultCode != "" {
 			// }
 			out += fmt.Sprintf(
 				"%s}\n", indent,
@@ -644,5 +833,494 @@ func compareStruct(
 			indentLevel--
 		}
 	}
+	return out, nil
+}
+
+// compareDefault outputs Go code opening the comparison of a field with a
+// default value, which isn't compared when it is unset in the first resource.
+// When the default value is a literal, it is compared to the value of the
+// field in the second resource instead. The caller is responsible for closing
+// the block. Returns an empty string if the field has no default value.
+//
+// Output code will look something like this:
+//
+// if a.ko.Spec.ImageTagMutability == nil {
+//     if b.ko.Spec.ImageTagMutability != nil && *b.ko.Spec.ImageTagMutability != "MUTABLE" {
+//         delta.Add("Spec.ImageTagMutability", a.ko.Spec.ImageTagMutability, b.ko.Spec.ImageTagMutability)
+//     }
+// } else {
+func compareDefault(
+	// The default value of the field, or nil
+	fieldDefault *model.FieldDefault,
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta`. We will generate Go code that calls the `Add()`
+	// method of this variable when differences between fields are detected.
+	deltaVarName string,
+	// String representing the name of the variable that represents the first
+	// CR under comparison. This will typically be something like
+	// "a.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
+	firstResVarName string,
+	// String representing the name of the variable that represents the second
+	// CR under comparison. This will typically be something like
+	// "b.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
+	secondResVarName string,
+	// String indicating the current field path being evaluated, e.g.
+	// "Author.Name". This does not include the top-level Spec or Status
+	// struct.
+	fieldPath string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	if fieldDefault == nil {
+		return ""
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	if fieldDefault.IsComputed() {
+		// if a.ko.Spec.Name != nil {
+		return fmt.Sprintf("%sif %s != nil {\n", indent, firstResVarName)
+	}
+	out := ""
+	// if a.ko.Spec.Name == nil {
+	out += fmt.Sprintf("%sif %s == nil {\n", indent, firstResVarName)
+	//   if b.ko.Spec.Name != nil && *b.ko.Spec.Name != "default" {
+	out += fmt.Sprintf(
+		"%s\tif %s != nil && *%s != %s {\n",
+		indent, secondResVarName, secondResVarName, fieldDefault.Value,
+	)
+	//     delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
+	out += fmt.Sprintf(
+		"%s\t\t%s.Add(\"%s\", %s, %s)\n",
+		indent, deltaVarName, fieldPath, firstResVarName, secondResVarName,
+	)
+	//   }
+	// } else {
+	out += fmt.Sprintf("%s\t}\n%s} else {\n", indent, indent)
 	return out
 }
+
+// compareStructElements outputs Go code that compares, member by member, the
+// struct elements of two slice or map values from two resource fields and, if
+// there is a difference, adds the difference to a variable representing an
+// `ackcompare.Delta`. It is used instead of `reflect.DeepEqual` when members
+// of the elements have a default value.
+//
+// Output code will look something like this:
+//
+// if len(a.ko.Spec.Rules) != len(b.ko.Spec.Rules) {
+//     delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+// } else {
+//     for iter1 := range a.ko.Spec.Rules {
+//         elemDelta1 := ackcompare.NewDelta()
+//         if ackcompare.HasNilDifference(a.ko.Spec.Rules[iter1], b.ko.Spec.Rules[iter1]) {
+//             elemDelta1.Add("Spec.Rules", a.ko.Spec.Rules[iter1], b.ko.Spec.Rules[iter1])
+//         } else if a.ko.Spec.Rules[iter1] != nil && b.ko.Spec.Rules[iter1] != nil {
+//             ...
+//         }
+//         if len(elemDelta1.Differences) > 0 {
+//             delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+//             break
+//         }
+//     }
+// }
+func compareStructElements(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// struct describing the SDK type of the elements being compared
+	elemShape *awssdkmodel.Shape,
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta`. We will generate Go code that calls the `Add()`
+	// method of this variable when differences between fields are detected.
+	deltaVarName string,
+	// String representing the name of the variable that represents the first
+	// CR under comparison. This will typically be something like
+	// "a.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
+	firstResVarName string,
+	// String representing the name of the variable that represents the second
+	// CR under comparison. This will typically be something like
+	// "b.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
+	secondResVarName string,
+	// String indicating the current field path being evaluated, e.g.
+	// "Author.Name". This does not include the top-level Spec or Status
+	// struct.
+	fieldPath string,
+	// true if the values are maps, false if they are slices
+	isMap bool,
+	// Number of levels of indentation to use
+	indentLevel int,
+) (string, error) {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	iterVarName := fmt.Sprintf("iter%d", indentLevel)
+	elemDeltaVarName := fmt.Sprintf("elemDelta%d", indentLevel)
+	firstElemVarName := fmt.Sprintf("%s[%s]", firstResVarName, iterVarName)
+	secondElemVarName := fmt.Sprintf("%s[%s]", secondResVarName, iterVarName)
+
+	// if len(a.ko.Spec.Rules) != len(b.ko.Spec.Rules) {
+	out += fmt.Sprintf(
+		"%sif len(%s) != len(%s) {\n",
+		indent, firstResVarName, secondResVarName,
+	)
+	//   delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+	out += fmt.Sprintf(
+		"%s\t%s.Add(\"%s\", %s, %s)\n",
+		indent, deltaVarName, fieldPath, firstResVarName, secondResVarName,
+	)
+	// } else {
+	//   for iter1 := range a.ko.Spec.Rules {
+	//     elemDelta1 := ackcompare.NewDelta()
+	out += fmt.Sprintf("%s} else {\n", indent)
+	out += fmt.Sprintf("%s\tfor %s := range %s {\n", indent, iterVarName, firstResVarName)
+	out += fmt.Sprintf("%s\t\t%s := ackcompare.NewDelta()\n", indent, elemDeltaVarName)
+	if isMap {
+		// if _, found := b.ko.Spec.Rules[iter1]; !found || ackcompare.HasNilDifference(a.ko.Spec.Rules[iter1], b.ko.Spec.Rules[iter1]) {
+		out += fmt.Sprintf(
+			"%s\t\tif _, found := %s; !found || ackcompare.HasNilDifference(%s, %s) {\n",
+			indent, secondElemVarName, firstElemVarName, secondElemVarName,
+		)
+	} else {
+		// if ackcompare.HasNilDifference(a.ko.Spec.Rules[iter1], b.ko.Spec.Rules[iter1]) {
+		out += fmt.Sprintf(
+			"%s\t\tif ackcompare.HasNilDifference(%s, %s) {\n",
+			indent, firstElemVarName, secondElemVarName,
+		)
+	}
+	//   elemDelta1.Add("Spec.Rules", a.ko.Spec.Rules[iter1], b.ko.Spec.Rules[iter1])
+	out += fmt.Sprintf(
+		"%s\t\t\t%s.Add(\"%s\", %s, %s)\n",
+		indent, elemDeltaVarName, fieldPath, firstElemVarName, secondElemVarName,
+	)
+	// } else if a.ko.Spec.Rules[iter1] != nil && b.ko.Spec.Rules[iter1] != nil {
+	out += fmt.Sprintf(
+		"%s\t\t} else if %s != nil && %s != nil {\n",
+		indent, firstElemVarName, secondElemVarName,
+	)
+	// The members of the elements have field paths like "Rules..Priority"
+	code, err := compareStruct(
+		cfg, r,
+		nil,
+		elemShape,
+		elemDeltaVarName,
+		firstElemVarName,
+		secondElemVarName,
+		fieldPath+".",
+		indentLevel+3,
+	)
+	if err != nil {
+		return "", err
+	}
+	out += code
+	// }
+	out += fmt.Sprintf("%s\t\t}\n", indent)
+	// if len(elemDelta1.Differences) > 0 {
+	//   delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+	//   break
+	// }
+	out += fmt.Sprintf(
+		"%s\t\tif len(%s.Differences) > 0 {\n", indent, elemDeltaVarName,
+	)
+	out += fmt.Sprintf(
+		"%s\t\t\t%s.Add(\"%s\", %s, %s)\n",
+		indent, deltaVarName, fieldPath, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s\t\t\tbreak\n%s\t\t}\n", indent, indent)
+	//   }
+	// }
+	out += fmt.Sprintf("%s\t}\n%s}\n", indent, indent)
+	return out, nil
+}
+
+// compareKeyedElements outputs Go code that matches the struct elements of
+// two slice values from two resource fields by the value of their key member,
+// regardless of their order, and compares the matching elements member by
+// member. Differences between matching elements are added to a variable
+// representing an `ackcompare.Delta` with a path identifying the element by
+// key, e.g. "Spec.Rules[name=foo].Priority". The whole slice values are also
+// added as a difference, so that `Delta.DifferentAt()` reports a difference
+// for the slice field itself.
+//
+// Output code will look something like this:
+//
+// if len(a.ko.Spec.Rules) != len(b.ko.Spec.Rules) {
+//     delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+// } else {
+//     differences1 := len(delta.Differences)
+//     for iter1 := range a.ko.Spec.Rules {
+//         match1 := -1
+//         for iterB1 := range b.ko.Spec.Rules {
+//             if a.ko.Spec.Rules[iter1] != nil && b.ko.Spec.Rules[iterB1] != nil && a.ko.Spec.Rules[iter1].Name != nil && b.ko.Spec.Rules[iterB1].Name != nil && *a.ko.Spec.Rules[iter1].Name == *b.ko.Spec.Rules[iterB1].Name {
+//                 match1 = iterB1
+//                 break
+//             }
+//         }
+//         if match1 < 0 {
+//             delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+//             break
+//         }
+//         key1 := *a.ko.Spec.Rules[iter1].Name
+//         if ackcompare.HasNilDifference(a.ko.Spec.Rules[iter1].Priority, b.ko.Spec.Rules[match1].Priority) {
+//             delta.Add("Spec.Rules[name="+key1+"].Priority", a.ko.Spec.Rules[iter1].Priority, b.ko.Spec.Rules[match1].Priority)
+//         } ...
+//     }
+//     if len(delta.Differences) > differences1 && !delta.DifferentAt("Spec.Rules") {
+//         delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+//     }
+// }
+func compareKeyedElements(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// struct describing the SDK type of the elements being compared
+	elemShape *awssdkmodel.Shape,
+	// name of the member of the elements identifying an element
+	keyMemberName string,
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta`. We will generate Go code that calls the `Add()`
+	// method of this variable when differences between fields are detected.
+	deltaVarName string,
+	// String representing the name of the variable that represents the first
+	// CR under comparison. This will typically be something like
+	// "a.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
+	firstResVarName string,
+	// String representing the name of the variable that represents the second
+	// CR under comparison. This will typically be something like
+	// "b.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
+	secondResVarName string,
+	// String indicating the current field path being evaluated, e.g.
+	// "Author.Name". This does not include the top-level Spec or Status
+	// struct.
+	fieldPath string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) (string, error) {
+	if _, found := elemShape.MemberRefs[keyMemberName]; !found {
+		return "", fmt.Errorf(
+			"cannot compare field %s: compare key %s is not a member of %s",
+			fieldPath, keyMemberName, elemShape.ShapeName,
+		)
+	}
+	keyNames := names.New(keyMemberName)
+
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	iterVarName := fmt.Sprintf("iter%d", indentLevel)
+	secondIterVarName := fmt.Sprintf("iterB%d", indentLevel)
+	matchVarName := fmt.Sprintf("match%d", indentLevel)
+	keyVarName := fmt.Sprintf("key%d", indentLevel)
+	differencesVarName := fmt.Sprintf("differences%d", indentLevel)
+	firstElemVarName := fmt.Sprintf("%s[%s]", firstResVarName, iterVarName)
+	secondElemVarName := fmt.Sprintf("%s[%s]", secondResVarName, secondIterVarName)
+	firstKeyVarName := firstElemVarName + "." + keyNames.Camel
+	secondKeyVarName := secondElemVarName + "." + keyNames.Camel
+
+	// if len(a.ko.Spec.Rules) != len(b.ko.Spec.Rules) {
+	//   delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+	// } else {
+	out += fmt.Sprintf(
+		"%sif len(%s) != len(%s) {\n",
+		indent, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf(
+		"%s\t%s.Add(\"%s\", %s, %s)\n",
+		indent, deltaVarName, fieldPath, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s} else {\n", indent)
+	//   differences1 := len(delta.Differences)
+	//   for iter1 := range a.ko.Spec.Rules {
+	//     match1 := -1
+	//     for iterB1 := range b.ko.Spec.Rules {
+	out += fmt.Sprintf(
+		"%s\t%s := len(%s.Differences)\n",
+		indent, differencesVarName, deltaVarName,
+	)
+	out += fmt.Sprintf("%s\tfor %s := range %s {\n", indent, iterVarName, firstResVarName)
+	out += fmt.Sprintf("%s\t\t%s := -1\n", indent, matchVarName)
+	out += fmt.Sprintf(
+		"%s\t\tfor %s := range %s {\n",
+		indent, secondIterVarName, secondResVarName,
+	)
+	//       if a.ko.Spec.Rules[iter1] != nil && b.ko.Spec.Rules[iterB1] != nil && ... {
+	//         match1 = iterB1
+	//         break
+	//       }
+	//     }
+	out += fmt.Sprintf(
+		"%s\t\t\tif %s != nil && %s != nil && %s != nil && %s != nil && *%s == *%s {\n",
+		indent, firstElemVarName, secondElemVarName,
+		firstKeyVarName, secondKeyVarName,
+		firstKeyVarName, secondKeyVarName,
+	)
+	out += fmt.Sprintf("%s\t\t\t\t%s = %s\n", indent, matchVarName, secondIterVarName)
+	out += fmt.Sprintf("%s\t\t\t\tbreak\n%s\t\t\t}\n%s\t\t}\n", indent, indent, indent)
+	//     if match1 < 0 {
+	//       delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+	//       break
+	//     }
+	out += fmt.Sprintf("%s\t\tif %s < 0 {\n", indent, matchVarName)
+	out += fmt.Sprintf(
+		"%s\t\t\t%s.Add(\"%s\", %s, %s)\n",
+		indent, deltaVarName, fieldPath, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s\t\t\tbreak\n%s\t\t}\n", indent, indent)
+	//     key1 := *a.ko.Spec.Rules[iter1].Name
+	out += fmt.Sprintf("%s\t\t%s := *%s\n", indent, keyVarName, firstKeyVarName)
+	// The members of the matching elements have paths like
+	// "Spec.Rules[name="+key1+"].Priority", whose field config path is
+	// "Rules..Priority"
+	elemFieldPath := fmt.Sprintf(
+		"%s[%s=\"+%s+\"]", fieldPath, keyNames.CamelLower, keyVarName,
+	)
+	code, err := compareStruct(
+		cfg, r,
+		nil,
+		elemShape,
+		deltaVarName,
+		firstElemVarName,
+		fmt.Sprintf("%s[%s]", secondResVarName, matchVarName),
+		elemFieldPath,
+		indentLevel+2,
+	)
+	if err != nil {
+		return "", err
+	}
+	out += code
+	//   }
+	out += fmt.Sprintf("%s\t}\n", indent)
+	//   if len(delta.Differences) > differences1 && !delta.DifferentAt("Spec.Rules") {
+	//     delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+	//   }
+	// }
+	out += fmt.Sprintf(
+		"%s\tif len(%s.Differences) > %s && !%s.DifferentAt(\"%s\") {\n",
+		indent, deltaVarName, differencesVarName, deltaVarName, fieldPath,
+	)
+	out += fmt.Sprintf(
+		"%s\t\t%s.Add(\"%s\", %s, %s)\n",
+		indent, deltaVarName, fieldPath, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s\t}\n%s}\n", indent, indent)
+	return out, nil
+}
+
+// compareSetElements outputs Go code that compares the struct elements of two
+// slice values from two resource fields regardless of their order and, if
+// there is a difference, adds the difference to a variable representing an
+// `ackcompare.Delta`.
+//
+// Output code will look something like this:
+//
+// if len(a.ko.Spec.Rules) != len(b.ko.Spec.Rules) {
+//     delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+// } else {
+//     matched1 := make([]bool, len(b.ko.Spec.Rules))
+//     for iter1 := range a.ko.Spec.Rules {
+//         match1 := -1
+//         for iterB1 := range b.ko.Spec.Rules {
+//             if !matched1[iterB1] && reflect.DeepEqual(a.ko.Spec.Rules[iter1], b.ko.Spec.Rules[iterB1]) {
+//                 match1 = iterB1
+//                 break
+//             }
+//         }
+//         if match1 < 0 {
+//             delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+//             break
+//         }
+//         matched1[match1] = true
+//     }
+// }
+func compareSetElements(
+	// String representing the name of the variable that is of type
+	// `*ackcompare.Delta`. We will generate Go code that calls the `Add()`
+	// method of this variable when differences between fields are detected.
+	deltaVarName string,
+	// String representing the name of the variable that represents the first
+	// CR under comparison. This will typically be something like
+	// "a.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
+	firstResVarName string,
+	// String representing the name of the variable that represents the second
+	// CR under comparison. This will typically be something like
+	// "b.ko.Spec.Name". See `templates/pkg/resource/delta.go.tpl`.
+	secondResVarName string,
+	// String indicating the current field path being evaluated, e.g.
+	// "Author.Name". This does not include the top-level Spec or Status
+	// struct.
+	fieldPath string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	iterVarName := fmt.Sprintf("iter%d", indentLevel)
+	secondIterVarName := fmt.Sprintf("iterB%d", indentLevel)
+	matchVarName := fmt.Sprintf("match%d", indentLevel)
+	matchedVarName := fmt.Sprintf("matched%d", indentLevel)
+
+	// if len(a.ko.Spec.Rules) != len(b.ko.Spec.Rules) {
+	//   delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+	// } else {
+	out += fmt.Sprintf(
+		"%sif len(%s) != len(%s) {\n",
+		indent, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf(
+		"%s\t%s.Add(\"%s\", %s, %s)\n",
+		indent, deltaVarName, fieldPath, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s} else {\n", indent)
+	//   matched1 := make([]bool, len(b.ko.Spec.Rules))
+	//   for iter1 := range a.ko.Spec.Rules {
+	//     match1 := -1
+	//     for iterB1 := range b.ko.Spec.Rules {
+	out += fmt.Sprintf(
+		"%s\t%s := make([]bool, len(%s))\n",
+		indent, matchedVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s\tfor %s := range %s {\n", indent, iterVarName, firstResVarName)
+	out += fmt.Sprintf("%s\t\t%s := -1\n", indent, matchVarName)
+	out += fmt.Sprintf(
+		"%s\t\tfor %s := range %s {\n",
+		indent, secondIterVarName, secondResVarName,
+	)
+	//       if !matched1[iterB1] && reflect.DeepEqual(a.ko.Spec.Rules[iter1], b.ko.Spec.Rules[iterB1]) {
+	//         match1 = iterB1
+	//         break
+	//       }
+	//     }
+	out += fmt.Sprintf(
+		"%s\t\t\tif !%s[%s] && reflect.DeepEqual(%s[%s], %s[%s]) {\n",
+		indent, matchedVarName, secondIterVarName,
+		firstResVarName, iterVarName, secondResVarName, secondIterVarName,
+	)
+	out += fmt.Sprintf("%s\t\t\t\t%s = %s\n", indent, matchVarName, secondIterVarName)
+	out += fmt.Sprintf("%s\t\t\t\tbreak\n%s\t\t\t}\n%s\t\t}\n", indent, indent, indent)
+	//     if match1 < 0 {
+	//       delta.Add("Spec.Rules", a.ko.Spec.Rules, b.ko.Spec.Rules)
+	//       break
+	//     }
+	//     matched1[match1] = true
+	//   }
+	// }
+	out += fmt.Sprintf("%s\t\tif %s < 0 {\n", indent, matchVarName)
+	out += fmt.Sprintf(
+		"%s\t\t\t%s.Add(\"%s\", %s, %s)\n",
+		indent, deltaVarName, fieldPath, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s\t\t\tbreak\n%s\t\t}\n", indent, indent)
+	out += fmt.Sprintf("%s\t\t%s[%s] = true\n", indent, matchedVarName, matchVarName)
+	out += fmt.Sprintf("%s\t}\n%s}\n", indent, indent)
+	return out
+}
+
+// elementKeyPattern matches the parts of the field paths of keyed list
+// elements identifying an element, e.g. `[name="+key1+"]`
+var elementKeyPattern = regexp.MustCompile(`\[[^\]]*\]`)
+
+// fieldConfigPath returns the path of the field config of the field at the
+// supplied field path, e.g. "Code.S3Bucket" for "Spec.Code.S3Bucket" or
+// "Rules..Priority" for `Spec.Rules[name="+key1+"].Priority`
+func fieldConfigPath(
+	cfg *ackgenconfig.Config,
+	fieldPath string,
+) string {
+	specPrefix := strings.TrimPrefix(cfg.PrefixConfig.SpecField, ".") + "."
+	fieldPath = elementKeyPattern.ReplaceAllString(fieldPath, ".")
+	return strings.TrimPrefix(fieldPath, specPrefix)
+}
@@ -16,6 +16,7 @@ package code_test
 import (
 	"testing"
 
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -121,12 +122,11 @@ func TestCompareResource_S3_Bucket(t *testing.T) {
 		}
 	}
 `
-	assert.Equal(
-		expected,
-		code.CompareResource(
-			crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
-		),
+	got, err := code.CompareResource(
+		crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
 	)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestCompareResource_Lambda_CodeSigningConfig(t *testing.T) {
@@ -165,12 +165,11 @@ func TestCompareResource_Lambda_CodeSigningConfig(t *testing.T) {
 		}
 	}
 `
-	assert.Equal(
-		expected,
-		code.CompareResource(
-			crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
-		),
+	got, err := code.CompareResource(
+		crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
 	)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestCompareResource_Lambda_Function(t *testing.T) {
@@ -369,10 +368,302 @@ func TestCompareResource_Lambda_Function(t *testing.T) {
 		}
 	}
 `
+	got, err := code.CompareResource(
+		crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
+	)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
+
+func TestCompareResource_ECR_Repository_Tags(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "ecr")
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// Tags are compared regardless of their order
+	expected := `
+	if !equalTags(a.ko.Spec.Tags, b.ko.Spec.Tags) {
+		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
+	}
+`
+	got, err := code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	require.Nil(err)
+	assert.Contains(got, expected)
+
+	g = testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-tags.yaml",
+	})
+
+	crd = testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	expected = `
+	if !ackcompare.MapStringStringPEqual(a.ko.Spec.Tags, b.ko.Spec.Tags) {
+		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
+	}
+`
+	got, err = code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	require.Nil(err)
+	assert.Contains(got, expected)
+}
+
+func TestCompareResource_APIGWv2_Integration_NonStringMapKey(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "apigatewayv2")
+
+	crd := testutil.GetCRDByName(t, g, "Integration")
+	require.NotNil(crd)
+
+	crd.SpecFields["RequestTemplates"].ShapeRef.Shape.KeyRef.Shape = &awssdkmodel.Shape{
+		ShapeName: "Integer",
+		Type:      "integer",
+	}
+	_, err := code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	require.NotNil(err)
 	assert.Equal(
-		expected,
-		code.CompareResource(
-			crd.Config(), crd, "delta", "a.ko", "b.ko", 1,
-		),
+		"cannot compare resource Integration: cannot compare field Spec.RequestTemplates: unsupported map key type integer, only string keys are supported",
+		err.Error(),
 	)
 }
+
+func TestCompareResource_Lambda_Alias_UnsupportedMapValue(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "lambda")
+
+	crd := testutil.GetCRDByName(t, g, "Alias")
+	require.NotNil(crd)
+
+	_, err := code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	require.NotNil(err)
+	assert.Equal(
+		"cannot compare resource Alias: cannot compare field Spec.RoutingConfig.AdditionalVersionWeights: unsupported map value type double",
+		err.Error(),
+	)
+}
+
+func TestCompareResource_APIGWv2_Model_TypeOverrides(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "apigatewayv2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-type-overrides.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Model")
+	require.NotNil(crd)
+
+	// JSON documents are compared as JSON values
+	got, err := code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	require.Nil(err)
+	assert.Contains(
+		got,
+		`
+	if ackcompare.HasNilDifference(a.ko.Spec.Schema, b.ko.Spec.Schema) {
+		delta.Add("Spec.Schema", a.ko.Spec.Schema, b.ko.Spec.Schema)
+	} else if a.ko.Spec.Schema != nil && b.ko.Spec.Schema != nil {
+		if !equalJSON(a.ko.Spec.Schema.Raw, b.ko.Spec.Schema.Raw) {
+			delta.Add("Spec.Schema", a.ko.Spec.Schema, b.ko.Spec.Schema)
+		}
+	}
+`)
+}
+
+func TestCompareResource_APIGWv2_Defaults(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "apigatewayv2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-defaults.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Api")
+	require.NotNil(crd)
+
+	got, err := code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	require.Nil(err)
+
+	// Unset fields with a literal default only differ from a non-default value
+	assert.Contains(got, `
+	if a.ko.Spec.APIKeySelectionExpression == nil {
+		if b.ko.Spec.APIKeySelectionExpression != nil && *b.ko.Spec.APIKeySelectionExpression != "$request.header.x-api-key" {
+			delta.Add("Spec.APIKeySelectionExpression", a.ko.Spec.APIKeySelectionExpression, b.ko.Spec.APIKeySelectionExpression)
+		}
+	} else {
+		if ackcompare.HasNilDifference(a.ko.Spec.APIKeySelectionExpression, b.ko.Spec.APIKeySelectionExpression) {
+			delta.Add("Spec.APIKeySelectionExpression", a.ko.Spec.APIKeySelectionExpression, b.ko.Spec.APIKeySelectionExpression)
+		} else if a.ko.Spec.APIKeySelectionExpression != nil && b.ko.Spec.APIKeySelectionExpression != nil {
+			if *a.ko.Spec.APIKeySelectionExpression != *b.ko.Spec.APIKeySelectionExpression {
+				delta.Add("Spec.APIKeySelectionExpression", a.ko.Spec.APIKeySelectionExpression, b.ko.Spec.APIKeySelectionExpression)
+			}
+		}
+	}
+`)
+	assert.Contains(got, `
+		if a.ko.Spec.CORSConfiguration.MaxAge == nil {
+			if b.ko.Spec.CORSConfiguration.MaxAge != nil && *b.ko.Spec.CORSConfiguration.MaxAge != 0 {
+				delta.Add("Spec.CORSConfiguration.MaxAge", a.ko.Spec.CORSConfiguration.MaxAge, b.ko.Spec.CORSConfiguration.MaxAge)
+			}
+		} else {
+`)
+
+	// Unset fields with a computed default are never different
+	assert.Contains(got, `
+	if a.ko.Spec.Version != nil {
+		if ackcompare.HasNilDifference(a.ko.Spec.Version, b.ko.Spec.Version) {
+			delta.Add("Spec.Version", a.ko.Spec.Version, b.ko.Spec.Version)
+		} else if a.ko.Spec.Version != nil && b.ko.Spec.Version != nil {
+			if *a.ko.Spec.Version != *b.ko.Spec.Version {
+				delta.Add("Spec.Version", a.ko.Spec.Version, b.ko.Spec.Version)
+			}
+		}
+	}
+`)
+
+	crd = testutil.GetCRDByName(t, g, "Route")
+	require.NotNil(crd)
+
+	// Maps of structs with defaulted members are compared element by element
+	got, err = code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	require.Nil(err)
+	assert.Contains(
+		got,
+		`
+	if ackcompare.HasNilDifference(a.ko.Spec.RequestParameters, b.ko.Spec.RequestParameters) {
+		delta.Add("Spec.RequestParameters", a.ko.Spec.RequestParameters, b.ko.Spec.RequestParameters)
+	} else if a.ko.Spec.RequestParameters != nil && b.ko.Spec.RequestParameters != nil {
+		if len(a.ko.Spec.RequestParameters) != len(b.ko.Spec.RequestParameters) {
+			delta.Add("Spec.RequestParameters", a.ko.Spec.RequestParameters, b.ko.Spec.RequestParameters)
+		} else {
+			for iter2 := range a.ko.Spec.RequestParameters {
+				elemDelta2 := ackcompare.NewDelta()
+				if _, found := b.ko.Spec.RequestParameters[iter2]; !found || ackcompare.HasNilDifference(a.ko.Spec.RequestParameters[iter2], b.ko.Spec.RequestParameters[iter2]) {
+					elemDelta2.Add("Spec.RequestParameters", a.ko.Spec.RequestParameters[iter2], b.ko.Spec.RequestParameters[iter2])
+				} else if a.ko.Spec.RequestParameters[iter2] != nil && b.ko.Spec.RequestParameters[iter2] != nil {
+					if a.ko.Spec.RequestParameters[iter2].Required == nil {
+						if b.ko.Spec.RequestParameters[iter2].Required != nil && *b.ko.Spec.RequestParameters[iter2].Required != false {
+							elemDelta2.Add("Spec.RequestParameters..Required", a.ko.Spec.RequestParameters[iter2].Required, b.ko.Spec.RequestParameters[iter2].Required)
+						}
+					} else {
+						if ackcompare.HasNilDifference(a.ko.Spec.RequestParameters[iter2].Required, b.ko.Spec.RequestParameters[iter2].Required) {
+							elemDelta2.Add("Spec.RequestParameters..Required", a.ko.Spec.RequestParameters[iter2].Required, b.ko.Spec.RequestParameters[iter2].Required)
+						} else if a.ko.Spec.RequestParameters[iter2].Required != nil && b.ko.Spec.RequestParameters[iter2].Required != nil {
+							if *a.ko.Spec.RequestParameters[iter2].Required != *b.ko.Spec.RequestParameters[iter2].Required {
+								elemDelta2.Add("Spec.RequestParameters..Required", a.ko.Spec.RequestParameters[iter2].Required, b.ko.Spec.RequestParameters[iter2].Required)
+							}
+						}
+					}
+				}
+				if len(elemDelta2.Differences) > 0 {
+					delta.Add("Spec.RequestParameters", a.ko.Spec.RequestParameters, b.ko.Spec.RequestParameters)
+					break
+				}
+			}
+		}
+	}
+`)
+}
+
+func TestCompareResource_Lambda_Function_KeyedList(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-compare.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+
+	// Elements are matched by key and differences are reported per element
+	got, err := code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	require.Nil(err)
+	assert.Contains(
+		got,
+		`
+	if len(a.ko.Spec.FileSystemConfigs) != len(b.ko.Spec.FileSystemConfigs) {
+		delta.Add("Spec.FileSystemConfigs", a.ko.Spec.FileSystemConfigs, b.ko.Spec.FileSystemConfigs)
+	} else {
+		differences1 := len(delta.Differences)
+		for iter1 := range a.ko.Spec.FileSystemConfigs {
+			match1 := -1
+			for iterB1 := range b.ko.Spec.FileSystemConfigs {
+				if a.ko.Spec.FileSystemConfigs[iter1] != nil && b.ko.Spec.FileSystemConfigs[iterB1] != nil && a.ko.Spec.FileSystemConfigs[iter1].ARN != nil && b.ko.Spec.FileSystemConfigs[iterB1].ARN != nil && *a.ko.Spec.FileSystemConfigs[iter1].ARN == *b.ko.Spec.FileSystemConfigs[iterB1].ARN {
+					match1 = iterB1
+					break
+				}
+			}
+			if match1 < 0 {
+				delta.Add("Spec.FileSystemConfigs", a.ko.Spec.FileSystemConfigs, b.ko.Spec.FileSystemConfigs)
+				break
+			}
+			key1 := *a.ko.Spec.FileSystemConfigs[iter1].ARN
+			if ackcompare.HasNilDifference(a.ko.Spec.FileSystemConfigs[iter1].ARN, b.ko.Spec.FileSystemConfigs[match1].ARN) {
+				delta.Add("Spec.FileSystemConfigs[arn="+key1+"].ARN", a.ko.Spec.FileSystemConfigs[iter1].ARN, b.ko.Spec.FileSystemConfigs[match1].ARN)
+			} else if a.ko.Spec.FileSystemConfigs[iter1].ARN != nil && b.ko.Spec.FileSystemConfigs[match1].ARN != nil {
+				if *a.ko.Spec.FileSystemConfigs[iter1].ARN != *b.ko.Spec.FileSystemConfigs[match1].ARN {
+					delta.Add("Spec.FileSystemConfigs[arn="+key1+"].ARN", a.ko.Spec.FileSystemConfigs[iter1].ARN, b.ko.Spec.FileSystemConfigs[match1].ARN)
+				}
+			}
+			if a.ko.Spec.FileSystemConfigs[iter1].LocalMountPath != nil {
+				if ackcompare.HasNilDifference(a.ko.Spec.FileSystemConfigs[iter1].LocalMountPath, b.ko.Spec.FileSystemConfigs[match1].LocalMountPath) {
+					delta.Add("Spec.FileSystemConfigs[arn="+key1+"].LocalMountPath", a.ko.Spec.FileSystemConfigs[iter1].LocalMountPath, b.ko.Spec.FileSystemConfigs[match1].LocalMountPath)
+				} else if a.ko.Spec.FileSystemConfigs[iter1].LocalMountPath != nil && b.ko.Spec.FileSystemConfigs[match1].LocalMountPath != nil {
+					if *a.ko.Spec.FileSystemConfigs[iter1].LocalMountPath != *b.ko.Spec.FileSystemConfigs[match1].LocalMountPath {
+						delta.Add("Spec.FileSystemConfigs[arn="+key1+"].LocalMountPath", a.ko.Spec.FileSystemConfigs[iter1].LocalMountPath, b.ko.Spec.FileSystemConfigs[match1].LocalMountPath)
+					}
+				}
+			}
+		}
+		if len(delta.Differences) > differences1 && !delta.DifferentAt("Spec.FileSystemConfigs") {
+			delta.Add("Spec.FileSystemConfigs", a.ko.Spec.FileSystemConfigs, b.ko.Spec.FileSystemConfigs)
+		}
+	}
+`)
+}
+
+func TestCompareResource_APIGWv2_DomainName_SetList(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "apigatewayv2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-compare.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DomainName")
+	require.NotNil(crd)
+
+	// Elements are compared regardless of their order
+	got, err := code.CompareResource(crd.Config(), crd, "delta", "a.ko", "b.ko", 1)
+	require.Nil(err)
+	assert.Contains(
+		got,
+		`
+	if len(a.ko.Spec.DomainNameConfigurations) != len(b.ko.Spec.DomainNameConfigurations) {
+		delta.Add("Spec.DomainNameConfigurations", a.ko.Spec.DomainNameConfigurations, b.ko.Spec.DomainNameConfigurations)
+	} else {
+		matched1 := make([]bool, len(b.ko.Spec.DomainNameConfigurations))
+		for iter1 := range a.ko.Spec.DomainNameConfigurations {
+			match1 := -1
+			for iterB1 := range b.ko.Spec.DomainNameConfigurations {
+				if !matched1[iterB1] && reflect.DeepEqual(a.ko.Spec.DomainNameConfigurations[iter1], b.ko.Spec.DomainNameConfigurations[iterB1]) {
+					match1 = iterB1
+					break
+				}
+			}
+			if match1 < 0 {
+				delta.Add("Spec.DomainNameConfigurations", a.ko.Spec.DomainNameConfigurations, b.ko.Spec.DomainNameConfigurations)
+				break
+			}
+			matched1[match1] = true
+		}
+	}
+`)
+}
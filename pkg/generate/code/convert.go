@@ -0,0 +1,766 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model/multiversion"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+// ConvertTo returns the Go code that copies the Spec and Status fields of a
+// spoke version resource into a hub version resource. It is used to generate
+// the body of the `ConvertTo` method implementing the controller-runtime
+// `conversion.Convertible` interface.
+//
+// The Go code we return depends on the change type of each field delta. For
+// fields that kept the same shape (or were only renamed), a deep copy is
+// generated. Structs are copied member by member because the struct types of
+// two API versions live in different Go packages:
+//
+// if src.Spec.ImageScanningConfiguration != nil {
+//     f3 := &v1beta1.ImageScanningConfiguration{}
+//     f3.ScanOnPush = src.Spec.ImageScanningConfiguration.ScanOnPush
+//     dst.Spec.ScanConfig = f3
+// }
+//
+// Fields and struct members that exist in a single version, and fields and
+// struct members whose type changed (from a string to a secret or to an
+// integer, for instance), cannot be converted. Their source values are kept in
+// a map, keyed by field path, that the generated code stores in an annotation
+// of the destination resource. Destination fields without a source value are
+// restored from the annotation of the source resource, which holds the values
+// kept when it was converted from the destination version, so that no data is
+// lost on round-trips:
+//
+// if v, ok := restored["Spec.EncryptionConfiguration"]; ok {
+//     if err := json.Unmarshal(v, &dst.Spec.EncryptionConfiguration); err != nil {
+//         return err
+//     }
+// }
+// kept["Spec.LegacyField"] = src.Spec.LegacyField
+//
+// Values within list elements or map values have no such key, so an error is
+// returned when they cannot be converted.
+func ConvertTo(
+	// The hub version CRD
+	hub *model.CRD,
+	// The deltas computed between the spoke (source) and the hub
+	// (destination) CRDs
+	delta *multiversion.CRDDelta,
+	// The import alias of the hub version API package
+	hubPkgName string,
+	// String representing the name of the spoke resource variable
+	srcVarName string,
+	// String representing the name of the hub resource variable
+	dstVarName string,
+	// String representing the name of the map[string]json.RawMessage
+	// variable holding the values restored from the source resource
+	restoredVarName string,
+	// String representing the name of the map[string]interface{} variable
+	// holding the values kept in the destination resource
+	keptVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) (string, error) {
+	return convertCRD(
+		hub, delta, false, hubPkgName,
+		srcVarName, dstVarName, restoredVarName, keptVarName, indentLevel,
+	)
+}
+
+// ConversionKeepsFields returns true if the Go code returned by ConvertTo
+// and ConvertFrom for the supplied deltas keeps or restores the values of
+// fields that cannot be converted
+func ConversionKeepsFields(
+	delta *multiversion.CRDDelta,
+) bool {
+	for _, deltas := range [][]multiversion.FieldDelta{delta.SpecDeltas, delta.StatusDeltas} {
+		for _, d := range deltas {
+			// What one direction keeps, the other restores
+			if keeps, restores := fieldDeltaLosses(d, false); keeps || restores {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fieldDeltaLosses returns whether converting the fields of the supplied
+// delta keeps source values that cannot be converted, and whether it restores
+// destination values that have no source. When reverse is true the delta is
+// applied from the destination to the source version.
+func fieldDeltaLosses(
+	delta multiversion.FieldDelta,
+	reverse bool,
+) (keeps bool, restores bool) {
+	srcField, dstField := delta.Source, delta.Destination
+	if reverse {
+		srcField, dstField = dstField, srcField
+	}
+	switch delta.ChangeType {
+	case multiversion.FieldChangeTypeAdded,
+		multiversion.FieldChangeTypeRemoved:
+		return srcField != nil, srcField == nil
+	case multiversion.FieldChangeTypeShapeChangedFromStringToSecret,
+		multiversion.FieldChangeTypeShapeChangedFromSecretToString:
+		return true, true
+	case multiversion.FieldChangeTypeNone,
+		multiversion.FieldChangeTypeRenamed,
+		multiversion.FieldChangeTypeShapeChanged:
+		if isSecretField(srcField) && isSecretField(dstField) {
+			return false, false
+		}
+		return shapeConversionLosses(
+			dstField.ShapeRef.Shape, srcField.ShapeRef.Shape,
+		)
+	}
+	return false, false
+}
+
+// shapeConversionLosses returns whether converting a value of sourceShape
+// into targetShape keeps source values that cannot be converted, and whether
+// it restores target values that have no source. This is the case of values
+// whose type differs, and of struct members found in a single shape.
+func shapeConversionLosses(
+	targetShape *awssdkmodel.Shape,
+	sourceShape *awssdkmodel.Shape,
+) (keeps bool, restores bool) {
+	if targetShape.Type != sourceShape.Type {
+		return true, true
+	}
+	switch targetShape.Type {
+	case "structure":
+		for memberName, targetMemberRef := range targetShape.MemberRefs {
+			sourceMemberRef, found := sourceShape.MemberRefs[memberName]
+			if !found {
+				restores = true
+				continue
+			}
+			memberKeeps, memberRestores := shapeConversionLosses(
+				targetMemberRef.Shape, sourceMemberRef.Shape,
+			)
+			keeps = keeps || memberKeeps
+			restores = restores || memberRestores
+		}
+		for memberName := range sourceShape.MemberRefs {
+			if _, found := targetShape.MemberRefs[memberName]; !found {
+				keeps = true
+			}
+		}
+	case "list":
+		return shapeConversionLosses(
+			targetShape.MemberRef.Shape, sourceShape.MemberRef.Shape,
+		)
+	case "map":
+		return shapeConversionLosses(
+			targetShape.ValueRef.Shape, sourceShape.ValueRef.Shape,
+		)
+	}
+	return keeps, restores
+}
+
+// conversionData holds what the generated code needs to keep a source value
+// that cannot be converted, and to restore a destination value that has no
+// source
+type conversionData struct {
+	// srcKey and dstKey are the keys of the value in the source and
+	// destination versions, e.g. "Spec.ScanConfig.ScanOnPush". Values within
+	// list elements or map values have no such key, which is marked by "[]"
+	srcKey string
+	dstKey string
+	// restoredVarName is the name of the map[string]json.RawMessage variable
+	// holding the values restored from the source resource
+	restoredVarName string
+	// keptVarName is the name of the map[string]interface{} variable holding
+	// the values kept in the destination resource
+	keptVarName string
+}
+
+// member returns the conversionData of the supplied struct member
+func (d conversionData) member(memberName string) conversionData {
+	d.srcKey += "." + memberName
+	d.dstKey += "." + memberName
+	return d
+}
+
+// elem returns the conversionData of list elements or map values
+func (d conversionData) elem() conversionData {
+	d.srcKey += "[]"
+	d.dstKey += "[]"
+	return d
+}
+
+// keyed returns true if the value can be kept and restored
+func (d conversionData) keyed() bool {
+	return !strings.Contains(d.srcKey, "[]")
+}
+
+// getConversionData returns the Go code reading the values kept in the
+// annotation of the source resource, when a destination field has to be
+// restored from them. Returns an empty string otherwise.
+//
+// restored, err := getConversionData(src)
+// if err != nil {
+//     return err
+// }
+func getConversionData(
+	delta *multiversion.CRDDelta,
+	reverse bool,
+	srcVarName string,
+	restoredVarName string,
+	indentLevel int,
+) string {
+	restores := false
+	for _, deltas := range [][]multiversion.FieldDelta{delta.SpecDeltas, delta.StatusDeltas} {
+		for _, d := range deltas {
+			if _, fieldRestores := fieldDeltaLosses(d, reverse); fieldRestores {
+				restores = true
+			}
+		}
+	}
+	if !restores {
+		return ""
+	}
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	out += fmt.Sprintf(
+		"%s%s, err := getConversionData(%s)\n",
+		indent, restoredVarName, srcVarName,
+	)
+	out += fmt.Sprintf("%sif err != nil {\n", indent)
+	out += fmt.Sprintf("%s\treturn err\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// ConvertFrom returns the Go code that copies the Spec and Status fields of a
+// hub version resource into a spoke version resource. It is used to generate
+// the body of the `ConvertFrom` method implementing the controller-runtime
+// `conversion.Convertible` interface. See `ConvertTo` for details about the
+// generated code.
+func ConvertFrom(
+	// The spoke version CRD
+	spoke *model.CRD,
+	// The deltas computed between the spoke (source) and the hub
+	// (destination) CRDs
+	delta *multiversion.CRDDelta,
+	// String representing the name of the hub resource variable
+	srcVarName string,
+	// String representing the name of the spoke resource variable
+	dstVarName string,
+	// String representing the name of the map[string]json.RawMessage
+	// variable holding the values restored from the source resource
+	restoredVarName string,
+	// String representing the name of the map[string]interface{} variable
+	// holding the values kept in the destination resource
+	keptVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) (string, error) {
+	return convertCRD(
+		spoke, delta, true, "",
+		srcVarName, dstVarName, restoredVarName, keptVarName, indentLevel,
+	)
+}
+
+// convertCRD returns the Go code that converts the Spec and Status fields of
+// a resource for ConvertTo or, when reverse is true, ConvertFrom
+func convertCRD(
+	dstCRD *model.CRD,
+	delta *multiversion.CRDDelta,
+	reverse bool,
+	dstPkgName string,
+	srcVarName string,
+	dstVarName string,
+	restoredVarName string,
+	keptVarName string,
+	indentLevel int,
+) (string, error) {
+	out := "\n"
+	out += getConversionData(
+		delta, reverse, srcVarName, restoredVarName, indentLevel,
+	)
+	for _, subStruct := range []string{"Spec", "Status"} {
+		deltas := delta.SpecDeltas
+		if subStruct == "Status" {
+			deltas = delta.StatusDeltas
+		}
+		code, err := convertFieldDeltas(
+			dstCRD, deltas, reverse, dstPkgName, subStruct,
+			srcVarName, dstVarName, restoredVarName, keptVarName, indentLevel,
+		)
+		if err != nil {
+			return "", err
+		}
+		out += code
+	}
+	return out, nil
+}
+
+// convertFieldDeltas returns the Go code that converts every field in a list
+// of field deltas. When reverse is true the deltas are applied from the
+// destination (hub) to the source (spoke) version.
+func convertFieldDeltas(
+	// The CRD of the version we are converting to
+	dstCRD *model.CRD,
+	deltas []multiversion.FieldDelta,
+	reverse bool,
+	// The package name the destination struct types are declared in. Empty
+	// when the types are in the same package as the generated code.
+	dstPkgName string,
+	// "Spec" or "Status"
+	subStruct string,
+	srcResVarName string,
+	dstResVarName string,
+	restoredVarName string,
+	keptVarName string,
+	indentLevel int,
+) (string, error) {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	srcVarName := srcResVarName + "." + subStruct
+	dstVarName := dstResVarName + "." + subStruct
+	for index, delta := range deltas {
+		srcField, dstField := delta.Source, delta.Destination
+		if reverse {
+			srcField, dstField = dstField, srcField
+		}
+		data := conversionData{
+			restoredVarName: restoredVarName,
+			keptVarName:     keptVarName,
+		}
+		if srcField != nil {
+			data.srcKey = subStruct + "." + srcField.Names.Camel
+		}
+		if dstField != nil {
+			data.dstKey = subStruct + "." + dstField.Names.Camel
+		}
+		switch delta.ChangeType {
+		case multiversion.FieldChangeTypeAdded,
+			multiversion.FieldChangeTypeRemoved:
+			if srcField != nil {
+				out += keepConversionValue(
+					data.srcKey, srcVarName+"."+srcField.Names.Camel,
+					keptVarName, indentLevel,
+				)
+			} else {
+				out += restoreConversionValue(
+					data.dstKey, dstVarName+"."+dstField.Names.Camel,
+					restoredVarName, indentLevel,
+				)
+			}
+		case multiversion.FieldChangeTypeShapeChangedFromStringToSecret,
+			multiversion.FieldChangeTypeShapeChangedFromSecretToString:
+			// The values of both versions are kept under the same key, the
+			// annotation of a resource only ever holds the values of the
+			// other version
+			out += restoreConversionValue(
+				data.dstKey, dstVarName+"."+dstField.Names.Camel,
+				restoredVarName, indentLevel,
+			)
+			out += keepConversionValue(
+				data.srcKey, srcVarName+"."+srcField.Names.Camel,
+				keptVarName, indentLevel,
+			)
+		case multiversion.FieldChangeTypeNone,
+			multiversion.FieldChangeTypeRenamed,
+			multiversion.FieldChangeTypeShapeChanged:
+			srcAdaptedVarName := srcVarName + "." + srcField.Names.Camel
+			dstAdaptedVarName := dstVarName + "." + dstField.Names.Camel
+			if isSecretField(srcField) && isSecretField(dstField) {
+				out += fmt.Sprintf(
+					"%s%s = %s\n", indent, dstAdaptedVarName, srcAdaptedVarName,
+				)
+				continue
+			}
+			code, err := convertShape(
+				dstCRD,
+				dstPkgName,
+				fmt.Sprintf("f%d", index),
+				dstAdaptedVarName,
+				dstField.ShapeRef.Shape,
+				srcAdaptedVarName,
+				srcField.ShapeRef.Shape,
+				data,
+				indentLevel,
+			)
+			if err != nil {
+				return "", err
+			}
+			out += code
+		default:
+			out += fmt.Sprintf(
+				"%s// %s.%s has an unknown change type and is left unset\n",
+				indent, dstVarName, getConvertedFieldName(srcField, dstField),
+			)
+		}
+	}
+	return out, nil
+}
+
+// keepConversionValue returns the Go code that keeps a source value that
+// can't be converted, for it to be restored when converting back
+//
+// kept["Spec.LegacyField"] = src.Spec.LegacyField
+func keepConversionValue(
+	// The key of the value, e.g. "Spec.LegacyField"
+	key string,
+	// The fully-qualified variable we read the value from
+	sourceVarName string,
+	keptVarName string,
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	return fmt.Sprintf(
+		"%s%s[%q] = %s\n", indent, keptVarName, key, sourceVarName,
+	)
+}
+
+// restoreConversionValue returns the Go code that sets a destination field
+// that has no source value to the value kept when the source resource was
+// converted from the destination version, if any
+//
+// if v, ok := restored["Spec.EncryptionConfiguration"]; ok {
+//     if err := json.Unmarshal(v, &dst.Spec.EncryptionConfiguration); err != nil {
+//         return err
+//     }
+// }
+func restoreConversionValue(
+	// The key of the value, e.g. "Spec.EncryptionConfiguration"
+	key string,
+	// The fully-qualified variable that will be set
+	targetVarName string,
+	restoredVarName string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	out += fmt.Sprintf(
+		"%sif v, ok := %s[%q]; ok {\n", indent, restoredVarName, key,
+	)
+	out += fmt.Sprintf(
+		"%s\tif err := json.Unmarshal(v, &%s); err != nil {\n",
+		indent, targetVarName,
+	)
+	out += fmt.Sprintf("%s\t\treturn err\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// convertShape returns the Go code that sets a target variable to a deep copy
+// of a source variable. Scalar values are assigned directly. Structs, slices
+// and maps containing structs are copied using a temporary variable prefixed
+// with varPrefix. Values whose type differs are kept and restored instead.
+func convertShape(
+	dstCRD *model.CRD,
+	dstPkgName string,
+	// Prefix used to name temporary variables
+	varPrefix string,
+	// The fully-qualified variable that will be set
+	targetVarName string,
+	targetShape *awssdkmodel.Shape,
+	// The fully-qualified variable we read the value from
+	sourceVarName string,
+	sourceShape *awssdkmodel.Shape,
+	data conversionData,
+	indentLevel int,
+) (string, error) {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	if targetShape.Type != sourceShape.Type {
+		if !data.keyed() {
+			return "", fmt.Errorf(
+				"cannot convert %s of %s from %s to %s: values within lists and maps cannot be kept",
+				data.srcKey, dstCRD.Names.Camel, sourceShape.Type, targetShape.Type,
+			)
+		}
+		out += restoreConversionValue(
+			data.dstKey, targetVarName, data.restoredVarName, indentLevel,
+		)
+		out += keepConversionValue(
+			data.srcKey, sourceVarName, data.keptVarName, indentLevel,
+		)
+		return out, nil
+	}
+	if !shapeContainsStruct(sourceShape) && !shapeContainsStruct(targetShape) {
+		out += fmt.Sprintf("%s%s = %s\n", indent, targetVarName, sourceVarName)
+		return out, nil
+	}
+
+	// if src.Spec.ScanConfig != nil {
+	out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceVarName)
+	code, err := convertContainer(
+		dstCRD, dstPkgName,
+		varPrefix,
+		targetShape,
+		sourceVarName,
+		sourceShape,
+		data,
+		indentLevel+1,
+	)
+	if err != nil {
+		return "", err
+	}
+	out += code
+	//     dst.Spec.ScanConfig = f0
+	out += fmt.Sprintf("%s\t%s = %s\n", indent, targetVarName, varPrefix)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out, nil
+}
+
+// convertContainer returns the Go code that declares a new variable named
+// varName, of the Go type corresponding to targetShape, and fills it with a
+// copy of the source struct, slice or map. Struct members found in a single
+// version are kept or restored.
+func convertContainer(
+	dstCRD *model.CRD,
+	dstPkgName string,
+	varName string,
+	targetShape *awssdkmodel.Shape,
+	sourceVarName string,
+	sourceShape *awssdkmodel.Shape,
+	data conversionData,
+	indentLevel int,
+) (string, error) {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	goType := convertGoType(dstCRD, dstPkgName, targetShape)
+	switch targetShape.Type {
+	case "structure":
+		// f0 := &v1alpha2.ImageScanningConfiguration{}
+		out += fmt.Sprintf(
+			"%s%s := &%s{}\n", indent, varName, strings.TrimPrefix(goType, "*"),
+		)
+		for memberIndex, memberName := range targetShape.MemberNames() {
+			targetMemberRef := targetShape.MemberRefs[memberName]
+			sourceMemberRef, found := sourceShape.MemberRefs[memberName]
+			cleanNames := names.New(memberName)
+			memberData := data.member(cleanNames.Camel)
+			if !found {
+				if !data.keyed() {
+					return "", fmt.Errorf(
+						"cannot convert %s of %s: member %s does not exist in the source version and values within lists and maps cannot be restored",
+						data.dstKey, dstCRD.Names.Camel, cleanNames.Camel,
+					)
+				}
+				out += restoreConversionValue(
+					memberData.dstKey, varName+"."+cleanNames.Camel,
+					data.restoredVarName, indentLevel,
+				)
+				continue
+			}
+			code, err := convertShape(
+				dstCRD, dstPkgName,
+				fmt.Sprintf("%sf%d", varName, memberIndex),
+				varName+"."+cleanNames.Camel,
+				targetMemberRef.Shape,
+				sourceVarName+"."+cleanNames.Camel,
+				sourceMemberRef.Shape,
+				memberData,
+				indentLevel,
+			)
+			if err != nil {
+				return "", err
+			}
+			out += code
+		}
+		for _, memberName := range sourceShape.MemberNames() {
+			if _, found := targetShape.MemberRefs[memberName]; found {
+				continue
+			}
+			cleanNames := names.New(memberName)
+			if !data.keyed() {
+				return "", fmt.Errorf(
+					"cannot convert %s of %s: member %s does not exist in the destination version and values within lists and maps cannot be kept",
+					data.srcKey, dstCRD.Names.Camel, cleanNames.Camel,
+				)
+			}
+			out += keepConversionValue(
+				data.member(cleanNames.Camel).srcKey,
+				sourceVarName+"."+cleanNames.Camel,
+				data.keptVarName, indentLevel,
+			)
+		}
+	case "list":
+		iterVarName := varName + "iter"
+		// f0 := make([]*v1alpha2.Rule, 0, len(src.Spec.Rules))
+		out += fmt.Sprintf(
+			"%s%s := make(%s, 0, len(%s))\n", indent, varName, goType, sourceVarName,
+		)
+		// for _, f0iter := range src.Spec.Rules {
+		out += fmt.Sprintf(
+			"%sfor _, %s := range %s {\n", indent, iterVarName, sourceVarName,
+		)
+		code, err := convertElem(
+			dstCRD, dstPkgName,
+			varName+"elem",
+			targetShape.MemberRef.Shape,
+			iterVarName,
+			sourceShape.MemberRef.Shape,
+			data.elem(),
+			indentLevel+1,
+		)
+		if err != nil {
+			return "", err
+		}
+		out += code
+		//     f0 = append(f0, f0elem)
+		out += fmt.Sprintf(
+			"%s\t%s = append(%s, %selem)\n", indent, varName, varName, varName,
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+	case "map":
+		keyVarName := varName + "key"
+		valIterVarName := varName + "valiter"
+		// f0 := make(map[string]*v1alpha2.Rule, len(src.Spec.Rules))
+		out += fmt.Sprintf(
+			"%s%s := make(%s, len(%s))\n", indent, varName, goType, sourceVarName,
+		)
+		// for f0key, f0valiter := range src.Spec.Rules {
+		out += fmt.Sprintf(
+			"%sfor %s, %s := range %s {\n",
+			indent, keyVarName, valIterVarName, sourceVarName,
+		)
+		code, err := convertElem(
+			dstCRD, dstPkgName,
+			varName+"val",
+			targetShape.ValueRef.Shape,
+			valIterVarName,
+			sourceShape.ValueRef.Shape,
+			data.elem(),
+			indentLevel+1,
+		)
+		if err != nil {
+			return "", err
+		}
+		out += code
+		//     f0[f0key] = f0val
+		out += fmt.Sprintf(
+			"%s\t%s[%s] = %sval\n", indent, varName, keyVarName, varName,
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out, nil
+}
+
+// convertElem returns the Go code that declares a variable named varName
+// holding a copy of a slice element or map value.
+func convertElem(
+	dstCRD *model.CRD,
+	dstPkgName string,
+	varName string,
+	targetShape *awssdkmodel.Shape,
+	sourceVarName string,
+	sourceShape *awssdkmodel.Shape,
+	data conversionData,
+	indentLevel int,
+) (string, error) {
+	indent := strings.Repeat("\t", indentLevel)
+	if targetShape.Type != sourceShape.Type {
+		return "", fmt.Errorf(
+			"cannot convert %s of %s from %s to %s: values within lists and maps cannot be kept",
+			data.srcKey, dstCRD.Names.Camel, sourceShape.Type, targetShape.Type,
+		)
+	}
+	if !shapeContainsStruct(sourceShape) && !shapeContainsStruct(targetShape) {
+		// f0elem := f0iter
+		return fmt.Sprintf("%s%s := %s\n", indent, varName, sourceVarName), nil
+	}
+	// var f0elem *v1alpha2.Rule
+	out := fmt.Sprintf(
+		"%svar %s %s\n", indent, varName,
+		convertGoType(dstCRD, dstPkgName, targetShape),
+	)
+	code, err := convertShape(
+		dstCRD, dstPkgName,
+		varName+"f",
+		varName,
+		targetShape,
+		sourceVarName,
+		sourceShape,
+		data,
+		indentLevel,
+	)
+	if err != nil {
+		return "", err
+	}
+	return out + code, nil
+}
+
+// convertGoType returns the Go type of a shape as declared in the API package
+// of the destination CRD. Struct type names are qualified with dstPkgName when
+// it isn't empty.
+func convertGoType(
+	dstCRD *model.CRD,
+	dstPkgName string,
+	shape *awssdkmodel.Shape,
+) string {
+	switch shape.Type {
+	case "structure":
+		typeName := shape.GoTypeElem()
+		if altTypeName, renamed := dstCRD.TypeRenames()[typeName]; renamed {
+			typeName = altTypeName
+		} else {
+			typeName = names.New(typeName).Camel
+		}
+		if dstPkgName != "" {
+			typeName = dstPkgName + "." + typeName
+		}
+		return "*" + typeName
+	case "list":
+		return "[]" + convertGoType(dstCRD, dstPkgName, shape.MemberRef.Shape)
+	case "map":
+		return "map[string]" + convertGoType(dstCRD, dstPkgName, shape.ValueRef.Shape)
+	case "timestamp":
+		return "*metav1.Time"
+	default:
+		return shape.GoType()
+	}
+}
+
+// shapeContainsStruct returns true if the given shape is a struct, or a slice
+// or map that (recursively) contains structs.
+func shapeContainsStruct(shape *awssdkmodel.Shape) bool {
+	switch shape.Type {
+	case "structure":
+		return true
+	case "list":
+		return shapeContainsStruct(shape.MemberRef.Shape)
+	case "map":
+		return shapeContainsStruct(shape.ValueRef.Shape)
+	default:
+		return false
+	}
+}
+
+// isSecretField returns true if the field is configured as a secret.
+func isSecretField(field *model.Field) bool {
+	return field.FieldConfig != nil && field.FieldConfig.IsSecret
+}
+
+// getConvertedFieldName returns the name of the destination field if there is
+// one, otherwise the name of the source field.
+func getConvertedFieldName(srcField, dstField *model.Field) string {
+	if dstField != nil {
+		return dstField.Names.Camel
+	}
+	return srcField.Names.Camel
+}
@@ -0,0 +1,293 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model/multiversion"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestConvert_ECR_Repository_Renamed_Added(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	spokeModel := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		APIVersion:          "v1alpha1",
+		GeneratorConfigFile: "generator-v1alpha1.yaml",
+	})
+	hubModel := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		APIVersion:          "v1beta1",
+		GeneratorConfigFile: "generator-v1beta1.yaml",
+		ServiceAPIVersion:   "0000-00-01",
+	})
+
+	spoke := testutil.GetCRDByName(t, spokeModel, "Repository")
+	require.NotNil(spoke)
+	hub := testutil.GetCRDByName(t, hubModel, "Repository")
+	require.NotNil(hub)
+
+	delta, err := multiversion.ComputeCRDFieldDeltas(spoke, hub)
+	require.Nil(err)
+
+	expectedTo := `
+	restored, err := getConversionData(src)
+	if err != nil {
+		return err
+	}
+	if v, ok := restored["Spec.EncryptionConfiguration"]; ok {
+		if err := json.Unmarshal(v, &dst.Spec.EncryptionConfiguration); err != nil {
+			return err
+		}
+	}
+	dst.Spec.ImageTagMutability = src.Spec.ImageTagMutability
+	dst.Spec.Name = src.Spec.RepositoryName
+	if src.Spec.ImageScanningConfiguration != nil {
+		f3 := &v1beta1.ImageScanningConfiguration{}
+		f3.ScanOnPush = src.Spec.ImageScanningConfiguration.ScanOnPush
+		dst.Spec.ScanConfig = f3
+	}
+	if src.Spec.Tags != nil {
+		f4 := make([]*v1beta1.Tag, 0, len(src.Spec.Tags))
+		for _, f4iter := range src.Spec.Tags {
+			var f4elem *v1beta1.Tag
+			if f4iter != nil {
+				f4elemf := &v1beta1.Tag{}
+				f4elemf.Key = f4iter.Key
+				f4elemf.Value = f4iter.Value
+				f4elem = f4elemf
+			}
+			f4 = append(f4, f4elem)
+		}
+		dst.Spec.Tags = f4
+	}
+	dst.Status.CreatedAt = src.Status.CreatedAt
+	dst.Status.RegistryID = src.Status.RegistryID
+	dst.Status.RepositoryURI = src.Status.RepositoryURI
+`
+	convertTo, err := code.ConvertTo(hub, delta, "v1beta1", "src", "dst", "restored", "kept", 1)
+	require.Nil(err)
+	assert.Equal(expectedTo, convertTo)
+	expectedFrom := `
+	kept["Spec.EncryptionConfiguration"] = src.Spec.EncryptionConfiguration
+	dst.Spec.ImageTagMutability = src.Spec.ImageTagMutability
+	dst.Spec.RepositoryName = src.Spec.Name
+	if src.Spec.ScanConfig != nil {
+		f3 := &ImageScanningConfiguration{}
+		f3.ScanOnPush = src.Spec.ScanConfig.ScanOnPush
+		dst.Spec.ImageScanningConfiguration = f3
+	}
+	if src.Spec.Tags != nil {
+		f4 := make([]*Tag, 0, len(src.Spec.Tags))
+		for _, f4iter := range src.Spec.Tags {
+			var f4elem *Tag
+			if f4iter != nil {
+				f4elemf := &Tag{}
+				f4elemf.Key = f4iter.Key
+				f4elemf.Value = f4iter.Value
+				f4elem = f4elemf
+			}
+			f4 = append(f4, f4elem)
+		}
+		dst.Spec.Tags = f4
+	}
+	dst.Status.CreatedAt = src.Status.CreatedAt
+	dst.Status.RegistryID = src.Status.RegistryID
+	dst.Status.RepositoryURI = src.Status.RepositoryURI
+`
+	convertFrom, err := code.ConvertFrom(spoke, delta, "src", "dst", "restored", "kept", 1)
+	require.Nil(err)
+	assert.Equal(expectedFrom, convertFrom)
+}
+
+func TestConvert_ECR_Repository_StringToSecret(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	spokeModel := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		APIVersion:          "v1alpha2",
+		GeneratorConfigFile: "generator-v1alpha2.yaml",
+	})
+	hubModel := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		APIVersion:          "v1alpha3",
+		GeneratorConfigFile: "generator-v1alpha3.yaml",
+	})
+
+	spoke := testutil.GetCRDByName(t, spokeModel, "Repository")
+	require.NotNil(spoke)
+	hub := testutil.GetCRDByName(t, hubModel, "Repository")
+	require.NotNil(hub)
+
+	delta, err := multiversion.ComputeCRDFieldDeltas(spoke, hub)
+	require.Nil(err)
+
+	expected := `
+	restored, err := getConversionData(src)
+	if err != nil {
+		return err
+	}
+`
+	expectedField := `	if v, ok := restored["Spec.ImageTagMutability"]; ok {
+		if err := json.Unmarshal(v, &dst.Spec.ImageTagMutability); err != nil {
+			return err
+		}
+	}
+	kept["Spec.ImageTagMutability"] = src.Spec.ImageTagMutability
+`
+	convertTo, err := code.ConvertTo(hub, delta, "v1alpha3", "src", "dst", "restored", "kept", 1)
+	require.Nil(err)
+	assert.True(strings.HasPrefix(convertTo, expected))
+	assert.Contains(convertTo, expectedField)
+	convertFrom, err := code.ConvertFrom(spoke, delta, "src", "dst", "restored", "kept", 1)
+	require.Nil(err)
+	assert.True(strings.HasPrefix(convertFrom, expected))
+	assert.Contains(convertFrom, expectedField)
+}
+
+func TestConvert_ECR_Repository_TypeChanged(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	spokeModel := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		APIVersion:          "v1alpha1",
+		GeneratorConfigFile: "generator-v1alpha1.yaml",
+	})
+	hubModel := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		APIVersion:          "v1beta3",
+		GeneratorConfigFile: "generator-v1beta3.yaml",
+		ServiceAPIVersion:   "0000-00-02",
+	})
+
+	spoke := testutil.GetCRDByName(t, spokeModel, "Repository")
+	require.NotNil(spoke)
+	hub := testutil.GetCRDByName(t, hubModel, "Repository")
+	require.NotNil(hub)
+
+	delta, err := multiversion.ComputeCRDFieldDeltas(spoke, hub)
+	require.Nil(err)
+	assert.True(code.ConversionKeepsFields(delta))
+
+	expectedTo := `
+	restored, err := getConversionData(src)
+	if err != nil {
+		return err
+	}
+	if src.Spec.ImageScanningConfiguration != nil {
+		f0 := &v1beta3.ImageScanningConfiguration{}
+		if v, ok := restored["Spec.ImageScanningConfiguration.ScanFrequency"]; ok {
+			if err := json.Unmarshal(v, &f0.ScanFrequency); err != nil {
+				return err
+			}
+		}
+		if v, ok := restored["Spec.ImageScanningConfiguration.ScanOnPush"]; ok {
+			if err := json.Unmarshal(v, &f0.ScanOnPush); err != nil {
+				return err
+			}
+		}
+		kept["Spec.ImageScanningConfiguration.ScanOnPush"] = src.Spec.ImageScanningConfiguration.ScanOnPush
+		dst.Spec.ImageScanningConfiguration = f0
+	}
+	if v, ok := restored["Spec.ImageTagMutability"]; ok {
+		if err := json.Unmarshal(v, &dst.Spec.ImageTagMutability); err != nil {
+			return err
+		}
+	}
+	kept["Spec.ImageTagMutability"] = src.Spec.ImageTagMutability
+	dst.Spec.RepositoryName = src.Spec.RepositoryName
+	kept["Spec.Tags"] = src.Spec.Tags
+	dst.Status.CreatedAt = src.Status.CreatedAt
+	dst.Status.RegistryID = src.Status.RegistryID
+	dst.Status.RepositoryURI = src.Status.RepositoryURI
+`
+	convertTo, err := code.ConvertTo(hub, delta, "v1beta3", "src", "dst", "restored", "kept", 1)
+	require.Nil(err)
+	assert.Equal(expectedTo, convertTo)
+	expectedFrom := `
+	restored, err := getConversionData(src)
+	if err != nil {
+		return err
+	}
+	if src.Spec.ImageScanningConfiguration != nil {
+		f0 := &ImageScanningConfiguration{}
+		if v, ok := restored["Spec.ImageScanningConfiguration.ScanOnPush"]; ok {
+			if err := json.Unmarshal(v, &f0.ScanOnPush); err != nil {
+				return err
+			}
+		}
+		kept["Spec.ImageScanningConfiguration.ScanOnPush"] = src.Spec.ImageScanningConfiguration.ScanOnPush
+		kept["Spec.ImageScanningConfiguration.ScanFrequency"] = src.Spec.ImageScanningConfiguration.ScanFrequency
+		dst.Spec.ImageScanningConfiguration = f0
+	}
+	if v, ok := restored["Spec.ImageTagMutability"]; ok {
+		if err := json.Unmarshal(v, &dst.Spec.ImageTagMutability); err != nil {
+			return err
+		}
+	}
+	kept["Spec.ImageTagMutability"] = src.Spec.ImageTagMutability
+	dst.Spec.RepositoryName = src.Spec.RepositoryName
+	if v, ok := restored["Spec.Tags"]; ok {
+		if err := json.Unmarshal(v, &dst.Spec.Tags); err != nil {
+			return err
+		}
+	}
+	dst.Status.CreatedAt = src.Status.CreatedAt
+	dst.Status.RegistryID = src.Status.RegistryID
+	dst.Status.RepositoryURI = src.Status.RepositoryURI
+`
+	convertFrom, err := code.ConvertFrom(spoke, delta, "src", "dst", "restored", "kept", 1)
+	require.Nil(err)
+	assert.Equal(expectedFrom, convertFrom)
+}
+
+func TestConvert_ECR_Repository_ListElemTypeChanged(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	spokeModel := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		APIVersion:          "v1alpha1",
+		GeneratorConfigFile: "generator-v1alpha1.yaml",
+	})
+	hubModel := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		APIVersion:          "v1beta4",
+		GeneratorConfigFile: "generator-v1beta4.yaml",
+		ServiceAPIVersion:   "0000-00-02",
+	})
+
+	spoke := testutil.GetCRDByName(t, spokeModel, "Repository")
+	require.NotNil(spoke)
+	hub := testutil.GetCRDByName(t, hubModel, "Repository")
+	require.NotNil(hub)
+
+	delta, err := multiversion.ComputeCRDFieldDeltas(spoke, hub)
+	require.Nil(err)
+
+	_, err = code.ConvertTo(hub, delta, "v1beta4", "src", "dst", "restored", "kept", 1)
+	require.NotNil(err)
+	assert.Equal(
+		"cannot convert Spec.Tags[].Value of Repository from string to integer: values within lists and maps cannot be kept",
+		err.Error(),
+	)
+	_, err = code.ConvertFrom(spoke, delta, "src", "dst", "restored", "kept", 1)
+	require.NotNil(err)
+	assert.Equal(
+		"cannot convert Spec.Tags[].Value of Repository from integer to string: values within lists and maps cannot be kept",
+		err.Error(),
+	)
+}
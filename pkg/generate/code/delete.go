@@ -0,0 +1,97 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// IsDeleting returns the Go code that returns true if a resource is being
+// deleted according to the value of the Status field listed in the
+// resource's `delete.deleting` config.
+//
+// Output code will look something like this:
+//
+// if ko.Status.DBInstanceStatus == nil {
+//     return false
+// }
+// return *ko.Status.DBInstanceStatus == "deleting"
+func IsDeleting(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable that represents the
+	// resource, e.g. "ko"
+	koVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	deleting := r.DeletingStatus()
+	if deleting == nil {
+		return fmt.Sprintf("%sreturn false\n", indent)
+	}
+	statusPrefix := strings.TrimPrefix(cfg.PrefixConfig.StatusField, ".")
+	selector := koVarName + "." + statusPrefix
+	isNil := []string{}
+	for _, f := range deleting.Fields {
+		selector += "." + f.Names.Camel
+		isNil = append(isNil, selector+" == nil")
+	}
+	// if ko.Status.DBInstanceStatus == nil {
+	//     return false
+	// }
+	out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(isNil, " || "))
+	out += fmt.Sprintf("%s\treturn false\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	// return *ko.Status.DBInstanceStatus == "deleting"
+	isDeleting := []string{}
+	for _, value := range deleting.Values {
+		isDeleting = append(isDeleting, fmt.Sprintf("*%s == %q", selector, value))
+	}
+	out += fmt.Sprintf("%sreturn %s\n", indent, strings.Join(isDeleting, " || "))
+	return out
+}
+
+// SetPreDeleteUpdates returns the Go code that sets the Spec fields of a
+// resource to the values they must have for the resource to be deleted, as
+// listed in the resource's `delete.pre_delete_updates` config.
+//
+// Output code will look something like this:
+//
+// ko.Spec.DeletionProtection = aws.Bool(false)
+func SetPreDeleteUpdates(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable that represents the
+	// resource, e.g. "ko"
+	koVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	specPrefix := strings.TrimPrefix(cfg.PrefixConfig.SpecField, ".")
+	for _, update := range r.PreDeleteUpdates() {
+		out += fmt.Sprintf(
+			"%s%s.%s.%s = %s\n",
+			indent, koVarName, specPrefix, update.Field.Names.Camel, update.Value,
+		)
+	}
+	return out
+}
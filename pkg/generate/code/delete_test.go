@@ -0,0 +1,83 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestIsDeleting_RDS_DBInstance(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-delete.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	expected := `	if r.ko.Status.DBInstanceStatus == nil {
+		return false
+	}
+	return *r.ko.Status.DBInstanceStatus == "deleting"
+`
+	assert.Equal(
+		expected,
+		code.IsDeleting(crd.Config(), crd, "r.ko", 1),
+	)
+}
+
+func TestIsDeleting_RDS_DBSubnetGroup(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-delete.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBSubnetGroup")
+	require.NotNil(crd)
+
+	// No deleting status configured
+	assert.Equal(
+		"\treturn false\n",
+		code.IsDeleting(crd.Config(), crd, "r.ko", 1),
+	)
+}
+
+func TestSetPreDeleteUpdates_RDS_DBInstance(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-delete.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	expected := `	desired.ko.Spec.DeletionProtection = aws.Bool(false)
+`
+	assert.Equal(
+		expected,
+		code.SetPreDeleteUpdates(crd.Config(), crd, "desired.ko", 1),
+	)
+}
@@ -0,0 +1,109 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// ClassifyAWSError returns the Go code that returns the error the reconciler
+// should act upon for an aws-sdk-go error matching one of the resource's
+// exception rules, in the order of the rules.
+//
+// Output code will look something like this:
+//
+// if awsErr.Code() == "DBInstanceNotFound" && (opName == "DescribeDBInstances" || opName == "DeleteDBInstance") {
+//     return ackerr.NotFound
+// }
+// if awsErr.Code() == "InvalidParameterCombination" && opName == "ModifyDBInstance" && exceptionMessagePatterns[1].MatchString(awsErr.Message()) {
+//     return &terminalError{awsErr}
+// }
+// if awsErr.Code() == "Throttling" {
+//     return ackrequeue.NeededAfter(awsErr, retryAfter(key, 5*time.Second, 300*time.Second))
+// }
+func ClassifyAWSError(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable holding the name of the
+	// API operation that returned the error, e.g. "opName"
+	opNameVarName string,
+	// String representing the name of the awserr.Error variable, e.g.
+	// "awsErr"
+	awsErrVarName string,
+	// String representing the name of the variable identifying the resource
+	// and operation whose retries are backed off, e.g. "key"
+	retryKeyVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	for _, rule := range r.ExceptionRules() {
+		conds := []string{fmt.Sprintf("%s.Code() == %q", awsErrVarName, rule.Code)}
+		if len(rule.Operations) > 0 {
+			opConds := []string{}
+			for _, opName := range rule.Operations {
+				opConds = append(opConds, fmt.Sprintf("%s == %q", opNameVarName, opName))
+			}
+			opCond := strings.Join(opConds, " || ")
+			if len(opConds) > 1 {
+				opCond = "(" + opCond + ")"
+			}
+			conds = append(conds, opCond)
+		}
+		if rule.MessagePattern != "" {
+			conds = append(conds, fmt.Sprintf(
+				"exceptionMessagePatterns[%d].MatchString(%s.Message())",
+				rule.Index, awsErrVarName,
+			))
+		}
+		out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(conds, " && "))
+		out += fmt.Sprintf(
+			"%s\treturn %s\n", indent,
+			exceptionOutcomeError(rule, awsErrVarName, retryKeyVarName),
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
+
+// exceptionOutcomeError returns the Go expression of the error returned for
+// an aws-sdk-go error matching the supplied exception rule
+func exceptionOutcomeError(
+	rule *model.ExceptionRule,
+	awsErrVarName string,
+	retryKeyVarName string,
+) string {
+	switch rule.Outcome {
+	case model.ExceptionOutcomeNotFound:
+		return "ackerr.NotFound"
+	case model.ExceptionOutcomeTerminal:
+		return fmt.Sprintf("&terminalError{%s}", awsErrVarName)
+	case model.ExceptionOutcomeRetry:
+		return fmt.Sprintf(
+			"ackrequeue.NeededAfter(%s, retryAfter(%s, %d*time.Second, %d*time.Second))",
+			awsErrVarName, retryKeyVarName, rule.AfterSeconds, rule.MaxAfterSeconds,
+		)
+	}
+	// model.ExceptionOutcomeRequeue
+	after := "ackrequeue.DefaultRequeueAfterDuration"
+	if rule.AfterSeconds > 0 {
+		after = fmt.Sprintf("%d*time.Second", rule.AfterSeconds)
+	}
+	return fmt.Sprintf("ackrequeue.NeededAfter(%s, %s)", awsErrVarName, after)
+}
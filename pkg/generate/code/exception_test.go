@@ -0,0 +1,57 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestClassifyAWSError_RDS_DBInstance(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-exceptions.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	expected := `	if awsErr.Code() == "DBInstanceNotFound" && (opName == "DescribeDBInstances" || opName == "DeleteDBInstance") {
+		return ackerr.NotFound
+	}
+	if awsErr.Code() == "InvalidParameterCombination" && opName == "ModifyDBInstance" && exceptionMessagePatterns[1].MatchString(awsErr.Message()) {
+		return &terminalError{awsErr}
+	}
+	if awsErr.Code() == "Throttling" {
+		return ackrequeue.NeededAfter(awsErr, retryAfter(key, 5*time.Second, 300*time.Second))
+	}
+	if awsErr.Code() == "InvalidDBInstanceState" && (opName == "ModifyDBInstance" || opName == "DeleteDBInstance") {
+		return ackrequeue.NeededAfter(awsErr, 60*time.Second)
+	}
+	if awsErr.Code() == "DBInstanceAlreadyExists" && opName == "CreateDBInstance" {
+		return ackrequeue.NeededAfter(awsErr, ackrequeue.DefaultRequeueAfterDuration)
+	}
+`
+	assert.Equal(
+		expected,
+		code.ClassifyAWSError(crd.Config(), crd, "opName", "awsErr", "key", 1),
+	)
+}
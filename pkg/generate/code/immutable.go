@@ -0,0 +1,111 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+// ValidateImmutableFields returns the Go code that appends an error to a
+// `field.ErrorList` for each field of a resource that can't be changed once set
+// and whose value differs between the old and new versions of the resource.
+//
+// Output code will look something like this:
+//
+// if old.Spec.EncryptionConfiguration != nil && old.Spec.EncryptionConfiguration.KMSKey != nil {
+//     if r.Spec.EncryptionConfiguration == nil || !equality.Semantic.DeepEqual(old.Spec.EncryptionConfiguration.KMSKey, r.Spec.EncryptionConfiguration.KMSKey) {
+//         errs = append(errs, field.Forbidden(field.NewPath("spec", "encryptionConfiguration", "kmsKey"), "Value is immutable once set"))
+//     }
+// }
+func ValidateImmutableFields(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable that represents the old
+	// version of the resource, e.g. "old"
+	oldVarName string,
+	// String representing the name of the variable that represents the new
+	// version of the resource, e.g. "r"
+	newVarName string,
+	// String representing the name of the variable that is of type
+	// `field.ErrorList`
+	errsVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) (string, error) {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	specPrefix := strings.TrimPrefix(cfg.PrefixConfig.SpecField, ".")
+	specJSONNames := []string{}
+	for _, part := range strings.Split(specPrefix, ".") {
+		specJSONNames = append(specJSONNames, fmt.Sprintf("%q", names.New(part).CamelLower))
+	}
+
+	for _, field := range r.ImmutableFields() {
+		parts := strings.Split(field.Path, ".")
+		if _, found := r.SpecFields[parts[0]]; !found {
+			// Status fields aren't changed by users
+			continue
+		}
+		// The Go selectors and JSON names of the field and of the fields
+		// containing it, e.g. "Spec.EncryptionConfiguration" and
+		// "Spec.EncryptionConfiguration.KMSKey"
+		selectors := []string{}
+		jsonNames := append([]string{}, specJSONNames...)
+		selector := specPrefix
+		for i := range parts {
+			parent, found := r.Fields[strings.Join(parts[:i+1], ".")]
+			if !found {
+				return "", fmt.Errorf(
+					"cannot validate immutable field %s: unknown field %s of resource %s",
+					field.Path, strings.Join(parts[:i+1], "."), r.Names.Original,
+				)
+			}
+			selector += "." + parent.Names.Camel
+			selectors = append(selectors, selector)
+			jsonNames = append(jsonNames, fmt.Sprintf("%q", parent.Names.CamelLower))
+		}
+		fieldSelector := selectors[len(selectors)-1]
+
+		// if old.Spec.EncryptionConfiguration != nil && old.Spec.EncryptionConfiguration.KMSKey != nil {
+		oldNotNil := []string{}
+		for _, s := range selectors {
+			oldNotNil = append(oldNotNil, fmt.Sprintf("%s.%s != nil", oldVarName, s))
+		}
+		out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(oldNotNil, " && "))
+		//   if r.Spec.EncryptionConfiguration == nil || !equality.Semantic.DeepEqual(old.Spec.EncryptionConfiguration.KMSKey, r.Spec.EncryptionConfiguration.KMSKey) {
+		changed := []string{}
+		for _, s := range selectors[:len(selectors)-1] {
+			changed = append(changed, fmt.Sprintf("%s.%s == nil", newVarName, s))
+		}
+		changed = append(changed, fmt.Sprintf(
+			"!equality.Semantic.DeepEqual(%s.%s, %s.%s)",
+			oldVarName, fieldSelector, newVarName, fieldSelector,
+		))
+		out += fmt.Sprintf("%s\tif %s {\n", indent, strings.Join(changed, " || "))
+		//     errs = append(errs, field.Forbidden(field.NewPath("spec", "encryptionConfiguration", "kmsKey"), "Value is immutable once set"))
+		out += fmt.Sprintf(
+			"%s\t\t%s = append(%s, field.Forbidden(field.NewPath(%s), \"Value is immutable once set\"))\n",
+			indent, errsVarName, errsVarName, strings.Join(jsonNames, ", "),
+		)
+		//   }
+		// }
+		out += fmt.Sprintf("%s\t}\n%s}\n", indent, indent)
+	}
+	return out, nil
+}
@@ -0,0 +1,52 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestValidateImmutableFields_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-immutable-fields.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// Fields nested in the elements of lists, like Tags..Key, are skipped
+	expected := `	if oldR.Spec.ImageScanningConfiguration != nil && oldR.Spec.ImageScanningConfiguration.ScanOnPush != nil {
+		if r.Spec.ImageScanningConfiguration == nil || !equality.Semantic.DeepEqual(oldR.Spec.ImageScanningConfiguration.ScanOnPush, r.Spec.ImageScanningConfiguration.ScanOnPush) {
+			errs = append(errs, field.Forbidden(field.NewPath("spec", "imageScanningConfiguration", "scanOnPush"), "Value is immutable once set"))
+		}
+	}
+	if oldR.Spec.RepositoryName != nil {
+		if !equality.Semantic.DeepEqual(oldR.Spec.RepositoryName, r.Spec.RepositoryName) {
+			errs = append(errs, field.Forbidden(field.NewPath("spec", "repositoryName"), "Value is immutable once set"))
+		}
+	}
+`
+	got, err := code.ValidateImmutableFields(crd.Config(), crd, "oldR", "r", "errs", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
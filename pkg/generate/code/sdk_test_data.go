@@ -0,0 +1,711 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
+)
+
+// sdkTestHookPrefixes maps the operation types exercised by the generated
+// resource manager tests to the prefix of the identifiers of the hooks that
+// can be injected into the corresponding sdkXXX method.
+var sdkTestHookPrefixes = map[model.OpType]string{
+	model.OpTypeCreate:        "sdk_create_",
+	model.OpTypeGet:           "sdk_read_one_",
+	model.OpTypeList:          "sdk_read_many_",
+	model.OpTypeGetAttributes: "sdk_get_attributes_",
+	model.OpTypeUpdate:        "sdk_update_",
+}
+
+// sdkTestField is a top-level scalar field of a resource that is mapped to a
+// member of the Input or Output shape of an operation
+type sdkTestField struct {
+	// MemberName is the name of the member in the Input or Output shape
+	MemberName string
+	// Path is the path to the field within the resource, e.g. "Spec.Name"
+	Path string
+	// Field is the resource field, or nil when the member is the primary
+	// resource ARN stored in the resource's ACKResourceMetadata
+	Field *model.Field
+}
+
+// SDKTestable returns true if the generated resource manager code for the
+// supplied operation type can be exercised against a fake SDK client. This is
+// the case when the resource has such an operation and there is no custom
+// method or hook that could call anything other than the SDK client.
+//
+// List and GetAttributes operations are only testable when sdkFind calls
+// them, that is when the resource has no ReadOne operation (and, for List, no
+// GetAttributes operation either).
+func SDKTestable(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The type of operation to check
+	opType model.OpType,
+) bool {
+	var op *awssdkmodel.Operation
+	switch opType {
+	case model.OpTypeCreate:
+		op = r.Ops.Create
+	case model.OpTypeGet:
+		op = r.Ops.ReadOne
+		if op != nil && r.GetCustomCheckRequiredFieldsMissingMethod(op) != "" {
+			return false
+		}
+	case model.OpTypeList:
+		if r.Ops.ReadOne != nil || r.Ops.GetAttributes != nil {
+			return false
+		}
+		op = r.Ops.ReadMany
+		if op == nil || r.GetCustomCheckRequiredFieldsMissingMethod(op) != "" {
+			return false
+		}
+		if _, elemShape := sdkTestReadManyListMember(op); elemShape == nil {
+			return false
+		}
+	case model.OpTypeGetAttributes:
+		if r.Ops.ReadOne != nil || !r.UnpacksAttributesMap() {
+			return false
+		}
+		op = r.Ops.GetAttributes
+		if op != nil && r.GetCustomCheckRequiredFieldsMissingMethod(op) != "" {
+			return false
+		}
+	case model.OpTypeUpdate:
+		op = r.Ops.Update
+		if r.CustomUpdateMethodName() != "" {
+			return false
+		}
+	default:
+		return false
+	}
+	if op == nil || op.InputRef.Shape == nil || op.OutputRef.Shape == nil {
+		return false
+	}
+	if r.GetCustomImplementation(op) != "" ||
+		r.SetOutputCustomMethodName(op) != nil {
+		return false
+	}
+	if cfg != nil {
+		if rConfig, ok := cfg.Resources[r.Names.Original]; ok {
+			for hookID := range rConfig.Hooks {
+				if strings.HasPrefix(hookID, sdkTestHookPrefixes[opType]) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// SDKTestSetResource returns the Go code that sets every top-level scalar
+// field of a resource, as well as its ACKResourceMetadata, to a test value.
+// The supplied seed is used to derive the test values so that two resources
+// set with different seeds differ in all those fields.
+//
+// Output code will look something like this:
+//
+// ko.Spec.ImageTagMutability = aws.String("ImageTagMutability-1")
+// ko.Spec.RepositoryName = aws.String("RepositoryName-1")
+// ko.Status.RegistryID = aws.String("RegistryID-1")
+// ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{
+//     ARN: &arn,
+//     OwnerAccountID: &testAccountID,
+// }
+func SDKTestSetResource(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable that we will be setting,
+	// e.g. "ko"
+	targetVarName string,
+	// Value used to derive the test values
+	seed int,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	for _, prefix := range []string{
+		cfg.PrefixConfig.SpecField, cfg.PrefixConfig.StatusField,
+	} {
+		fields := r.SpecFields
+		if prefix == cfg.PrefixConfig.StatusField {
+			fields = r.StatusFields
+		}
+		fieldNames := []string{}
+		for fieldName := range fields {
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+		for _, fieldName := range fieldNames {
+			f := fields[fieldName]
+			value := sdkTestValue(f, seed)
+			if value == "" {
+				continue
+			}
+			out += fmt.Sprintf(
+				"\n%s%s%s.%s = %s",
+				indent, targetVarName, prefix, f.Names.Camel, value,
+			)
+		}
+	}
+	if cfg.IncludeACKMetadata {
+		out += fmt.Sprintf(
+			"\n%sarn := ackv1alpha1.AWSResourceName(\"arn:aws:%s:us-west-2:111111111111:%s/%d\")",
+			indent, r.SDKAPIPackageName(), r.Names.Snake, seed,
+		)
+		out += fmt.Sprintf(
+			"\n%s%s.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{",
+			indent, targetVarName,
+		)
+		out += fmt.Sprintf("\n%s\tARN:            &arn,", indent)
+		out += fmt.Sprintf(
+			"\n%s\tOwnerAccountID: &testAccountID,", indent,
+		)
+		out += fmt.Sprintf("\n%s}", indent)
+	}
+	return out
+}
+
+// SDKTestSetOutput returns the Go code that sets every member of the Output
+// shape of an operation that is mapped to a top-level scalar field of the
+// resource to a test value, allocating any wrapper structs on the way. For
+// List operations, the members of a single element of the list of results are
+// set, and for GetAttributes operations, the entries of the attributes map.
+//
+// Output code will look something like this:
+//
+// resp.Repository = &svcsdk.Repository{}
+// resp.Repository.RegistryId = aws.String("RegistryID-2")
+// resp.Repository.RepositoryArn = aws.String("arn:aws:ecr:us-west-2:111111111111:repository/2")
+// resp.Repository.RepositoryName = aws.String("RepositoryName-2")
+func SDKTestSetOutput(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The type of operation to look for the Output shape
+	opType model.OpType,
+	// String representing the name of the variable holding the Output shape,
+	// e.g. "resp"
+	targetVarName string,
+	// Value used to derive the test values
+	seed int,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	op := sdkTestOp(r, opType)
+	if op == nil {
+		return ""
+	}
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	if opType == model.OpTypeGetAttributes {
+		out += fmt.Sprintf(
+			"\n%s%s.Attributes = map[string]*string{}", indent, targetVarName,
+		)
+		for _, tf := range sdkTestGetAttributesFields(cfg, r) {
+			out += fmt.Sprintf(
+				"\n%s%s.Attributes[%q] = %s",
+				indent, targetVarName, tf.MemberName,
+				sdkTestOutputValue(r, tf, seed),
+			)
+		}
+		return out
+	}
+	outputShape, wrappers := sdkTestUnwrapOutput(r, op)
+	for _, wrapper := range wrappers {
+		targetVarName += "." + wrapper.MemberName
+		goType := model.ReplacePkgName(
+			wrapper.Shape.GoTypeWithPkgName(), r.SDKAPIPackageName(),
+			"svcsdk", false,
+		)
+		if wrapper.IsList {
+			out += fmt.Sprintf("\n%s%s = []*%s{{}}", indent, targetVarName, goType)
+			targetVarName += "[0]"
+			continue
+		}
+		out += fmt.Sprintf("\n%s%s = &%s{}", indent, targetVarName, goType)
+	}
+	for _, tf := range sdkTestOutputFields(cfg, r, op, outputShape) {
+		out += fmt.Sprintf(
+			"\n%s%s.%s = %s",
+			indent, targetVarName, tf.MemberName,
+			sdkTestOutputValue(r, tf, seed),
+		)
+	}
+	return out
+}
+
+// SDKTestSetReadManyMatchFields returns the Go code that sets the members of
+// the element of the Output shape of a List operation that sdkFind matches
+// the resource on to the values of the corresponding fields of the resource,
+// so that the element set by SDKTestSetOutput is found.
+//
+// Output code will look something like this:
+//
+// resp.Repositories[0].RepositoryName = r.ko.Spec.RepositoryName
+func SDKTestSetReadManyMatchFields(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable holding the Output shape,
+	// e.g. "resp"
+	targetVarName string,
+	// String representing the name of the variable holding the resource's
+	// CR, e.g. "r.ko"
+	koVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	op := r.Ops.ReadMany
+	if op == nil {
+		return ""
+	}
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	outputShape, wrappers := sdkTestUnwrapOutput(r, op)
+	for _, wrapper := range wrappers {
+		targetVarName += "." + wrapper.MemberName
+		if wrapper.IsList {
+			targetVarName += "[0]"
+		}
+	}
+	matchFieldNames := r.ListOpMatchFieldNames()
+	for _, tf := range sdkTestOutputFields(cfg, r, op, outputShape) {
+		if tf.Field == nil || !util.InStrings(tf.Field.Names.Original, matchFieldNames) {
+			continue
+		}
+		out += fmt.Sprintf(
+			"\n%s%s.%s = %s.%s",
+			indent, targetVarName, tf.MemberName, koVarName, tf.Path,
+		)
+	}
+	return out
+}
+
+// SDKTestInputCases returns the Go code for the test cases that check that
+// the members of the Input shape of an operation have been set from the
+// corresponding top-level scalar fields of the resource. Each case is
+// `{path, got, want}`.
+//
+// For Update operations, Spec fields that are ignored in the resource's
+// comparison are left out since a delta never reports them.
+//
+// Output code will look something like this:
+//
+// {"Spec.ImageTagMutability", input.ImageTagMutability, r.ko.Spec.ImageTagMutability},
+// {"Spec.RepositoryName", input.RepositoryName, r.ko.Spec.RepositoryName},
+func SDKTestInputCases(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The type of operation to look for the Input shape
+	opType model.OpType,
+	// String representing the name of the variable holding the Input shape,
+	// e.g. "input"
+	inputVarName string,
+	// String representing the name of the variable holding the resource's
+	// CR, e.g. "r.ko"
+	koVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	op := sdkTestOp(r, opType)
+	if op == nil {
+		return ""
+	}
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	fieldConfigs := cfg.ResourceFields(r.Names.Original)
+	for _, tf := range sdkTestInputFields(cfg, r, op) {
+		if tf.Field == nil {
+			out += fmt.Sprintf(
+				"\n%s{%q, %s.%s, (*string)(%s.Status.ACKResourceMetadata.ARN)},",
+				indent, tf.Path, inputVarName, tf.MemberName, koVarName,
+			)
+			continue
+		}
+		if opType == model.OpTypeUpdate {
+			fConfig := fieldConfigs[tf.Field.Names.Original]
+			if fConfig != nil && fConfig.Compare != nil &&
+				fConfig.Compare.IsIgnored {
+				continue
+			}
+		}
+		out += fmt.Sprintf(
+			"\n%s{%q, %s.%s, %s.%s},",
+			indent, tf.Path, inputVarName, tf.MemberName, koVarName, tf.Path,
+		)
+	}
+	return out
+}
+
+// SDKTestOutputCases returns the Go code for the test cases that check that
+// the top-level scalar fields of the resource have been set from the
+// corresponding members of the Output shape of an operation. Each case is
+// `{path, got, want}`.
+//
+// Output code will look something like this:
+//
+// {"Status.ACKResourceMetadata.ARN", (*string)(ko.Status.ACKResourceMetadata.ARN), resp.Repository.RepositoryArn},
+// {"Spec.RepositoryName", ko.Spec.RepositoryName, resp.Repository.RepositoryName},
+func SDKTestOutputCases(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The type of operation to look for the Output shape
+	opType model.OpType,
+	// String representing the name of the variable holding the Output shape,
+	// e.g. "resp"
+	outputVarName string,
+	// String representing the name of the variable holding the resource's
+	// CR, e.g. "ko"
+	koVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	op := sdkTestOp(r, opType)
+	if op == nil {
+		return ""
+	}
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	if opType == model.OpTypeGetAttributes {
+		for _, tf := range sdkTestGetAttributesFields(cfg, r) {
+			got := koVarName + "." + tf.Path
+			if tf.Field == nil {
+				got = fmt.Sprintf("(*string)(%s)", got)
+			}
+			out += fmt.Sprintf(
+				"\n%s{%q, %s, %s.Attributes[%q]},",
+				indent, tf.Path, got, outputVarName, tf.MemberName,
+			)
+		}
+		return out
+	}
+	outputShape, wrappers := sdkTestUnwrapOutput(r, op)
+	for _, wrapper := range wrappers {
+		outputVarName += "." + wrapper.MemberName
+		if wrapper.IsList {
+			outputVarName += "[0]"
+		}
+	}
+	for _, tf := range sdkTestOutputFields(cfg, r, op, outputShape) {
+		got := koVarName + "." + tf.Path
+		if tf.Field == nil {
+			got = fmt.Sprintf("(*string)(%s)", got)
+		}
+		out += fmt.Sprintf(
+			"\n%s{%q, %s, %s.%s},",
+			indent, tf.Path, got, outputVarName, tf.MemberName,
+		)
+	}
+	return out
+}
+
+// sdkTestOp returns the operation of the supplied type exercised by the
+// generated resource manager tests, or nil if the resource has none.
+func sdkTestOp(
+	r *model.CRD,
+	opType model.OpType,
+) *awssdkmodel.Operation {
+	switch opType {
+	case model.OpTypeCreate:
+		return r.Ops.Create
+	case model.OpTypeGet:
+		return r.Ops.ReadOne
+	case model.OpTypeList:
+		return r.Ops.ReadMany
+	case model.OpTypeGetAttributes:
+		return r.Ops.GetAttributes
+	case model.OpTypeUpdate:
+		return r.Ops.Update
+	}
+	return nil
+}
+
+// sdkTestWrapper is a member of an Output shape that wraps the shape
+// representing the resource
+type sdkTestWrapper struct {
+	MemberName string
+	Shape      *awssdkmodel.Shape
+	// IsList is true when the member is the list of results of a List
+	// operation, in which case Shape is the shape of the list elements
+	IsList bool
+}
+
+// sdkTestUnwrapOutput returns the shape that SetResource reads the resource
+// from for the supplied operation, along with the chain of wrapper members
+// leading to it from the operation's Output shape.
+func sdkTestUnwrapOutput(
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+) (*awssdkmodel.Shape, []sdkTestWrapper) {
+	if op == r.Ops.ReadMany {
+		memberName, elemShape := sdkTestReadManyListMember(op)
+		if elemShape == nil {
+			return nil, nil
+		}
+		return elemShape, []sdkTestWrapper{{memberName, elemShape, true}}
+	}
+	outputShape := op.OutputRef.Shape
+	wrappers := []sdkTestWrapper{}
+	if wrapperFieldPath := r.GetOutputWrapperFieldPath(op); wrapperFieldPath != nil {
+		for _, memberName := range strings.Split(*wrapperFieldPath, ".") {
+			memberRef, ok := outputShape.MemberRefs[memberName]
+			if !ok || memberRef.Shape == nil || memberRef.Shape.Type != "structure" {
+				// SetResource reports invalid wrapper field paths
+				return nil, nil
+			}
+			outputShape = memberRef.Shape
+			wrappers = append(wrappers, sdkTestWrapper{memberName, outputShape, false})
+		}
+	} else if outputShape.UsedAsOutput && len(outputShape.MemberRefs) == 1 {
+		for memberName, memberRef := range outputShape.MemberRefs {
+			if memberRef.Shape.Type == "structure" {
+				outputShape = memberRef.Shape
+				wrappers = append(wrappers, sdkTestWrapper{memberName, outputShape, false})
+			}
+		}
+	}
+	return outputShape, wrappers
+}
+
+// sdkTestReadManyListMember returns the name of the member of the Output shape
+// of a List operation that contains the list of results, and the shape of the
+// list elements, or nil if those aren't structures.
+func sdkTestReadManyListMember(
+	op *awssdkmodel.Operation,
+) (string, *awssdkmodel.Shape) {
+	outputShape := op.OutputRef.Shape
+	if outputShape == nil {
+		return "", nil
+	}
+	for _, memberName := range outputShape.MemberNames() {
+		memberShape := outputShape.MemberRefs[memberName].Shape
+		if memberShape == nil || memberShape.Type != "list" {
+			continue
+		}
+		// Same heuristic as the one sdkFind relies on: the first list member
+		// holds the results
+		elemShape := memberShape.MemberRef.Shape
+		if elemShape == nil || elemShape.Type != "structure" {
+			return "", nil
+		}
+		return memberName, elemShape
+	}
+	return "", nil
+}
+
+// sdkTestInputFields returns the members of the Input shape of the supplied
+// operation that SetSDK sets from a top-level scalar field of the resource,
+// or from the resource's ARN.
+func sdkTestInputFields(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+) []sdkTestField {
+	res := []sdkTestField{}
+	inputShape := op.InputRef.Shape
+	if inputShape == nil {
+		return res
+	}
+	opConfig, override := cfg.OverrideValues(op.Name)
+	attrOverrides := map[string]*ackgenconfig.MemberConstructorConfig{}
+	if op == r.Ops.GetAttributes {
+		rConfig, ok := cfg.Resources[r.Names.Original]
+		if ok && rConfig.UnpackAttributesMapConfig != nil &&
+			rConfig.UnpackAttributesMapConfig.GetAttributesInput != nil {
+			attrOverrides = rConfig.UnpackAttributesMapConfig.GetAttributesInput.Overrides
+		}
+	}
+	for _, memberName := range inputShape.MemberNames() {
+		if r.UnpacksAttributesMap() && memberName == "Attributes" {
+			continue
+		}
+		if _, ok := opConfig[memberName]; override && ok {
+			continue
+		}
+		if _, ok := attrOverrides[memberName]; ok {
+			continue
+		}
+		if r.IsPrimaryARNField(memberName) {
+			res = append(res, sdkTestField{
+				MemberName: memberName,
+				Path:       "Status.ACKResourceMetadata.ARN",
+			})
+			continue
+		}
+		renamedName, _ := r.InputFieldRename(op.Name, memberName)
+		path := cfg.PrefixConfig.SpecField
+		f, found := r.SpecFields[renamedName]
+		if !found {
+			path = cfg.PrefixConfig.StatusField
+			if f, found = r.StatusFields[renamedName]; !found {
+				continue
+			}
+		}
+		memberRef := inputShape.MemberRefs[memberName]
+		if !sdkTestScalar(f, memberRef) {
+			continue
+		}
+		res = append(res, sdkTestField{
+			MemberName: memberName,
+			Path:       strings.TrimPrefix(path+"."+f.Names.Camel, "."),
+			Field:      f,
+		})
+	}
+	return res
+}
+
+// sdkTestOutputFields returns the members of the supplied (unwrapped) Output
+// shape that SetResource copies into a top-level scalar field of the
+// resource, or into the resource's ARN.
+func sdkTestOutputFields(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+	outputShape *awssdkmodel.Shape,
+) []sdkTestField {
+	res := []sdkTestField{}
+	if outputShape == nil {
+		return res
+	}
+	for _, memberName := range outputShape.MemberNames() {
+		if r.IsPrimaryARNField(memberName) {
+			res = append(res, sdkTestField{
+				MemberName: memberName,
+				Path:       "Status.ACKResourceMetadata.ARN",
+			})
+			continue
+		}
+		renamedName, _ := r.InputFieldRename(op.Name, memberName)
+		path := cfg.PrefixConfig.SpecField
+		f, found := r.SpecFields[renamedName]
+		if !found {
+			path = cfg.PrefixConfig.StatusField
+			if f, found = r.StatusFields[memberName]; !found {
+				continue
+			}
+		}
+		memberRef := outputShape.MemberRefs[memberName]
+		if !sdkTestScalar(f, memberRef) {
+			continue
+		}
+		res = append(res, sdkTestField{
+			MemberName: memberName,
+			Path:       strings.TrimPrefix(path+"."+f.Names.Camel, "."),
+			Field:      f,
+		})
+	}
+	return res
+}
+
+// sdkTestGetAttributesFields returns the entries of the attributes map in the
+// Output shape of a GetAttributes operation that SetResource copies into a
+// top-level scalar Status field of the resource, or into the resource's ARN.
+func sdkTestGetAttributesFields(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+) []sdkTestField {
+	res := []sdkTestField{}
+	fieldConfigs := cfg.ResourceFields(r.Names.Original)
+	attrFieldNames := []string{}
+	for fieldName, fieldConfig := range fieldConfigs {
+		if fieldConfig.IsAttribute {
+			attrFieldNames = append(attrFieldNames, fieldName)
+		}
+	}
+	sort.Strings(attrFieldNames)
+	for _, fieldName := range attrFieldNames {
+		if r.IsPrimaryARNField(fieldName) {
+			res = append(res, sdkTestField{
+				MemberName: fieldName,
+				Path:       "Status.ACKResourceMetadata.ARN",
+			})
+			continue
+		}
+		fieldConfig := fieldConfigs[fieldName]
+		if fieldConfig.IsOwnerAccountID || !fieldConfig.IsReadOnly {
+			continue
+		}
+		f, found := r.StatusFields[fieldName]
+		if !found || f.TypeOverride() != nil || f.GoType != "*string" {
+			continue
+		}
+		res = append(res, sdkTestField{
+			MemberName: fieldName,
+			Path: strings.TrimPrefix(
+				cfg.PrefixConfig.StatusField+"."+f.Names.Camel, ".",
+			),
+			Field: f,
+		})
+	}
+	return res
+}
+
+// sdkTestOutputValue returns the Go code for the test value of an Output
+// shape member derived from the supplied seed.
+func sdkTestOutputValue(
+	r *model.CRD,
+	tf sdkTestField,
+	seed int,
+) string {
+	if tf.Field == nil {
+		return fmt.Sprintf(
+			"aws.String(\"arn:aws:%s:us-west-2:111111111111:%s/%d\")",
+			r.SDKAPIPackageName(), r.Names.Snake, seed,
+		)
+	}
+	return sdkTestValue(tf.Field, seed)
+}
+
+// sdkTestScalar returns true if the supplied field is a scalar that is copied
+// as is to or from the supplied shape member.
+func sdkTestScalar(
+	f *model.Field,
+	memberRef *awssdkmodel.ShapeRef,
+) bool {
+	if f.TypeOverride() != nil || memberRef == nil || memberRef.Shape == nil {
+		return false
+	}
+	return sdkTestValue(f, 0) != "" && memberRef.GoType() == f.GoType
+}
+
+// sdkTestValue returns the Go code for the test value of a scalar field
+// derived from the supplied seed, or the empty string if the field isn't a
+// scalar.
+func sdkTestValue(
+	f *model.Field,
+	seed int,
+) string {
+	if f.TypeOverride() != nil {
+		return ""
+	}
+	switch f.GoType {
+	case "*string":
+		return fmt.Sprintf("aws.String(\"%s-%d\")", f.Names.Camel, seed)
+	case "*int64":
+		return fmt.Sprintf("aws.Int64(%d)", seed)
+	case "*bool":
+		return fmt.Sprintf("aws.Bool(%t)", seed%2 == 1)
+	case "*float64":
+		return fmt.Sprintf("aws.Float64(%d.5)", seed)
+	}
+	return ""
+}
@@ -0,0 +1,191 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestSDKTestable_APIGWv2(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "apigatewayv2")
+
+	// Api has a custom Create implementation and a custom update method
+	crd := testutil.GetCRDByName(t, g, "Api")
+	require.NotNil(crd)
+	assert.False(code.SDKTestable(crd.Config(), crd, model.OpTypeCreate))
+	assert.True(code.SDKTestable(crd.Config(), crd, model.OpTypeGet))
+	assert.False(code.SDKTestable(crd.Config(), crd, model.OpTypeUpdate))
+
+	crd = testutil.GetCRDByName(t, g, "Route")
+	require.NotNil(crd)
+	assert.True(code.SDKTestable(crd.Config(), crd, model.OpTypeCreate))
+	assert.True(code.SDKTestable(crd.Config(), crd, model.OpTypeGet))
+	assert.True(code.SDKTestable(crd.Config(), crd, model.OpTypeUpdate))
+	assert.False(code.SDKTestable(crd.Config(), crd, model.OpTypeDelete))
+}
+
+func TestSDKTest_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "ecr")
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// ECR has no ReadOne nor Update operation for repositories, so sdkFind
+	// calls the ReadMany operation
+	assert.True(code.SDKTestable(crd.Config(), crd, model.OpTypeCreate))
+	assert.False(code.SDKTestable(crd.Config(), crd, model.OpTypeGet))
+	assert.True(code.SDKTestable(crd.Config(), crd, model.OpTypeList))
+	assert.False(code.SDKTestable(crd.Config(), crd, model.OpTypeGetAttributes))
+	assert.False(code.SDKTestable(crd.Config(), crd, model.OpTypeUpdate))
+
+	expectedResource := `
+	ko.Spec.ImageTagMutability = aws.String("ImageTagMutability-1")
+	ko.Spec.RepositoryName = aws.String("RepositoryName-1")
+	ko.Status.RegistryID = aws.String("RegistryID-1")
+	ko.Status.RepositoryURI = aws.String("RepositoryURI-1")
+	arn := ackv1alpha1.AWSResourceName("arn:aws:ecr:us-west-2:111111111111:repository/1")
+	ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{
+		ARN:            &arn,
+		OwnerAccountID: &testAccountID,
+	}`
+	assert.Equal(
+		expectedResource,
+		code.SDKTestSetResource(crd.Config(), crd, "ko", 1, 1),
+	)
+
+	// The Output shape wraps the Repository shape
+	expectedOutput := `
+	resp.Repository = &svcsdk.Repository{}
+	resp.Repository.ImageTagMutability = aws.String("ImageTagMutability-2")
+	resp.Repository.RegistryId = aws.String("RegistryID-2")
+	resp.Repository.RepositoryArn = aws.String("arn:aws:ecr:us-west-2:111111111111:repository/2")
+	resp.Repository.RepositoryName = aws.String("RepositoryName-2")
+	resp.Repository.RepositoryUri = aws.String("RepositoryURI-2")`
+	assert.Equal(
+		expectedOutput,
+		code.SDKTestSetOutput(crd.Config(), crd, model.OpTypeCreate, "resp", 2, 1),
+	)
+
+	expectedInputCases := `
+	{"Spec.ImageTagMutability", input.ImageTagMutability, r.ko.Spec.ImageTagMutability},
+	{"Spec.RepositoryName", input.RepositoryName, r.ko.Spec.RepositoryName},`
+	assert.Equal(
+		expectedInputCases,
+		code.SDKTestInputCases(crd.Config(), crd, model.OpTypeCreate, "input", "r.ko", 1),
+	)
+
+	expectedOutputCases := `
+	{"Spec.ImageTagMutability", ko.Spec.ImageTagMutability, resp.Repository.ImageTagMutability},
+	{"Status.RegistryID", ko.Status.RegistryID, resp.Repository.RegistryId},
+	{"Status.ACKResourceMetadata.ARN", (*string)(ko.Status.ACKResourceMetadata.ARN), resp.Repository.RepositoryArn},
+	{"Spec.RepositoryName", ko.Spec.RepositoryName, resp.Repository.RepositoryName},
+	{"Status.RepositoryURI", ko.Status.RepositoryURI, resp.Repository.RepositoryUri},`
+	assert.Equal(
+		expectedOutputCases,
+		code.SDKTestOutputCases(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1),
+	)
+
+	// The Output shape of the ReadMany operation lists the repositories
+	expectedListOutput := `
+	resp.Repositories = []*svcsdk.Repository{{}}
+	resp.Repositories[0].ImageTagMutability = aws.String("ImageTagMutability-2")
+	resp.Repositories[0].RegistryId = aws.String("RegistryID-2")
+	resp.Repositories[0].RepositoryArn = aws.String("arn:aws:ecr:us-west-2:111111111111:repository/2")
+	resp.Repositories[0].RepositoryName = aws.String("RepositoryName-2")
+	resp.Repositories[0].RepositoryUri = aws.String("RepositoryURI-2")`
+	assert.Equal(
+		expectedListOutput,
+		code.SDKTestSetOutput(crd.Config(), crd, model.OpTypeList, "resp", 2, 1),
+	)
+
+	expectedMatchFields := `
+	resp.Repositories[0].RepositoryName = r.ko.Spec.RepositoryName`
+	assert.Equal(
+		expectedMatchFields,
+		code.SDKTestSetReadManyMatchFields(crd.Config(), crd, "resp", "r.ko", 1),
+	)
+
+	expectedListInputCases := `
+	{"Status.RegistryID", input.RegistryId, r.ko.Status.RegistryID},`
+	assert.Equal(
+		expectedListInputCases,
+		code.SDKTestInputCases(crd.Config(), crd, model.OpTypeList, "input", "r.ko", 1),
+	)
+
+	expectedListOutputCases := `
+	{"Spec.ImageTagMutability", ko.Spec.ImageTagMutability, resp.Repositories[0].ImageTagMutability},
+	{"Status.RegistryID", ko.Status.RegistryID, resp.Repositories[0].RegistryId},
+	{"Status.ACKResourceMetadata.ARN", (*string)(ko.Status.ACKResourceMetadata.ARN), resp.Repositories[0].RepositoryArn},
+	{"Spec.RepositoryName", ko.Spec.RepositoryName, resp.Repositories[0].RepositoryName},
+	{"Status.RepositoryURI", ko.Status.RepositoryURI, resp.Repositories[0].RepositoryUri},`
+	assert.Equal(
+		expectedListOutputCases,
+		code.SDKTestOutputCases(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1),
+	)
+}
+
+func TestSDKTest_SNS_Topic(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "sns")
+
+	crd := testutil.GetCRDByName(t, g, "Topic")
+	require.NotNil(crd)
+
+	// SNS has no ReadOne operation for topics, so sdkFind calls the
+	// GetAttributes operation
+	assert.False(code.SDKTestable(crd.Config(), crd, model.OpTypeGet))
+	assert.False(code.SDKTestable(crd.Config(), crd, model.OpTypeList))
+	assert.True(code.SDKTestable(crd.Config(), crd, model.OpTypeGetAttributes))
+
+	// SetResource doesn't copy the attributes that aren't read-only, and the
+	// owner account ID is an AWSAccountID rather than a string
+	expectedOutput := `
+	resp.Attributes = map[string]*string{}
+	resp.Attributes["EffectiveDeliveryPolicy"] = aws.String("EffectiveDeliveryPolicy-2")
+	resp.Attributes["TopicArn"] = aws.String("arn:aws:sns:us-west-2:111111111111:topic/2")`
+	assert.Equal(
+		expectedOutput,
+		code.SDKTestSetOutput(crd.Config(), crd, model.OpTypeGetAttributes, "resp", 2, 1),
+	)
+
+	expectedInputCases := `
+	{"Status.ACKResourceMetadata.ARN", input.TopicArn, (*string)(r.ko.Status.ACKResourceMetadata.ARN)},`
+	assert.Equal(
+		expectedInputCases,
+		code.SDKTestInputCases(crd.Config(), crd, model.OpTypeGetAttributes, "input", "r.ko", 1),
+	)
+
+	expectedOutputCases := `
+	{"Status.EffectiveDeliveryPolicy", ko.Status.EffectiveDeliveryPolicy, resp.Attributes["EffectiveDeliveryPolicy"]},
+	{"Status.ACKResourceMetadata.ARN", (*string)(ko.Status.ACKResourceMetadata.ARN), resp.Attributes["TopicArn"]},`
+	assert.Equal(
+		expectedOutputCases,
+		code.SDKTestOutputCases(crd.Config(), crd, model.OpTypeGetAttributes, "resp", "ko", 1),
+	)
+}
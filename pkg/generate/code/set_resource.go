@@ -88,7 +88,7 @@ func SetResource(
 	targetVarName string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	var op *awssdkmodel.Operation
 	switch opType {
 	case model.OpTypeCreate:
@@ -105,14 +105,14 @@ func SetResource(
 	case model.OpTypeDelete:
 		op = r.Ops.Delete
 	default:
-		return ""
+		return "", nil
 	}
 	if op == nil {
-		return ""
+		return "", nil
 	}
 	outputShape := op.OutputRef.Shape
 	if outputShape == nil {
-		return ""
+		return "", nil
 	}
 
 	var err error
@@ -124,8 +124,10 @@ func SetResource(
 	if wrapperFieldPath != nil {
 		outputShape, err = r.GetWrapperOutputShape(outputShape, *wrapperFieldPath)
 		if err != nil {
-			msg := fmt.Sprintf("Unable to unwrap the output shape: %v", err)
-			panic(msg)
+			return "", fmt.Errorf(
+				"unable to unwrap the output shape of operation %s of resource %s: %v",
+				op.Name, r.Names.Original, err,
+			)
 		}
 		sourceVarName += "." + *wrapperFieldPath
 	} else {
@@ -194,11 +196,10 @@ func SetResource(
 		if sourceMemberShapeRef.Shape == nil {
 			// Technically this should not happen, so let's bail here if it
 			// does...
-			msg := fmt.Sprintf(
-				"expected .Shape to not be nil for ShapeRef of memberName %s",
-				memberName,
+			return "", fmt.Errorf(
+				"%v: member %s of the output shape of operation %s of resource %s",
+				model.ErrNilShapePointer, memberName, op.Name, r.Names.Original,
 			)
-			panic(msg)
 		}
 
 		sourceMemberShape := sourceMemberShapeRef.Shape
@@ -226,6 +227,23 @@ func SetResource(
 			targetAdaptedVarName += cfg.PrefixConfig.StatusField
 		}
 		targetMemberShapeRef = f.ShapeRef
+		if normalizedListTags(r, f) {
+			out += setResourceForNormalizedTags(
+				targetAdaptedVarName+"."+f.Names.Camel,
+				sourceAdaptedVarName,
+				indentLevel,
+			)
+			continue
+		}
+		if override := f.TypeOverride(); override != nil {
+			out += setResourceForTypeOverride(
+				override, sourceMemberShape.Type,
+				targetAdaptedVarName+"."+f.Names.Camel,
+				sourceAdaptedVarName,
+				indentLevel,
+			)
+			continue
+		}
 		// fieldVarName is the name of the variable that is used for temporary
 		// storage of complex member field values
 		//
@@ -307,22 +325,38 @@ func SetResource(
 			"%s}\n", indent,
 		)
 	}
-	return out
+	return out, nil
 }
 
 func ListMemberNameInReadManyOutput(
 	r *model.CRD,
-) string {
+) (string, error) {
+	memberName, _, err := readManyListMember(r, r.Ops.ReadMany)
+	return memberName, err
+}
+
+// readManyListMember returns the name of the member of a List operation's
+// output shape that contains the list of resources, and the shape of the
+// list elements.
+func readManyListMember(
+	r *model.CRD,
+	op *awssdkmodel.Operation,
+) (string, *awssdkmodel.Shape, error) {
+	outputShape := op.OutputRef.Shape
 	// Find the element in the output shape that contains the list of
 	// resources. This heuristic is simplistic (just look for the field with a
 	// list type) but seems to be followed consistently by the aws-sdk-go for
 	// List operations.
-	for memberName, memberShapeRef := range r.Ops.ReadMany.OutputRef.Shape.MemberRefs {
+	for _, memberName := range outputShape.MemberNames() {
+		memberShapeRef := outputShape.MemberRefs[memberName]
 		if memberShapeRef.Shape.Type == "list" {
-			return memberName
+			return memberName, memberShapeRef.Shape.MemberRef.Shape, nil
 		}
 	}
-	panic("List output shape had no field of type 'list'")
+	return "", nil, fmt.Errorf(
+		"output shape of operation %s of resource %s has no member of type list",
+		op.Name, r.Names.Original,
+	)
 }
 
 // setResourceReadMany sets the supplied target variable from the results of a
@@ -391,32 +425,18 @@ func setResourceReadMany(
 	targetVarName string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	outputShape := op.OutputRef.Shape
 	if outputShape == nil {
-		return ""
+		return "", nil
 	}
 
 	out := "\n"
 	indent := strings.Repeat("\t", indentLevel)
 
-	listShapeName := ""
-	var sourceElemShape *awssdkmodel.Shape
-
-	// Find the element in the output shape that contains the list of
-	// resources. This heuristic is simplistic (just look for the field with a
-	// list type) but seems to be followed consistently by the aws-sdk-go for
-	// List operations.
-	for memberName, memberShapeRef := range outputShape.MemberRefs {
-		if memberShapeRef.Shape.Type == "list" {
-			listShapeName = memberName
-			sourceElemShape = memberShapeRef.Shape.MemberRef.Shape
-			break
-		}
-	}
-
-	if listShapeName == "" {
-		panic("List output shape had no field of type 'list'")
+	listShapeName, sourceElemShape, err := readManyListMember(r, op)
+	if err != nil {
+		return "", err
 	}
 
 	// Set of field names in the element shape that, if the generator config
@@ -428,11 +448,10 @@ func setResourceReadMany(
 		_, foundSpec := r.SpecFields[matchFieldName]
 		_, foundStatus := r.StatusFields[matchFieldName]
 		if !foundSpec && !foundStatus {
-			msg := fmt.Sprintf(
-				"Match field name %s is not in %s Spec or Status fields",
+			return "", fmt.Errorf(
+				"match field name %s is not in %s Spec or Status fields",
 				matchFieldName, r.Names.Camel,
 			)
-			panic(msg)
 		}
 	}
 
@@ -499,17 +518,33 @@ func setResourceReadMany(
 			f, found = r.StatusFields[renamedName]
 			if !found {
 				if foundInputFieldRename {
-					msg := fmt.Sprintf(
-						"Input field rename %s for operation %s is not part of %s Spec or Status fields",
+					return "", fmt.Errorf(
+						"input field rename %s for operation %s is not part of %s Spec or Status fields",
 						memberName, op.Name, r.Names.Camel,
 					)
-					panic(msg)
 				}
 				continue
 			}
 			targetAdaptedVarName += cfg.PrefixConfig.StatusField
 		}
 		targetMemberShapeRef = f.ShapeRef
+		if normalizedListTags(r, f) {
+			out += setResourceForNormalizedTags(
+				targetAdaptedVarName+"."+f.Names.Camel,
+				sourceAdaptedVarName,
+				indentLevel+1,
+			)
+			continue
+		}
+		if override := f.TypeOverride(); override != nil {
+			out += setResourceForTypeOverride(
+				override, sourceMemberShape.Type,
+				targetAdaptedVarName+"."+f.Names.Camel,
+				sourceAdaptedVarName,
+				indentLevel+1,
+			)
+			continue
+		}
 		out += fmt.Sprintf(
 			"%s\tif %s != nil {\n", indent, sourceAdaptedVarName,
 		)
@@ -612,7 +647,107 @@ func setResourceReadMany(
 	out += fmt.Sprintf("%sif !found {\n", indent)
 	out += fmt.Sprintf("%s\t%s\n", indent, cfg.SetManyOutputNotFoundErrReturn)
 	out += fmt.Sprintf("%s}\n", indent)
-	return out
+	return out, nil
+}
+
+// ReadManyHasMatch returns the Go code that returns true if a page of
+// results of the ReadMany operation contains the resource, using the same
+// match fields as the code returned by SetResource for OpTypeList. sdkFind
+// stops reading the pages of results of a paginated ReadMany operation once
+// a page contains the resource.
+//
+// As an example, for the DescribeCacheClusters Elasticache API call, the
+// returned code looks like this:
+//
+//  for _, elem := range page.CacheClusters {
+//      if elem.CacheClusterId != nil && r.ko.Spec.CacheClusterID != nil {
+//          if *elem.CacheClusterId != *r.ko.Spec.CacheClusterID {
+//              continue
+//          }
+//      }
+//      return true
+//  }
+//  return false
+func ReadManyHasMatch(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable that contains the
+	// resource's CR, e.g. "r.ko"
+	koVarName string,
+	// String representing the name of the variable that contains the page
+	// of results, an Output shape of the ReadMany operation
+	sourceVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) (string, error) {
+	op := r.Ops.ReadMany
+	if op == nil || op.OutputRef.Shape == nil {
+		return "", nil
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	listShapeName, sourceElemShape, err := readManyListMember(r, op)
+	if err != nil {
+		return "", err
+	}
+	matchFieldNames := r.ListOpMatchFieldNames()
+
+	matchChecks := ""
+	for _, memberName := range sourceElemShape.MemberNames() {
+		sourceMemberShape := sourceElemShape.MemberRefs[memberName].Shape
+		if r.IsPrimaryARNField(memberName) {
+			continue
+		}
+		switch sourceMemberShape.Type {
+		case "list", "structure", "map":
+			continue
+		}
+		renamedName, _ := r.InputFieldRename(op.Name, memberName)
+		if !util.InStrings(renamedName, matchFieldNames) {
+			continue
+		}
+		targetVarName := koVarName
+		f, found := r.SpecFields[renamedName]
+		if found {
+			targetVarName += cfg.PrefixConfig.SpecField
+		} else {
+			f, found = r.StatusFields[renamedName]
+			if !found {
+				continue
+			}
+			targetVarName += cfg.PrefixConfig.StatusField
+		}
+		if normalizedListTags(r, f) || f.TypeOverride() != nil {
+			continue
+		}
+		sourceAdaptedVarName := "elem." + memberName
+		targetAdaptedVarName := targetVarName + "." + f.Names.Camel
+		matchChecks += fmt.Sprintf(
+			"%s\tif %s != nil && %s != nil {\n",
+			indent, sourceAdaptedVarName, targetAdaptedVarName,
+		)
+		matchChecks += fmt.Sprintf(
+			"%s\t\tif *%s != *%s {\n",
+			indent, sourceAdaptedVarName, targetAdaptedVarName,
+		)
+		matchChecks += fmt.Sprintf("%s\t\t\tcontinue\n", indent)
+		matchChecks += fmt.Sprintf("%s\t\t}\n", indent)
+		matchChecks += fmt.Sprintf("%s\t}\n", indent)
+	}
+	// Without match fields, the first element of the page is the resource
+	if matchChecks == "" {
+		return fmt.Sprintf(
+			"%sreturn len(%s.%s) > 0", indent, sourceVarName, listShapeName,
+		), nil
+	}
+	out := fmt.Sprintf(
+		"%sfor _, elem := range %s.%s {\n",
+		indent, sourceVarName, listShapeName,
+	)
+	out += matchChecks
+	out += fmt.Sprintf("%s\treturn true\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	out += fmt.Sprintf("%sreturn false", indent)
+	return out, nil
 }
 
 // ackResourceMetadataGuardConstructor returns Go code representing a nil-guard
@@ -692,22 +827,21 @@ func SetResourceGetAttributes(
 	targetVarName string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	if !r.UnpacksAttributesMap() {
 		// This is a bug in the code generation if this occurs...
-		msg := fmt.Sprintf(
-			"called SetResourceGetAttributes for a resource '%s' that doesn't unpack attributes map",
-			r.Ops.GetAttributes.Name,
+		return "", fmt.Errorf(
+			"called SetResourceGetAttributes for resource %s that doesn't unpack attributes map",
+			r.Names.Original,
 		)
-		panic(msg)
 	}
 	op := r.Ops.GetAttributes
 	if op == nil {
-		return ""
+		return "", nil
 	}
 	inputShape := op.InputRef.Shape
 	if inputShape == nil {
-		return ""
+		return "", nil
 	}
 
 	out := "\n"
@@ -780,7 +914,7 @@ func SetResourceGetAttributes(
 			)
 		}
 	}
-	return out
+	return out, nil
 }
 
 // SetResourceIdentifiers returns the Go code that sets an empty CR object with
@@ -852,19 +986,22 @@ func SetResourceIdentifiers(
 	targetVarName string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	op := r.Ops.ReadOne
 	if op == nil {
 		if r.Ops.GetAttributes != nil {
 			// TODO(RedbackThomson): Support attribute maps for resource identifiers
-			return ""
+			return "", nil
 		}
 		// If single lookups can only be done using ReadMany
 		op = r.Ops.ReadMany
 	}
+	if op == nil {
+		return "", nil
+	}
 	inputShape := op.InputRef.Shape
 	if inputShape == nil {
-		return ""
+		return "", nil
 	}
 
 	primaryKeyOut := ""
@@ -888,11 +1025,14 @@ func SetResourceIdentifiers(
 
 	// Check if the CRD defines the primary keys
 	if r.IsARNPrimaryKey() {
-		return arnOut
+		return arnOut, nil
 	}
 	primaryField, err := r.GetPrimaryKeyField()
 	if err != nil {
-		panic(err)
+		return "", fmt.Errorf(
+			"cannot find the primary key field of resource %s: %v",
+			r.Names.Original, err,
+		)
 	}
 
 	var primaryCRField, primaryShapeField string
@@ -906,9 +1046,12 @@ func SetResourceIdentifiers(
 			sourceVarName,
 			indentLevel)
 	} else {
-		primaryCRField, primaryShapeField = FindPrimaryIdentifierFieldNames(cfg, r, op)
+		primaryCRField, primaryShapeField, err = FindPrimaryIdentifierFieldNames(cfg, r, op)
+		if err != nil {
+			return "", err
+		}
 		if primaryShapeField == PrimaryIdentifierARNOverride {
-			return arnOut
+			return arnOut, nil
 		}
 	}
 
@@ -968,7 +1111,10 @@ func SetResourceIdentifiers(
 
 		switch targetField.ShapeRef.Shape.Type {
 		case "list", "structure", "map":
-			panic("primary identifier '" + targetField.Path + "' must be a scalar type since NameOrID is a string")
+			return "", fmt.Errorf(
+				"primary identifier %s of resource %s must be a scalar type since NameOrID is a string",
+				targetField.Path, r.Names.Original,
+			)
 		default:
 			break
 		}
@@ -992,7 +1138,7 @@ func SetResourceIdentifiers(
 		}
 	}
 
-	return primaryKeyConditionalOut + primaryKeyOut + additionalKeyOut
+	return primaryKeyConditionalOut + primaryKeyOut + additionalKeyOut, nil
 }
 
 // findFieldInCR will search for a given field, by its name, in a CR and returns
@@ -122,10 +122,9 @@ func TestSetResource_APIGWv2_Route_Create(t *testing.T) {
 		ko.Spec.Target = nil
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_APIGWv2_Route_ReadOne(t *testing.T) {
@@ -224,10 +223,9 @@ func TestSetResource_APIGWv2_Route_ReadOne(t *testing.T) {
 		ko.Spec.Target = nil
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_DynamoDB_Backup_ReadOne(t *testing.T) {
@@ -278,10 +276,9 @@ func TestSetResource_DynamoDB_Backup_ReadOne(t *testing.T) {
 		ko.Status.BackupType = nil
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_CodeDeploy_Deployment_Create(t *testing.T) {
@@ -304,10 +301,9 @@ func TestSetResource_CodeDeploy_Deployment_Create(t *testing.T) {
 		ko.Status.DeploymentID = nil
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_DynamoDB_Table_ReadOne(t *testing.T) {
@@ -652,10 +648,9 @@ func TestSetResource_DynamoDB_Table_ReadOne(t *testing.T) {
 		ko.Status.TableStatus = nil
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_EC2_LaunchTemplate_Create(t *testing.T) {
@@ -718,10 +713,9 @@ func TestSetResource_EC2_LaunchTemplate_Create(t *testing.T) {
 		ko.Status.Tags = nil
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_ECR_Repository_Create(t *testing.T) {
@@ -779,10 +773,9 @@ func TestSetResource_ECR_Repository_Create(t *testing.T) {
 		ko.Status.RepositoryURI = nil
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_ECR_Repository_ReadMany(t *testing.T) {
@@ -855,10 +848,50 @@ func TestSetResource_ECR_Repository_ReadMany(t *testing.T) {
 		return nil, ackerr.NotFound
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
+
+func TestReadManyHasMatch_ECR_Repository(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "ecr")
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// A page of DescribeRepositories results contains the repository when one
+	// of its elements matches the RepositoryName match field
+	expected := `	for _, elem := range page.Repositories {
+		if elem.RepositoryName != nil && r.ko.Spec.RepositoryName != nil {
+			if *elem.RepositoryName != *r.ko.Spec.RepositoryName {
+				continue
+			}
+		}
+		return true
+	}
+	return false`
+	got, err := code.ReadManyHasMatch(crd.Config(), crd, "r.ko", "page", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
+
+func TestReadManyHasMatch_EC2_VPC(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "ec2")
+
+	crd := testutil.GetCRDByName(t, g, "Vpc")
+	require.NotNil(crd)
+
+	// Without match fields, the first element of the page is the resource
+	expected := `	return len(page.Vpcs) > 0`
+	got, err := code.ReadManyHasMatch(crd.Config(), crd, "r.ko", "page", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_Elasticache_ReplicationGroup_Create(t *testing.T) {
@@ -1203,10 +1236,9 @@ func TestSetResource_Elasticache_ReplicationGroup_Create(t *testing.T) {
 		ko.Spec.UserGroupIDs = nil
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_Elasticache_ReplicationGroup_ReadMany(t *testing.T) {
@@ -1559,10 +1591,9 @@ func TestSetResource_Elasticache_ReplicationGroup_ReadMany(t *testing.T) {
 		return nil, ackerr.NotFound
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_RDS_DBInstance_Create(t *testing.T) {
@@ -2113,10 +2144,9 @@ func TestSetResource_RDS_DBInstance_Create(t *testing.T) {
 		ko.Status.VPCSecurityGroups = nil
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_RDS_DBInstance_ReadMany(t *testing.T) {
@@ -2678,10 +2708,9 @@ func TestSetResource_RDS_DBInstance_ReadMany(t *testing.T) {
 		return nil, ackerr.NotFound
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_S3_Bucket_Create(t *testing.T) {
@@ -2700,10 +2729,9 @@ func TestSetResource_S3_Bucket_Create(t *testing.T) {
 		ko.Status.Location = nil
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_S3_Bucket_ReadMany(t *testing.T) {
@@ -2735,10 +2763,9 @@ func TestSetResource_S3_Bucket_ReadMany(t *testing.T) {
 		return nil, ackerr.NotFound
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_SNS_Topic_Create(t *testing.T) {
@@ -2765,10 +2792,9 @@ func TestSetResource_SNS_Topic_Create(t *testing.T) {
 		ko.Status.ACKResourceMetadata.ARN = &arn
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_SNS_Topic_GetAttributes(t *testing.T) {
@@ -2795,10 +2821,9 @@ func TestSetResource_SNS_Topic_GetAttributes(t *testing.T) {
 	tmpARN := ackv1alpha1.AWSResourceName(*resp.Attributes["TopicArn"])
 	ko.Status.ACKResourceMetadata.ARN = &tmpARN
 `
-	assert.Equal(
-		expected,
-		code.SetResourceGetAttributes(crd.Config(), crd, "resp", "ko", 1),
-	)
+	got, err := code.SetResourceGetAttributes(crd.Config(), crd, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_SQS_Queue_Create(t *testing.T) {
@@ -2819,10 +2844,9 @@ func TestSetResource_SQS_Queue_Create(t *testing.T) {
 		ko.Status.QueueURL = nil
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_SQS_Queue_GetAttributes(t *testing.T) {
@@ -2848,9 +2872,28 @@ func TestSetResource_SQS_Queue_GetAttributes(t *testing.T) {
 	tmpARN := ackv1alpha1.AWSResourceName(*resp.Attributes["QueueArn"])
 	ko.Status.ACKResourceMetadata.ARN = &tmpARN
 `
+	got, err := code.SetResourceGetAttributes(crd.Config(), crd, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
+
+func TestSetResource_ECR_Repository_GetAttributes_Error(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "ecr")
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// Repositories don't unpack an attributes map. The problem is returned
+	// for the template execution to fail instead of panicking.
+	got, err := code.SetResourceGetAttributes(crd.Config(), crd, "resp", "ko", 1)
+	assert.Empty(got)
+	require.NotNil(err)
 	assert.Equal(
-		expected,
-		code.SetResourceGetAttributes(crd.Config(), crd, "resp", "ko", 1),
+		"called SetResourceGetAttributes for resource Repository that doesn't unpack attributes map",
+		err.Error(),
 	)
 }
 
@@ -2934,10 +2977,9 @@ func TestSetResource_RDS_DBSubnetGroup_ReadMany(t *testing.T) {
 		return nil, ackerr.NotFound
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1),
-	)
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeList, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestGetWrapperOutputShape(t *testing.T) {
@@ -3015,10 +3057,9 @@ func TestSetResource_MQ_Broker_SetResourceIdentifiers(t *testing.T) {
 	r.ko.Status.BrokerID = &identifier.NameOrID
 
 `
-	assert.Equal(
-		expected,
-		code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1),
-	)
+	got, err := code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_RDS_DBInstances_SetResourceIdentifiers(t *testing.T) {
@@ -3037,10 +3078,9 @@ func TestSetResource_RDS_DBInstances_SetResourceIdentifiers(t *testing.T) {
 	r.ko.Spec.DBInstanceIdentifier = &identifier.NameOrID
 
 `
-	assert.Equal(
-		expected,
-		code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1),
-	)
+	got, err := code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_RDS_DBSubnetGroup_SetResourceIdentifiers(t *testing.T) {
@@ -3061,9 +3101,25 @@ func TestSetResource_RDS_DBSubnetGroup_SetResourceIdentifiers(t *testing.T) {
 	r.ko.Spec.Name = &identifier.NameOrID
 
 `
+	got, err := code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
+
+func TestSetResource_MQ_User_SetResourceIdentifiers_NoPrimaryIdentifier(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "mq")
+
+	crd := testutil.GetCRDByName(t, g, "User")
+	require.NotNil(crd)
+
+	_, err := code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1)
+	require.NotNil(err)
 	assert.Equal(
-		expected,
-		code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1),
+		"could not find primary identifier for User: set `is_primary_key` for the primary field in the User resource",
+		err.Error(),
 	)
 }
 
@@ -3087,10 +3143,9 @@ func TestSetResource_APIGWV2_ApiMapping_SetResourceIdentifiers(t *testing.T) {
 		r.ko.Spec.DomainName = &f1
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1),
-	)
+	got, err := code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_SageMaker_ModelPackage_SetResourceIdentifiers(t *testing.T) {
@@ -3108,10 +3163,9 @@ func TestSetResource_SageMaker_ModelPackage_SetResourceIdentifiers(t *testing.T)
 	}
 	r.ko.Status.ACKResourceMetadata.ARN = identifier.ARN
 `
-	assert.Equal(
-		expected,
-		code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1),
-	)
+	got, err := code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetResource_EC2_VPC_SetResourceIdentifiers(t *testing.T) {
@@ -3130,8 +3184,58 @@ func TestSetResource_EC2_VPC_SetResourceIdentifiers(t *testing.T) {
 	r.ko.Status.VPCID = &identifier.NameOrID
 
 `
-	assert.Equal(
-		expected,
-		code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1),
-	)
+	got, err := code.SetResourceIdentifiers(crd.Config(), crd, "identifier", "r.ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
+
+func TestSetResource_Lambda_Function_Create_TypeOverrides(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-type-overrides.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Contains(got, `
+	if resp.MemorySize != nil {
+		ko.Spec.MemorySize = k8sresource.NewQuantity(*resp.MemorySize, k8sresource.DecimalSI)
+	} else {
+		ko.Spec.MemorySize = nil
+	}
+`)
+	assert.Contains(got, `
+	if resp.Timeout != nil {
+		ko.Spec.Timeout = &metav1.Duration{Duration: time.Duration(*resp.Timeout) * time.Second}
+	} else {
+		ko.Spec.Timeout = nil
+	}
+`)
+}
+
+func TestSetResource_APIGWv2_Model_ReadOne_TypeOverrides(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "apigatewayv2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-type-overrides.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Model")
+	require.NotNil(crd)
+
+	got, err := code.SetResource(crd.Config(), crd, model.OpTypeGet, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Contains(got, `
+	if resp.Schema != nil {
+		ko.Spec.Schema = &apiextensionsv1.JSON{Raw: []byte(*resp.Schema)}
+	} else {
+		ko.Spec.Schema = nil
+	}
+`)
 }
@@ -0,0 +1,394 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+// SetResourceV2 returns the Go code that sets a CRD's field values from an
+// aws-sdk-go-v2 output shape's member fields. It is the aws-sdk-go-v2
+// counterpart of SetResource.
+//
+// Enums are converted back to strings, 32 bits numbers to the 64 bits numbers
+// the CR fields use and the value-typed members, which are always set, are
+// assigned without a nil check:
+//
+//     if resp.Repository.ImageTagMutability != "" {
+//         ko.Spec.ImageTagMutability = aws.String(string(resp.Repository.ImageTagMutability))
+//     } else {
+//         ko.Spec.ImageTagMutability = nil
+//     }
+//     if resp.Repository.ImageScanningConfiguration != nil {
+//         f1 := &svcapitypes.ImageScanningConfiguration{}
+//         f1.ScanOnPush = aws.Bool(resp.Repository.ImageScanningConfiguration.ScanOnPush)
+//         ko.Spec.ImageScanningConfiguration = f1
+//     } else {
+//         ko.Spec.ImageScanningConfiguration = nil
+//     }
+//
+// ReadMany operations are not supported yet and produce no code.
+func SetResourceV2(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The type of operation to look for the Output shape
+	opType model.OpType,
+	// String representing the name of the variable that we will grab the
+	// Output shape from. This will likely be "resp"
+	sourceVarName string,
+	// String representing the name of the variable that we will be **setting**
+	// with values we get from the Output shape. This will likely be "ko"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) (string, error) {
+	op := sdkV2Operation(r, opType)
+	if op == nil {
+		return "", nil
+	}
+	outputShape := op.OutputRef.Shape
+	if outputShape == nil {
+		return "", nil
+	}
+
+	var err error
+	// We might be in a "wrapper" shape. Unwrap it to find the real object
+	// representation for the CRD's createOp/DescribeOP.
+	wrapperFieldPath := r.GetOutputWrapperFieldPath(op)
+	if wrapperFieldPath != nil {
+		outputShape, err = r.GetWrapperOutputShape(outputShape, *wrapperFieldPath)
+		if err != nil {
+			return "", fmt.Errorf(
+				"unable to unwrap the output shape of operation %s of resource %s: %v",
+				op.Name, r.Names.Original, err,
+			)
+		}
+		sourceVarName += "." + *wrapperFieldPath
+	} else if outputShape.UsedAsOutput && len(outputShape.MemberRefs) == 1 {
+		for memberName, memberRef := range outputShape.MemberRefs {
+			if memberRef.Shape.Type == "structure" {
+				sourceVarName += "." + memberName
+				outputShape = memberRef.Shape
+			}
+		}
+	}
+	out := "\n"
+	indent := strings.Repeat("\t", indentLevel)
+
+	for memberIndex, memberName := range outputShape.MemberNames() {
+		sourceAdaptedVarName := sourceVarName + "." + memberName
+
+		if r.IsPrimaryARNField(memberName) {
+			// if ko.Status.ACKResourceMetadata == nil {
+			//     ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+			// }
+			// if resp.BookArn != nil {
+			//     arn := ackv1alpha1.AWSResourceName(*resp.BookArn)
+			//     ko.Status.ACKResourceMetadata.ARN = &arn
+			// }
+			out += fmt.Sprintf("%sif %s.Status.ACKResourceMetadata == nil {\n", indent, targetVarName)
+			out += fmt.Sprintf(
+				"%s\t%s.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}\n",
+				indent, targetVarName,
+			)
+			out += fmt.Sprintf("%s}\n", indent)
+			out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceAdaptedVarName)
+			out += fmt.Sprintf(
+				"%s\tarn := ackv1alpha1.AWSResourceName(*%s)\n",
+				indent, sourceAdaptedVarName,
+			)
+			out += fmt.Sprintf(
+				"%s\t%s.Status.ACKResourceMetadata.ARN = &arn\n",
+				indent, targetVarName,
+			)
+			out += fmt.Sprintf("%s}\n", indent)
+			continue
+		}
+
+		sourceMemberShapeRef := outputShape.MemberRefs[memberName]
+		if sourceMemberShapeRef.Shape == nil {
+			return "", fmt.Errorf(
+				"%v: member %s of the output shape of operation %s of resource %s",
+				model.ErrNilShapePointer, memberName, op.Name, r.Names.Original,
+			)
+		}
+		sourceMemberShape := sourceMemberShapeRef.Shape
+
+		// Determine whether the output shape's field is in the Spec or the
+		// Status struct and set the target variable appropriately.
+		var f *model.Field
+		var found bool
+		targetAdaptedVarName := targetVarName
+		renamedName, _ := r.InputFieldRename(op.Name, memberName)
+		f, found = r.SpecFields[renamedName]
+		if found {
+			targetAdaptedVarName += cfg.PrefixConfig.SpecField
+		} else {
+			f, found = r.StatusFields[memberName]
+			if !found {
+				continue
+			}
+			targetAdaptedVarName += cfg.PrefixConfig.StatusField
+		}
+		qualifiedTargetVar := fmt.Sprintf(
+			"%s.%s", targetAdaptedVarName, f.Names.Camel,
+		)
+		nullable := r.IsNullableMember(outputShape, memberName)
+
+		switch sourceMemberShape.Type {
+		case "list", "structure", "map":
+			memberVarName := fmt.Sprintf("f%d", memberIndex)
+			out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceAdaptedVarName)
+			out += varEmptyConstructorK8sType(
+				cfg, r,
+				memberVarName,
+				f.ShapeRef.Shape,
+				indentLevel+1,
+			)
+			out += setResourceV2ForContainer(
+				cfg, r,
+				memberVarName,
+				f.ShapeRef,
+				sourceAdaptedVarName,
+				sourceMemberShapeRef,
+				indentLevel+1,
+			)
+			out += fmt.Sprintf("%s\t%s = %s\n", indent, qualifiedTargetVar, memberVarName)
+		default:
+			guard := setResourceV2Guard(sourceMemberShape, nullable, sourceAdaptedVarName)
+			setTo := setResourceV2ScalarValue(sourceMemberShape, nullable, sourceAdaptedVarName)
+			if guard == "" {
+				// Value-typed members are always set
+				out += fmt.Sprintf("%s%s = %s\n", indent, qualifiedTargetVar, setTo)
+				continue
+			}
+			out += fmt.Sprintf("%sif %s {\n", indent, guard)
+			out += fmt.Sprintf("%s\t%s = %s\n", indent, qualifiedTargetVar, setTo)
+		}
+		out += fmt.Sprintf("%s} else {\n", indent)
+		out += fmt.Sprintf("%s\t%s = nil\n", indent, qualifiedTargetVar)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out, nil
+}
+
+// setResourceV2ForContainer returns a string of Go code that sets the members
+// or elements of a target variable from an aws-sdk-go-v2 source variable when
+// the type of the source variable is a map, struct or slice type.
+func setResourceV2ForContainer(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// Shape Ref of the target field
+	targetShapeRef *awssdkmodel.ShapeRef,
+	// The struct or struct field that we access our source value from
+	sourceVarName string,
+	// ShapeRef of the source field
+	sourceShapeRef *awssdkmodel.ShapeRef,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	sourceShape := sourceShapeRef.Shape
+	targetShape := targetShapeRef.Shape
+
+	switch sourceShape.Type {
+	case "structure":
+		for memberIndex, memberName := range sourceShape.MemberNames() {
+			targetMemberShapeRef := targetShape.MemberRefs[memberName]
+			if targetMemberShapeRef == nil {
+				continue
+			}
+			memberShapeRef := sourceShape.MemberRefs[memberName]
+			sourceAdaptedVarName := sourceVarName + "." + memberName
+			qualifiedTargetVar := targetVarName + "." + names.New(memberName).Camel
+			nullable := r.IsNullableMember(sourceShape, memberName)
+
+			switch memberShapeRef.Shape.Type {
+			case "list", "structure", "map":
+				memberVarName := fmt.Sprintf("%sf%d", targetVarName, memberIndex)
+				out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceAdaptedVarName)
+				out += varEmptyConstructorK8sType(
+					cfg, r,
+					memberVarName,
+					targetMemberShapeRef.Shape,
+					indentLevel+1,
+				)
+				out += setResourceV2ForContainer(
+					cfg, r,
+					memberVarName,
+					targetMemberShapeRef,
+					sourceAdaptedVarName,
+					memberShapeRef,
+					indentLevel+1,
+				)
+				out += fmt.Sprintf("%s\t%s = %s\n", indent, qualifiedTargetVar, memberVarName)
+				out += fmt.Sprintf("%s}\n", indent)
+			default:
+				guard := setResourceV2Guard(memberShapeRef.Shape, nullable, sourceAdaptedVarName)
+				setTo := setResourceV2ScalarValue(memberShapeRef.Shape, nullable, sourceAdaptedVarName)
+				if guard == "" {
+					out += fmt.Sprintf("%s%s = %s\n", indent, qualifiedTargetVar, setTo)
+					continue
+				}
+				out += fmt.Sprintf("%sif %s {\n", indent, guard)
+				out += fmt.Sprintf("%s\t%s = %s\n", indent, qualifiedTargetVar, setTo)
+				out += fmt.Sprintf("%s}\n", indent)
+			}
+		}
+	case "list":
+		iterVarName := fmt.Sprintf("%siter", targetVarName)
+		elemVarName := fmt.Sprintf("%selem", targetVarName)
+		// for _, f0iter := range resp.Tags {
+		out += fmt.Sprintf("%sfor _, %s := range %s {\n", indent, iterVarName, sourceVarName)
+		elemOut, elem := setResourceV2ForElement(
+			cfg, r,
+			elemVarName,
+			&targetShape.MemberRef,
+			iterVarName,
+			&sourceShape.MemberRef,
+			indentLevel+1,
+		)
+		out += elemOut
+		//     f0 = append(f0, f0elem)
+		out += fmt.Sprintf("%s\t%s = append(%s, %s)\n", indent, targetVarName, targetVarName, elem)
+		out += fmt.Sprintf("%s}\n", indent)
+	case "map":
+		valIterVarName := fmt.Sprintf("%svaliter", targetVarName)
+		keyVarName := fmt.Sprintf("%skey", targetVarName)
+		valVarName := fmt.Sprintf("%sval", targetVarName)
+		// for f0key, f0valiter := range resp.Tags {
+		out += fmt.Sprintf("%sfor %s, %s := range %s {\n", indent, keyVarName, valIterVarName, sourceVarName)
+		valOut, val := setResourceV2ForElement(
+			cfg, r,
+			valVarName,
+			&targetShape.ValueRef,
+			valIterVarName,
+			&sourceShape.ValueRef,
+			indentLevel+1,
+		)
+		out += valOut
+		//     f0[f0key] = f0val
+		out += fmt.Sprintf("%s\t%s[%s] = %s\n", indent, targetVarName, keyVarName, val)
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
+
+// setResourceV2ForElement returns the Go code that builds a CR slice element
+// or map value from an aws-sdk-go-v2 iterator variable, along with the Go
+// expression of the element value.
+func setResourceV2ForElement(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The variable name used for temporary storage of the element
+	elemVarName string,
+	// Shape Ref of the target element
+	targetShapeRef *awssdkmodel.ShapeRef,
+	// The iterator variable that we access our source value from
+	iterVarName string,
+	// ShapeRef of the source element
+	sourceShapeRef *awssdkmodel.ShapeRef,
+	indentLevel int,
+) (string, string) {
+	switch sourceShapeRef.Shape.Type {
+	case "list", "structure", "map":
+		out := varEmptyConstructorK8sType(
+			cfg, r,
+			elemVarName,
+			targetShapeRef.Shape,
+			indentLevel,
+		)
+		out += setResourceV2ForContainer(
+			cfg, r,
+			elemVarName,
+			targetShapeRef,
+			iterVarName,
+			sourceShapeRef,
+			indentLevel,
+		)
+		return out, elemVarName
+	default:
+		// The aws-sdk-go-v2 list elements and map values are values. Taking
+		// their address would alias the iterator variable.
+		return "", setResourceV2ScalarValue(sourceShapeRef.Shape, false, iterVarName)
+	}
+}
+
+// setResourceV2Guard returns the condition under which an aws-sdk-go-v2
+// scalar member is set, or an empty string if the member is always set
+func setResourceV2Guard(
+	shape *awssdkmodel.Shape,
+	nullable bool,
+	sourceVarName string,
+) string {
+	if shape.IsEnum() {
+		return sourceVarName + " != \"\""
+	}
+	if !nullable {
+		return ""
+	}
+	return sourceVarName + " != nil"
+}
+
+// setResourceV2ScalarValue returns the Go expression converting the value of
+// an aws-sdk-go-v2 scalar member to the pointer type of the CR field. The
+// source variable is a pointer when nullable is true.
+func setResourceV2ScalarValue(
+	shape *awssdkmodel.Shape,
+	nullable bool,
+	sourceVarName string,
+) string {
+	if shape.IsEnum() {
+		// aws.String(string(resp.ImageTagMutability))
+		return fmt.Sprintf("aws.String(string(%s))", sourceVarName)
+	}
+	switch shape.Type {
+	case "timestamp":
+		if nullable {
+			return "&metav1.Time{*" + sourceVarName + "}"
+		}
+		return "&metav1.Time{" + sourceVarName + "}"
+	case "blob":
+		return sourceVarName
+	}
+	goType := sdkV2GoType(shape)
+	value := sourceVarName
+	if nullable {
+		if goType != "int32" && goType != "float32" {
+			// Same pointer type as the CR field
+			return value
+		}
+		value = "*" + value
+	}
+	// The CR fields use 64 bits types for every number
+	switch goType {
+	case "int32":
+		return fmt.Sprintf("aws.Int64(int64(%s))", value)
+	case "float32":
+		return fmt.Sprintf("aws.Float64(float64(%s))", value)
+	}
+	if helper, found := sdkV2PointerHelpers[goType]; found {
+		return fmt.Sprintf("%s(%s)", helper, value)
+	}
+	return value
+}
@@ -0,0 +1,87 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestSetResourceV2_ECR_Repository_Create(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		SmithyModels: true,
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	expected := `
+	if resp.Repository.CreatedAt != nil {
+		ko.Status.CreatedAt = &metav1.Time{*resp.Repository.CreatedAt}
+	} else {
+		ko.Status.CreatedAt = nil
+	}
+	if resp.Repository.ImageScanningConfiguration != nil {
+		f1 := &svcapitypes.ImageScanningConfiguration{}
+		f1.ScanOnPush = aws.Bool(resp.Repository.ImageScanningConfiguration.ScanOnPush)
+		ko.Spec.ImageScanningConfiguration = f1
+	} else {
+		ko.Spec.ImageScanningConfiguration = nil
+	}
+	if resp.Repository.ImageTagMutability != "" {
+		ko.Spec.ImageTagMutability = aws.String(string(resp.Repository.ImageTagMutability))
+	} else {
+		ko.Spec.ImageTagMutability = nil
+	}
+	if resp.Repository.RegistryId != nil {
+		ko.Status.RegistryID = resp.Repository.RegistryId
+	} else {
+		ko.Status.RegistryID = nil
+	}
+	if ko.Status.ACKResourceMetadata == nil {
+		ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	if resp.Repository.RepositoryArn != nil {
+		arn := ackv1alpha1.AWSResourceName(*resp.Repository.RepositoryArn)
+		ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	if resp.Repository.RepositoryName != nil {
+		ko.Spec.RepositoryName = resp.Repository.RepositoryName
+	} else {
+		ko.Spec.RepositoryName = nil
+	}
+	if resp.Repository.RepositoryUri != nil {
+		ko.Status.RepositoryURI = resp.Repository.RepositoryUri
+	} else {
+		ko.Status.RepositoryURI = nil
+	}
+	if resp.Repository.ScanFrequency != nil {
+		ko.Spec.ScanFrequency = aws.Int64(int64(*resp.Repository.ScanFrequency))
+	} else {
+		ko.Spec.ScanFrequency = nil
+	}
+`
+	got, err := code.SetResourceV2(crd.Config(), crd, model.OpTypeCreate, "resp", "ko", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
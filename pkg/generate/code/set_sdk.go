@@ -89,7 +89,7 @@ func SetSDK(
 	targetVarName string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	var op *awssdkmodel.Operation
 	switch opType {
 	case model.OpTypeCreate:
@@ -105,14 +105,14 @@ func SetSDK(
 	case model.OpTypeDelete:
 		op = r.Ops.Delete
 	default:
-		return ""
+		return "", nil
 	}
 	if op == nil {
-		return ""
+		return "", nil
 	}
 	inputShape := op.InputRef.Shape
 	if inputShape == nil {
-		return ""
+		return "", nil
 	}
 
 	out := "\n"
@@ -183,7 +183,10 @@ func SetSDK(
 				case "string":
 					value = "\"" + value + "\""
 				default:
-					panic("Member type not handled")
+					return "", fmt.Errorf(
+						"cannot override member %s of operation %s of resource %s: unsupported shape type %s",
+						memberName, op.Name, r.Names.Original, memberShape.Type,
+					)
 				}
 
 				out += fmt.Sprintf("%s%s.Set%s(%s)\n", indent, targetVarName, memberName, value)
@@ -238,9 +241,24 @@ func SetSDK(
 		sourceAdaptedVarName += "." + f.Names.Camel
 		sourceFieldPath := f.Names.Camel
 
+		if normalizedListTags(r, f) {
+			out += setSDKForNormalizedTags(
+				targetVarName, memberName, sourceAdaptedVarName, indentLevel,
+			)
+			continue
+		}
+
 		memberShapeRef, _ := inputShape.MemberRefs[memberName]
 		memberShape := memberShapeRef.Shape
 
+		if override := f.TypeOverride(); override != nil {
+			out += setSDKForTypeOverride(
+				override, memberShape.Type,
+				targetVarName, memberName, sourceAdaptedVarName, indentLevel,
+			)
+			continue
+		}
+
 		// we construct variables containing temporary storage for sub-elements
 		// and sub-fields that are structs. Names of fields are "f" appended by
 		// the 0-based index of the field within the set of the target struct's
@@ -355,7 +373,7 @@ func SetSDK(
 			"%s}\n", indent,
 		)
 	}
-	return out
+	return out, nil
 }
 
 // SetSDKGetAttributes returns the Go code that sets the Input shape for a
@@ -387,22 +405,21 @@ func SetSDKGetAttributes(
 	targetVarName string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	op := r.Ops.GetAttributes
 	if op == nil {
-		return ""
+		return "", nil
 	}
 	inputShape := op.InputRef.Shape
 	if inputShape == nil {
-		return ""
+		return "", nil
 	}
 	if !r.UnpacksAttributesMap() {
 		// This is a bug in the code generation if this occurs...
-		msg := fmt.Sprintf(
-			"called SetSDKGetAttributes for a resource '%s' that doesn't unpack attributes map",
+		return "", fmt.Errorf(
+			"called SetSDKGetAttributes for resource %s that doesn't unpack attributes map",
 			r.Names.Original,
 		)
-		panic(msg)
 	}
 
 	out := "\n"
@@ -412,11 +429,10 @@ func SetSDKGetAttributes(
 	rConfig, ok := cfg.Resources[r.Names.Original]
 	if !ok {
 		// This is a bug in the code generation if this occurs...
-		msg := fmt.Sprintf(
-			"called SetSDKGetAttributes for a resource '%s' that doesn't have a ResourceConfig",
+		return "", fmt.Errorf(
+			"called SetSDKGetAttributes for resource %s that doesn't have a ResourceConfig",
 			r.Names.Original,
 		)
-		panic(msg)
 	}
 	attrCfg := rConfig.UnpackAttributesMapConfig
 	if attrCfg != nil && attrCfg.GetAttributesInput != nil {
@@ -522,7 +538,7 @@ func SetSDKGetAttributes(
 			"%s}\n", indent,
 		)
 	}
-	return out
+	return out, nil
 }
 
 // SetSDKSetAttributes returns the Go code that sets the Input shape for a
@@ -571,29 +587,28 @@ func SetSDKSetAttributes(
 	targetVarName string,
 	// Number of levels of indentation to use
 	indentLevel int,
-) string {
+) (string, error) {
 	op := r.Ops.SetAttributes
 	if op == nil {
-		return ""
+		return "", nil
 	}
 	inputShape := op.InputRef.Shape
 	if inputShape == nil {
-		return ""
+		return "", nil
 	}
 	if !r.UnpacksAttributesMap() {
 		// This is a bug in the code generation if this occurs...
-		msg := fmt.Sprintf(
-			"called SetSDKSetAttributes for a resource '%s' that doesn't unpack attributes map",
+		return "", fmt.Errorf(
+			"called SetSDKSetAttributes for resource %s that doesn't unpack attributes map",
 			r.Names.Original,
 		)
-		panic(msg)
 	}
 
 	if r.SetAttributesSingleAttribute() {
 		// TODO(jaypipes): For now, because these APIs require *multiple* calls
 		// to the backend, one for each attribute being set, we'll go ahead and
 		// rely on the CustomOperation functionality to write code for these...
-		return ""
+		return "", nil
 	}
 
 	out := "\n"
@@ -717,7 +732,7 @@ func SetSDKSetAttributes(
 			"%s}\n", indent,
 		)
 	}
-	return out
+	return out, nil
 }
 
 // setSDKReadMany is a special-case handling of those APIs where there is no
@@ -748,10 +763,10 @@ func setSDKReadMany(
 	sourceVarName string,
 	targetVarName string,
 	indentLevel int,
-) string {
+) (string, error) {
 	inputShape := op.InputRef.Shape
 	if inputShape == nil {
-		return ""
+		return "", nil
 	}
 
 	out := "\n"
@@ -771,8 +786,10 @@ func setSDKReadMany(
 				case "string":
 					value = "\"" + value + "\""
 				default:
-					panic(fmt.Sprintf("Unsupported shape type %s in "+
-						"generate.code.setSDKReadMany", memberShape.Type))
+					return "", fmt.Errorf(
+						"cannot override member %s of operation %s of resource %s: unsupported shape type %s",
+						memberName, op.Name, r.Names.Original, memberShape.Type,
+					)
 				}
 
 				out += fmt.Sprintf("%s%s.Set%s(%s)\n", indent, targetVarName, memberName, value)
@@ -789,9 +806,10 @@ func setSDKReadMany(
 			if strings.EqualFold(memberName, shapeIdentifier) {
 				resVarPath, err = r.GetSanitizedMemberPath(crIdentifier, op, sourceVarName)
 				if err != nil {
-					panic(fmt.Sprintf(
-						"Unable to locate identifier field %s in "+
-							"%s Spec/Status in generate.code.setSDKReadMany", crIdentifier, r.Kind))
+					return "", fmt.Errorf(
+						"unable to locate identifier field %s of operation %s in %s Spec or Status fields",
+						crIdentifier, op.Name, r.Names.Camel,
+					)
 				}
 			} else {
 				// TODO(jaypipes): check generator config for exceptions?
@@ -851,7 +869,7 @@ func setSDKReadMany(
 		)
 	}
 
-	return out
+	return out, nil
 }
 
 // setSDKForContainer returns a string of Go code that sets the value of a
@@ -91,10 +91,9 @@ func TestSetSDK_APIGWv2_Route_Create(t *testing.T) {
 		res.SetTarget(*r.ko.Spec.Target)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_DynamoDB_Table_Create(t *testing.T) {
@@ -281,10 +280,9 @@ func TestSetSDK_DynamoDB_Table_Create(t *testing.T) {
 		res.SetTags(f9)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_EC2_LaunchTemplate_Create(t *testing.T) {
@@ -685,10 +683,9 @@ func TestSetSDK_EC2_LaunchTemplate_Create(t *testing.T) {
 		res.SetVersionDescription(*r.ko.Spec.VersionDescription)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_ECR_Repository_Create(t *testing.T) {
@@ -733,10 +730,32 @@ func TestSetSDK_ECR_Repository_Create(t *testing.T) {
 		res.SetTags(f3)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
+
+func TestSetSDK_ECR_Repository_Create_NormalizedTags(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-tags.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// The Tags field is a map in the Spec and is converted into the list of
+	// Tag structures of the CreateRepositoryInput shape.
+	expected := `
+	if r.ko.Spec.Tags != nil {
+		res.SetTags(fromACKTags(r.ko.Spec.Tags))
+	}
+`
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Contains(got, expected)
 }
 
 func TestSetSDK_Elasticache_ReplicationGroup_Create(t *testing.T) {
@@ -966,10 +985,9 @@ func TestSetSDK_Elasticache_ReplicationGroup_Create(t *testing.T) {
 		res.SetUserGroupIds(f31)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_Elasticache_ReplicationGroup_ReadMany(t *testing.T) {
@@ -990,10 +1008,9 @@ func TestSetSDK_Elasticache_ReplicationGroup_ReadMany(t *testing.T) {
 		res.SetReplicationGroupId(*r.ko.Spec.ReplicationGroupID)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeList, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeList, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_Elasticache_ReplicationGroup_Update_Override_Values(t *testing.T) {
@@ -1103,10 +1120,9 @@ func TestSetSDK_Elasticache_ReplicationGroup_Update_Override_Values(t *testing.T
 		res.SetSnapshottingClusterId(*r.ko.Status.SnapshottingClusterID)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeUpdate, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeUpdate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_Elasticache_User_Create_Override_Values(t *testing.T) {
@@ -1146,10 +1162,9 @@ func TestSetSDK_Elasticache_User_Create_Override_Values(t *testing.T) {
 		res.SetUserId(*r.ko.Spec.UserID)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeUpdate, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeUpdate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_RDS_DBInstance_Create(t *testing.T) {
@@ -1341,10 +1356,9 @@ func TestSetSDK_RDS_DBInstance_Create(t *testing.T) {
 		res.SetVpcSecurityGroupIds(f45)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_S3_Bucket_Create(t *testing.T) {
@@ -1389,10 +1403,9 @@ func TestSetSDK_S3_Bucket_Create(t *testing.T) {
 		res.SetObjectLockEnabledForBucket(*r.ko.Spec.ObjectLockEnabledForBucket)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_S3_Bucket_Delete(t *testing.T) {
@@ -1409,10 +1422,9 @@ func TestSetSDK_S3_Bucket_Delete(t *testing.T) {
 		res.SetBucket(*r.ko.Spec.Name)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeDelete, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeDelete, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_SNS_Topic_Create(t *testing.T) {
@@ -1461,10 +1473,9 @@ func TestSetSDK_SNS_Topic_Create(t *testing.T) {
 		res.SetTags(f2)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_SNS_Topic_GetAttributes(t *testing.T) {
@@ -1487,10 +1498,9 @@ func TestSetSDK_SNS_Topic_GetAttributes(t *testing.T) {
 		res.SetTopicArn(rm.ARNFromName(*r.ko.Spec.Name))
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDKGetAttributes(crd.Config(), crd, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDKGetAttributes(crd.Config(), crd, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_SQS_Queue_Create(t *testing.T) {
@@ -1551,10 +1561,9 @@ func TestSetSDK_SQS_Queue_Create(t *testing.T) {
 		res.SetTags(f2)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_SQS_Queue_GetAttributes(t *testing.T) {
@@ -1581,10 +1590,9 @@ func TestSetSDK_SQS_Queue_GetAttributes(t *testing.T) {
 		res.SetQueueUrl(*r.ko.Status.QueueURL)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDKGetAttributes(crd.Config(), crd, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDKGetAttributes(crd.Config(), crd, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_MQ_Broker_Create(t *testing.T) {
@@ -1773,10 +1781,9 @@ func TestSetSDK_MQ_Broker_Create(t *testing.T) {
 		res.SetUsers(f18)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1),
-	)
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
 }
 
 func TestSetSDK_EC2_VPC_ReadMany(t *testing.T) {
@@ -1795,8 +1802,53 @@ func TestSetSDK_EC2_VPC_ReadMany(t *testing.T) {
 		res.SetVpcIds(f4)
 	}
 `
-	assert.Equal(
-		expected,
-		code.SetSDK(crd.Config(), crd, model.OpTypeList, "r.ko", "res", 1),
-	)
-}
\ No newline at end of file
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeList, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
+func TestSetSDK_Lambda_Function_Create_TypeOverrides(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-type-overrides.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Function")
+	require.NotNil(crd)
+
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Contains(got, `
+	if r.ko.Spec.MemorySize != nil {
+		res.SetMemorySize(r.ko.Spec.MemorySize.Value())
+	}
+`)
+	assert.Contains(got, `
+	if r.ko.Spec.Timeout != nil {
+		res.SetTimeout(int64(r.ko.Spec.Timeout.Duration.Seconds()))
+	}
+`)
+}
+
+func TestSetSDK_APIGWv2_Model_Create_TypeOverrides(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "apigatewayv2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-type-overrides.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Model")
+	require.NotNil(crd)
+
+	got, err := code.SetSDK(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Contains(
+		got,
+		`
+	if r.ko.Spec.Schema != nil {
+		res.SetSchema(string(r.ko.Spec.Schema.Raw))
+	}
+`)
+}
@@ -0,0 +1,651 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+// The Go code output by the aws-sdk-go-v2 code generators expects the
+// following imports:
+//
+//     "github.com/aws/aws-sdk-go-v2/aws"
+//     svcsdk "github.com/aws/aws-sdk-go-v2/service/{service}"
+//     svcsdktypes "github.com/aws/aws-sdk-go-v2/service/{service}/types"
+
+// sdkV2ScalarTypes maps the API model scalar shape types to the Go types the
+// aws-sdk-go-v2 uses for them
+var sdkV2ScalarTypes = map[string]string{
+	"string":    "string",
+	"boolean":   "bool",
+	"integer":   "int32",
+	"long":      "int64",
+	"float":     "float32",
+	"double":    "float64",
+	"timestamp": "time.Time",
+	"blob":      "[]byte",
+}
+
+// sdkV2PointerHelpers maps the aws-sdk-go-v2 scalar Go types to the `aws`
+// package helpers returning a pointer to a value of that type
+var sdkV2PointerHelpers = map[string]string{
+	"string":  "aws.String",
+	"bool":    "aws.Bool",
+	"int32":   "aws.Int32",
+	"int64":   "aws.Int64",
+	"float32": "aws.Float32",
+	"float64": "aws.Float64",
+}
+
+// SetSDKV2 returns the Go code that sets an aws-sdk-go-v2 input shape's member
+// fields from a CRD's fields. It is the aws-sdk-go-v2 counterpart of SetSDK.
+//
+// The aws-sdk-go-v2 structs have no SetXXX methods, so members are assigned
+// directly. Scalar members are pointers, unless the API model gives them a
+// default value, enums are value-typed string types from the service's types
+// package and the elements of lists and maps are values:
+//
+//     if r.ko.Spec.ImageTagMutability != nil {
+//         res.ImageTagMutability = svcsdktypes.ImageTagMutability(*r.ko.Spec.ImageTagMutability)
+//     }
+//     if r.ko.Spec.RepositoryName != nil {
+//         res.RepositoryName = aws.String(*r.ko.Spec.RepositoryName)
+//     }
+//     if r.ko.Spec.Tags != nil {
+//         f4 := []svcsdktypes.Tag{}
+//         for _, f4iter := range r.ko.Spec.Tags {
+//             f4elem := &svcsdktypes.Tag{}
+//             if f4iter.Key != nil {
+//                 f4elem.Key = aws.String(*f4iter.Key)
+//             }
+//             f4 = append(f4, *f4elem)
+//         }
+//         res.Tags = f4
+//     }
+//
+// ReadMany operations are not supported yet and produce no code.
+func SetSDKV2(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The type of operation to look for the Input shape
+	opType model.OpType,
+	// String representing the name of the variable that we will grab the Input
+	// shape from. This will likely be "r.ko"
+	sourceVarName string,
+	// String representing the name of the variable that we will be **setting**
+	// with values we get from the CRD. This will likely be "res"
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) (string, error) {
+	op := sdkV2Operation(r, opType)
+	if op == nil {
+		return "", nil
+	}
+	inputShape := op.InputRef.Shape
+	if inputShape == nil {
+		return "", nil
+	}
+
+	out := "\n"
+	indent := strings.Repeat("\t", indentLevel)
+
+	_, foundAttrs := inputShape.MemberRefs["Attributes"]
+	if r.UnpacksAttributesMap() && foundAttrs {
+		// attrMap := map[string]string{}
+		// if r.ko.Spec.DeliveryPolicy != nil {
+		//     attrMap["DeliveryPolicy"] = *r.ko.Spec.DeliveryPolicy
+		// }
+		// res.Attributes = attrMap
+		fieldConfigs := cfg.ResourceFields(r.Names.Original)
+		out += fmt.Sprintf("%sattrMap := map[string]string{}\n", indent)
+		sortedAttrFieldNames := []string{}
+		for fName, fConfig := range fieldConfigs {
+			if fConfig.IsAttribute && !fConfig.IsReadOnly {
+				sortedAttrFieldNames = append(sortedAttrFieldNames, fName)
+			}
+		}
+		sort.Strings(sortedAttrFieldNames)
+		for _, fieldName := range sortedAttrFieldNames {
+			fieldNames := names.New(fieldName)
+			sourceAdaptedVarName := sourceVarName + cfg.PrefixConfig.SpecField + "." + fieldNames.Camel
+			out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceAdaptedVarName)
+			out += fmt.Sprintf(
+				"%s\tattrMap[\"%s\"] = *%s\n",
+				indent, fieldName, sourceAdaptedVarName,
+			)
+			out += fmt.Sprintf("%s}\n", indent)
+		}
+		out += fmt.Sprintf("%s%s.Attributes = attrMap\n", indent, targetVarName)
+	}
+
+	opConfig, override := cfg.OverrideValues(op.Name)
+	for memberIndex, memberName := range inputShape.MemberNames() {
+		if r.UnpacksAttributesMap() && memberName == "Attributes" {
+			continue
+		}
+		memberShapeRef := inputShape.MemberRefs[memberName]
+		memberShape := memberShapeRef.Shape
+		nullable := r.IsNullableMember(inputShape, memberName)
+		targetMemberVarName := targetVarName + "." + memberName
+
+		if override {
+			if value, ok := opConfig[memberName]; ok {
+				literal, err := sdkV2LiteralValue(memberShape, nullable, value)
+				if err != nil {
+					return "", fmt.Errorf(
+						"cannot override member %s of operation %s of resource %s: %v",
+						memberName, op.Name, r.Names.Original, err,
+					)
+				}
+				out += fmt.Sprintf(
+					"%s%s = %s\n", indent, targetMemberVarName, literal,
+				)
+				continue
+			}
+		}
+
+		if r.IsPrimaryARNField(memberName) {
+			// if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
+			//     res.TopicArn = aws.String(string(*ko.Status.ACKResourceMetadata.ARN))
+			// } else {
+			//     res.TopicArn = aws.String(rm.ARNFromName(*ko.Spec.Name))
+			// }
+			out += fmt.Sprintf(
+				"%sif %s.Status.ACKResourceMetadata != nil && %s.Status.ACKResourceMetadata.ARN != nil {\n",
+				indent, sourceVarName, sourceVarName,
+			)
+			out += fmt.Sprintf(
+				"%s\t%s = aws.String(string(*%s.Status.ACKResourceMetadata.ARN))\n",
+				indent, targetMemberVarName, sourceVarName,
+			)
+			out += fmt.Sprintf("%s} else {\n", indent)
+			nameField := *r.SpecIdentifierField()
+			out += fmt.Sprintf(
+				"%s\t%s = aws.String(rm.ARNFromName(*%s.Spec.%s))\n",
+				indent, targetMemberVarName, sourceVarName, nameField,
+			)
+			out += fmt.Sprintf("%s}\n", indent)
+			continue
+		}
+		renamedName, _ := r.InputFieldRename(op.Name, memberName)
+		// Determine whether the input shape's field is in the Spec or the
+		// Status struct and set the source variable appropriately.
+		var f *model.Field
+		var found bool
+		sourceAdaptedVarName := sourceVarName
+		f, found = r.SpecFields[renamedName]
+		if found {
+			sourceAdaptedVarName += cfg.PrefixConfig.SpecField
+		} else {
+			f, found = r.StatusFields[renamedName]
+			if !found {
+				continue
+			}
+			sourceAdaptedVarName += cfg.PrefixConfig.StatusField
+		}
+		sourceAdaptedVarName += "." + f.Names.Camel
+		sourceFieldPath := f.Names.Camel
+
+		out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceAdaptedVarName)
+		switch memberShape.Type {
+		case "list", "structure", "map":
+			memberVarName := fmt.Sprintf("f%d", memberIndex)
+			out += varEmptyConstructorSDKV2Type(
+				memberVarName, memberShape, indentLevel+1,
+			)
+			out += setSDKV2ForContainer(
+				cfg, r,
+				memberVarName,
+				memberShapeRef,
+				sourceFieldPath,
+				sourceAdaptedVarName,
+				indentLevel+1,
+			)
+			out += fmt.Sprintf(
+				"%s\t%s = %s\n", indent, targetMemberVarName, memberVarName,
+			)
+		default:
+			if r.IsSecretField(memberName) {
+				out += setSDKV2ForSecret(
+					targetMemberVarName,
+					sourceAdaptedVarName,
+					nullable,
+					indentLevel,
+				)
+			} else {
+				out += fmt.Sprintf(
+					"%s\t%s = %s\n", indent, targetMemberVarName,
+					sdkV2ScalarValue(memberShape, nullable, sourceAdaptedVarName),
+				)
+			}
+		}
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out, nil
+}
+
+// sdkV2Operation returns the resource's operation of the supplied type, or
+// nil if the aws-sdk-go-v2 code generators do not support the operation type
+func sdkV2Operation(
+	r *model.CRD,
+	opType model.OpType,
+) *awssdkmodel.Operation {
+	switch opType {
+	case model.OpTypeCreate:
+		return r.Ops.Create
+	case model.OpTypeGet:
+		return r.Ops.ReadOne
+	case model.OpTypeUpdate:
+		return r.Ops.Update
+	case model.OpTypeDelete:
+		return r.Ops.Delete
+	default:
+		return nil
+	}
+}
+
+// setSDKV2ForContainer returns a string of Go code that sets the members or
+// elements of a target variable from a source variable when the type of the
+// target variable is a map, struct or slice type.
+func setSDKV2ForContainer(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// ShapeRef of the target variable
+	targetShapeRef *awssdkmodel.ShapeRef,
+	// The path to the field that we access our source value from
+	sourceFieldPath string,
+	// The struct or struct field that we access our source value from
+	sourceVarName string,
+	indentLevel int,
+) string {
+	switch targetShapeRef.Shape.Type {
+	case "structure":
+		return setSDKV2ForStruct(
+			cfg, r,
+			targetVarName,
+			targetShapeRef,
+			sourceFieldPath,
+			sourceVarName,
+			indentLevel,
+		)
+	case "list":
+		return setSDKV2ForSlice(
+			cfg, r,
+			targetVarName,
+			targetShapeRef,
+			sourceFieldPath,
+			sourceVarName,
+			indentLevel,
+		)
+	case "map":
+		return setSDKV2ForMap(
+			cfg, r,
+			targetVarName,
+			targetShapeRef,
+			sourceFieldPath,
+			sourceVarName,
+			indentLevel,
+		)
+	default:
+		return ""
+	}
+}
+
+// setSDKV2ForStruct returns a string of Go code that sets the members of a
+// target struct variable from a source variable.
+func setSDKV2ForStruct(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// ShapeRef of the target struct
+	targetShapeRef *awssdkmodel.ShapeRef,
+	// The path to the field that we access our source value from
+	sourceFieldPath string,
+	// The struct or struct field that we access our source value from
+	sourceVarName string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	targetShape := targetShapeRef.Shape
+
+	for memberIndex, memberName := range targetShape.MemberNames() {
+		memberShapeRef := targetShape.MemberRefs[memberName]
+		memberShape := memberShapeRef.Shape
+		cleanMemberName := names.New(memberName).Camel
+		sourceAdaptedVarName := sourceVarName + "." + cleanMemberName
+		memberFieldPath := sourceFieldPath + "." + cleanMemberName
+		targetMemberVarName := targetVarName + "." + memberName
+		nullable := r.IsNullableMember(targetShape, memberName)
+
+		out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceAdaptedVarName)
+		switch memberShape.Type {
+		case "list", "structure", "map":
+			memberVarName := fmt.Sprintf("%sf%d", targetVarName, memberIndex)
+			out += varEmptyConstructorSDKV2Type(
+				memberVarName, memberShape, indentLevel+1,
+			)
+			out += setSDKV2ForContainer(
+				cfg, r,
+				memberVarName,
+				memberShapeRef,
+				memberFieldPath,
+				sourceAdaptedVarName,
+				indentLevel+1,
+			)
+			out += fmt.Sprintf(
+				"%s\t%s = %s\n", indent, targetMemberVarName, memberVarName,
+			)
+		default:
+			if r.IsSecretField(memberFieldPath) {
+				out += setSDKV2ForSecret(
+					targetMemberVarName,
+					sourceAdaptedVarName,
+					nullable,
+					indentLevel,
+				)
+			} else {
+				out += fmt.Sprintf(
+					"%s\t%s = %s\n", indent, targetMemberVarName,
+					sdkV2ScalarValue(memberShape, nullable, sourceAdaptedVarName),
+				)
+			}
+		}
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	return out
+}
+
+// setSDKV2ForSlice returns a string of Go code that appends the elements of a
+// source slice to a target slice variable.
+func setSDKV2ForSlice(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// ShapeRef of the target slice
+	targetShapeRef *awssdkmodel.ShapeRef,
+	// The path to the field that we access our source value from
+	sourceFieldPath string,
+	// The struct or struct field that we access our source value from
+	sourceVarName string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	elemShapeRef := &targetShapeRef.Shape.MemberRef
+	iterVarName := fmt.Sprintf("%siter", targetVarName)
+	elemVarName := fmt.Sprintf("%selem", targetVarName)
+
+	// for _, f0iter := range r.ko.Spec.Tags {
+	out += fmt.Sprintf("%sfor _, %s := range %s {\n", indent, iterVarName, sourceVarName)
+	//     f0 = append(f0, <element value>)
+	elemOut, elem := setSDKV2ForElement(
+		cfg, r,
+		elemVarName,
+		elemShapeRef,
+		sourceFieldPath,
+		iterVarName,
+		indentLevel+1,
+	)
+	out += elemOut
+	out += fmt.Sprintf("%s\t%s = append(%s, %s)\n", indent, targetVarName, targetVarName, elem)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// setSDKV2ForMap returns a string of Go code that sets the values of a source
+// map in a target map variable.
+func setSDKV2ForMap(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// ShapeRef of the target map
+	targetShapeRef *awssdkmodel.ShapeRef,
+	// The path to the field that we access our source value from
+	sourceFieldPath string,
+	// The struct or struct field that we access our source value from
+	sourceVarName string,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	valueShapeRef := &targetShapeRef.Shape.ValueRef
+	valIterVarName := fmt.Sprintf("%svaliter", targetVarName)
+	keyVarName := fmt.Sprintf("%skey", targetVarName)
+	valVarName := fmt.Sprintf("%sval", targetVarName)
+
+	// for f0key, f0valiter := range r.ko.Spec.Tags {
+	out += fmt.Sprintf("%sfor %s, %s := range %s {\n", indent, keyVarName, valIterVarName, sourceVarName)
+	//     f0[f0key] = <value>
+	valOut, val := setSDKV2ForElement(
+		cfg, r,
+		valVarName,
+		valueShapeRef,
+		sourceFieldPath,
+		valIterVarName,
+		indentLevel+1,
+	)
+	out += valOut
+	out += fmt.Sprintf("%s\t%s[%s] = %s\n", indent, targetVarName, keyVarName, val)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// setSDKV2ForElement returns the Go code that builds a slice element or map
+// value from the source iterator variable, along with the Go expression of
+// the element value.
+func setSDKV2ForElement(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// The variable name used for temporary storage of the element
+	elemVarName string,
+	// ShapeRef of the target element
+	elemShapeRef *awssdkmodel.ShapeRef,
+	// The path to the field that we access our source value from
+	sourceFieldPath string,
+	// The iterator variable that we access our source value from
+	iterVarName string,
+	indentLevel int,
+) (string, string) {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	elemShape := elemShapeRef.Shape
+	switch elemShape.Type {
+	case "structure":
+		out += varEmptyConstructorSDKV2Type(elemVarName, elemShape, indentLevel)
+		out += setSDKV2ForContainer(
+			cfg, r,
+			elemVarName,
+			elemShapeRef,
+			sourceFieldPath+".",
+			iterVarName,
+			indentLevel,
+		)
+		return out, "*" + elemVarName
+	case "list", "map":
+		out += varEmptyConstructorSDKV2Type(elemVarName, elemShape, indentLevel)
+		out += setSDKV2ForContainer(
+			cfg, r,
+			elemVarName,
+			elemShapeRef,
+			sourceFieldPath,
+			iterVarName,
+			indentLevel,
+		)
+		return out, elemVarName
+	default:
+		if r.IsSecretField(sourceFieldPath) {
+			out += fmt.Sprintf("%svar %s string\n", indent, elemVarName)
+			out += fmt.Sprintf("%sif %s != nil {\n", indent, iterVarName)
+			out += setSDKV2ForSecret(elemVarName, iterVarName, false, indentLevel)
+			out += fmt.Sprintf("%s}\n", indent)
+			return out, elemVarName
+		}
+		return out, sdkV2ScalarValue(elemShape, false, iterVarName)
+	}
+}
+
+// setSDKV2ForSecret returns a string of Go code that sets a target variable to
+// the value of a Secret referenced by a SecretKeyReference source variable:
+//
+//     tmpSecret, err := rm.rr.SecretValueFromReference(ctx, ko.Spec.MasterUserPassword)
+//     if err != nil {
+//         return nil, err
+//     }
+//     if tmpSecret != "" {
+//         res.MasterUserPassword = aws.String(tmpSecret)
+//     }
+func setSDKV2ForSecret(
+	// The variable name that we want to set a value to
+	targetVarName string,
+	// The CR field that we access our source value from
+	sourceVarName string,
+	// Whether the target variable is a pointer
+	nullable bool,
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	setTo := "tmpSecret"
+	if nullable {
+		setTo = "aws.String(tmpSecret)"
+	}
+	out += fmt.Sprintf(
+		"%s\ttmpSecret, err := rm.rr.SecretValueFromReference(ctx, %s)\n",
+		indent, sourceVarName,
+	)
+	out += fmt.Sprintf("%s\tif err != nil {\n", indent)
+	out += fmt.Sprintf("%s\t\treturn nil, err\n", indent)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s\tif tmpSecret != \"\" {\n", indent)
+	out += fmt.Sprintf("%s\t\t%s = %s\n", indent, targetVarName, setTo)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	return out
+}
+
+// varEmptyConstructorSDKV2Type returns the Go code that declares a variable
+// of the aws-sdk-go-v2 type of the supplied shape
+func varEmptyConstructorSDKV2Type(
+	varName string,
+	// The shape we want to construct a new thing for
+	shape *awssdkmodel.Shape,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	goType := sdkV2GoType(shape)
+	switch shape.Type {
+	case "structure":
+		// f0 := &svcsdktypes.BookData{}
+		return fmt.Sprintf("%s%s := &%s{}\n", indent, varName, goType)
+	case "list", "map":
+		// f0 := []string{}
+		return fmt.Sprintf("%s%s := %s{}\n", indent, varName, goType)
+	default:
+		// var f0 string
+		return fmt.Sprintf("%svar %s %s\n", indent, varName, goType)
+	}
+}
+
+// sdkV2GoType returns the Go type the aws-sdk-go-v2 uses for values of the
+// supplied shape. Structures and enums are types of the service's types
+// package.
+func sdkV2GoType(shape *awssdkmodel.Shape) string {
+	switch shape.Type {
+	case "structure":
+		return "svcsdktypes." + shape.ShapeName
+	case "list":
+		return "[]" + sdkV2GoType(shape.MemberRef.Shape)
+	case "map":
+		return "map[string]" + sdkV2GoType(shape.ValueRef.Shape)
+	}
+	if shape.IsEnum() {
+		return "svcsdktypes." + shape.ShapeName
+	}
+	if goType, found := sdkV2ScalarTypes[shape.Type]; found {
+		return goType
+	}
+	return shape.GoTypeElem()
+}
+
+// sdkV2ScalarValue returns the Go expression converting the value of a CR
+// scalar field, which is always a pointer, to the aws-sdk-go-v2 type of the
+// supplied shape. The expression is a pointer when nullable is true.
+func sdkV2ScalarValue(
+	shape *awssdkmodel.Shape,
+	nullable bool,
+	sourceVarName string,
+) string {
+	if shape.IsEnum() {
+		// svcsdktypes.ImageTagMutability(*r.ko.Spec.ImageTagMutability)
+		return fmt.Sprintf("%s(*%s)", sdkV2GoType(shape), sourceVarName)
+	}
+	switch shape.Type {
+	case "timestamp":
+		if nullable {
+			return "&" + sourceVarName + ".Time"
+		}
+		return sourceVarName + ".Time"
+	case "blob":
+		return sourceVarName
+	}
+	goType := sdkV2GoType(shape)
+	value := "*" + sourceVarName
+	if goType == "int32" || goType == "float32" {
+		// The CR fields use 64 bits types for every number
+		value = fmt.Sprintf("%s(%s)", goType, value)
+	}
+	helper, found := sdkV2PointerHelpers[goType]
+	if !nullable || !found {
+		return value
+	}
+	return fmt.Sprintf("%s(%s)", helper, value)
+}
+
+// sdkV2LiteralValue returns the Go expression of an operation override value
+// for a member of the supplied shape
+func sdkV2LiteralValue(
+	shape *awssdkmodel.Shape,
+	nullable bool,
+	value string,
+) (string, error) {
+	switch shape.Type {
+	case "boolean", "integer", "long":
+	case "string":
+		value = "\"" + value + "\""
+		if shape.IsEnum() {
+			return fmt.Sprintf("%s(%s)", sdkV2GoType(shape), value), nil
+		}
+	default:
+		return "", fmt.Errorf("unsupported shape type %s", shape.Type)
+	}
+	if !nullable {
+		return value, nil
+	}
+	return fmt.Sprintf("%s(%s)", sdkV2PointerHelpers[sdkV2GoType(shape)], value), nil
+}
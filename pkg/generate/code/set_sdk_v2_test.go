@@ -0,0 +1,117 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestSetSDKV2_ECR_Repository_Create(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		SmithyModels: true,
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	expected := `
+	if r.ko.Spec.ImageScanningConfiguration != nil {
+		f0 := &svcsdktypes.ImageScanningConfiguration{}
+		if r.ko.Spec.ImageScanningConfiguration.ScanOnPush != nil {
+			f0.ScanOnPush = *r.ko.Spec.ImageScanningConfiguration.ScanOnPush
+		}
+		res.ImageScanningConfiguration = f0
+	}
+	if r.ko.Spec.ImageTagMutability != nil {
+		res.ImageTagMutability = svcsdktypes.ImageTagMutability(*r.ko.Spec.ImageTagMutability)
+	}
+	if r.ko.Spec.RepositoryName != nil {
+		res.RepositoryName = aws.String(*r.ko.Spec.RepositoryName)
+	}
+	if r.ko.Spec.ScanFrequency != nil {
+		res.ScanFrequency = aws.Int32(int32(*r.ko.Spec.ScanFrequency))
+	}
+	if r.ko.Spec.Tags != nil {
+		f4 := []svcsdktypes.Tag{}
+		for _, f4iter := range r.ko.Spec.Tags {
+			f4elem := &svcsdktypes.Tag{}
+			if f4iter.Key != nil {
+				f4elem.Key = aws.String(*f4iter.Key)
+			}
+			if f4iter.Value != nil {
+				f4elem.Value = aws.String(*f4iter.Value)
+			}
+			f4 = append(f4, *f4elem)
+		}
+		res.Tags = f4
+	}
+`
+	got, err := code.SetSDKV2(crd.Config(), crd, model.OpTypeCreate, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
+
+func TestSetSDKV2_ECR_Repository_Delete(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		SmithyModels: true,
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	expected := `
+	if r.ko.Status.RegistryID != nil {
+		res.RegistryId = aws.String(*r.ko.Status.RegistryID)
+	}
+	if r.ko.Spec.RepositoryName != nil {
+		res.RepositoryName = aws.String(*r.ko.Spec.RepositoryName)
+	}
+`
+	got, err := code.SetSDKV2(crd.Config(), crd, model.OpTypeDelete, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(expected, got)
+}
+
+func TestSetSDKV2_ECR_Repository_ReadMany(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		SmithyModels: true,
+	})
+
+	crd := testutil.GetCRDByName(t, g, "Repository")
+	require.NotNil(crd)
+
+	// ReadMany operations are not supported yet
+	got, err := code.SetSDKV2(crd.Config(), crd, model.OpTypeList, "r.ko", "res", 1)
+	require.Nil(err)
+	assert.Equal(
+		"",
+		got,
+	)
+}
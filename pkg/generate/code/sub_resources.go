@@ -0,0 +1,220 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// SetSDKSubResource returns Go code that sets the member of a sub-resource's
+// put operation input shape containing the sub-resource from the Spec field
+// of a CR.
+//
+// Output code will look something like this:
+//
+// if r.ko.Spec.Versioning != nil {
+//     f0 := &svcsdk.VersioningConfiguration{}
+//     if r.ko.Spec.Versioning.Status != nil {
+//         f0.SetStatus(*r.ko.Spec.Versioning.Status)
+//     }
+//     input.SetVersioningConfiguration(f0)
+// }
+func SetSDKSubResource(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	sub *model.SubResource,
+	// String representing the name of the variable that we will grab the
+	// sub-resource from. This will typically be "r.ko".
+	sourceVarName string,
+	// String representing the name of the variable of the put operation's
+	// input shape. This will typically be "input".
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	field := sub.Field
+	memberShapeRef := sub.MemberShapeRef()
+	sourceFieldPath := field.Names.Camel
+	sourceAdaptedVarName := sourceVarName + cfg.PrefixConfig.SpecField +
+		"." + sourceFieldPath
+	if override := field.TypeOverride(); override != nil {
+		return setSDKForTypeOverride(
+			override, memberShapeRef.Shape.Type,
+			targetVarName, sub.MemberName, sourceAdaptedVarName, indentLevel,
+		)
+	}
+
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf("%sif %s != nil {\n", indent, sourceAdaptedVarName)
+	switch memberShapeRef.Shape.Type {
+	case "list", "structure", "map":
+		memberVarName := "f0"
+		out += varEmptyConstructorSDKType(
+			cfg, r,
+			memberVarName,
+			memberShapeRef.Shape,
+			indentLevel+1,
+		)
+		out += setSDKForContainer(
+			cfg, r,
+			sub.MemberName,
+			memberVarName,
+			sourceFieldPath,
+			sourceAdaptedVarName,
+			memberShapeRef,
+			indentLevel+1,
+		)
+		out += setSDKForScalar(
+			cfg, r,
+			sub.MemberName,
+			targetVarName,
+			"structure",
+			sourceFieldPath,
+			memberVarName,
+			memberShapeRef,
+			indentLevel+1,
+		)
+	default:
+		out += setSDKForScalar(
+			cfg, r,
+			sub.MemberName,
+			targetVarName,
+			"structure",
+			sourceFieldPath,
+			sourceAdaptedVarName,
+			memberShapeRef,
+			indentLevel+1,
+		)
+	}
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// SetResourceSubResource returns Go code that sets the Spec field of a
+// sub-resource from the output shape of the sub-resource's get operation.
+//
+// When the output shape has a member with the name of the put operation's
+// input shape member, the field is set from this member. Otherwise the field
+// is a structure whose members are set from the same-named members of the
+// output shape, and the output code will look something like this:
+//
+// if resp.MFADelete != nil || resp.Status != nil {
+//     f0 := &svcapitypes.VersioningConfiguration{}
+//     if resp.MFADelete != nil {
+//         f0.MFADelete = resp.MFADelete
+//     }
+//     if resp.Status != nil {
+//         f0.Status = resp.Status
+//     }
+//     ko.Spec.Versioning = f0
+// } else {
+//     ko.Spec.Versioning = nil
+// }
+func SetResourceSubResource(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	sub *model.SubResource,
+	// String representing the name of the variable of the get operation's
+	// output shape. This will typically be "resp".
+	sourceVarName string,
+	// String representing the name of the variable that we will set the
+	// Spec field on. This will typically be "ko".
+	targetVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	field := sub.Field
+	targetAdaptedVarName := targetVarName + cfg.PrefixConfig.SpecField +
+		"." + field.Names.Camel
+	outputRef := &sub.GetOp.Operation.OutputRef
+	indent := strings.Repeat("\t", indentLevel)
+	out := ""
+	if sourceMemberRef, found := outputRef.Shape.MemberRefs[sub.MemberName]; found {
+		sourceAdaptedVarName := sourceVarName + "." + sub.MemberName
+		if override := field.TypeOverride(); override != nil {
+			return setResourceForTypeOverride(
+				override, sourceMemberRef.Shape.Type,
+				targetAdaptedVarName, sourceAdaptedVarName, indentLevel,
+			)
+		}
+		out += fmt.Sprintf("%sif %s != nil {\n", indent, sourceAdaptedVarName)
+		switch sourceMemberRef.Shape.Type {
+		case "list", "structure", "map":
+			memberVarName := "f0"
+			out += varEmptyConstructorK8sType(
+				cfg, r,
+				memberVarName,
+				field.ShapeRef.Shape,
+				indentLevel+1,
+			)
+			out += setResourceForContainer(
+				cfg, r,
+				field.Names.Camel,
+				memberVarName,
+				field.ShapeRef,
+				sourceAdaptedVarName,
+				sourceMemberRef,
+				indentLevel+1,
+			)
+			out += setResourceForScalar(
+				targetAdaptedVarName,
+				memberVarName,
+				sourceMemberRef,
+				indentLevel+1,
+			)
+		default:
+			out += setResourceForScalar(
+				targetAdaptedVarName,
+				sourceAdaptedVarName,
+				sourceMemberRef,
+				indentLevel+1,
+			)
+		}
+	} else {
+		conds := []string{}
+		for _, memberName := range outputRef.Shape.MemberNames() {
+			if _, found := field.ShapeRef.Shape.MemberRefs[memberName]; found {
+				conds = append(conds, sourceVarName+"."+memberName+" != nil")
+			}
+		}
+		out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(conds, " || "))
+		memberVarName := "f0"
+		out += varEmptyConstructorK8sType(
+			cfg, r,
+			memberVarName,
+			field.ShapeRef.Shape,
+			indentLevel+1,
+		)
+		out += SetResourceForStruct(
+			cfg, r,
+			field.Names.Camel,
+			memberVarName,
+			field.ShapeRef,
+			sourceVarName,
+			outputRef,
+			indentLevel+1,
+		)
+		out += fmt.Sprintf(
+			"%s\t%s = %s\n", indent, targetAdaptedVarName, memberVarName,
+		)
+	}
+	out += fmt.Sprintf("%s} else {\n", indent)
+	out += fmt.Sprintf("%s\t%s = nil\n", indent, targetAdaptedVarName)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
@@ -0,0 +1,98 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestSetSDKSubResource_S3_Bucket_Versioning(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "s3", &testutil.TestingModelOptions{GeneratorConfigFile: "generator-with-sub-resources.yaml"})
+
+	crd := testutil.GetCRDByName(t, g, "Bucket")
+	require.NotNil(crd)
+
+	subs := crd.SubResources()
+	require.Len(subs, 2)
+
+	expected := `	if desired.ko.Spec.Versioning != nil {
+		f0 := &svcsdk.VersioningConfiguration{}
+		if desired.ko.Spec.Versioning.MFADelete != nil {
+			f0.SetMFADelete(*desired.ko.Spec.Versioning.MFADelete)
+		}
+		if desired.ko.Spec.Versioning.Status != nil {
+			f0.SetStatus(*desired.ko.Spec.Versioning.Status)
+		}
+		input.SetVersioningConfiguration(f0)
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetSDKSubResource(crd.Config(), crd, subs[1], "desired.ko", "input", 1),
+	)
+}
+
+func TestSetResourceSubResource_S3_Bucket(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "s3", &testutil.TestingModelOptions{GeneratorConfigFile: "generator-with-sub-resources.yaml"})
+
+	crd := testutil.GetCRDByName(t, g, "Bucket")
+	require.NotNil(crd)
+
+	subs := crd.SubResources()
+	require.Len(subs, 2)
+
+	expected := `	if resp.Policy != nil {
+		ko.Spec.Policy = resp.Policy
+	} else {
+		ko.Spec.Policy = nil
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetResourceSubResource(crd.Config(), crd, subs[0], "resp", "ko", 1),
+	)
+
+	// The GetBucketVersioning output shape has the members of the
+	// VersioningConfiguration shape instead of a VersioningConfiguration
+	// member
+	expected = `	if resp.MFADelete != nil || resp.Status != nil {
+		f0 := &svcapitypes.VersioningConfiguration{}
+		if resp.MFADelete != nil {
+			f0.MFADelete = resp.MFADelete
+		}
+		if resp.Status != nil {
+			f0.Status = resp.Status
+		}
+		ko.Spec.Versioning = f0
+	} else {
+		ko.Spec.Versioning = nil
+	}
+`
+	assert.Equal(
+		expected,
+		code.SetResourceSubResource(crd.Config(), crd, subs[1], "resp", "ko", 1),
+	)
+}
@@ -0,0 +1,162 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// SyncedStatus returns the Go code that derives the status of the
+// ACK.ResourceSynced condition of a resource from the values of its Status
+// fields, as listed in the resource's `synced` config. The code returns the
+// condition status, whether the resource is in a terminal state and a message
+// explaining why the resource isn't synced.
+//
+// Output code will look something like this:
+//
+// if ko.Status.DBInstanceStatus != nil {
+//     switch *ko.Status.DBInstanceStatus {
+//     case "failed":
+//         return corev1.ConditionFalse, true, "Status.DBInstanceStatus is " + *ko.Status.DBInstanceStatus
+//     }
+// }
+// if ko.Status.DBInstanceStatus == nil {
+//     return corev1.ConditionUnknown, false, "Status.DBInstanceStatus is not set"
+// }
+// switch *ko.Status.DBInstanceStatus {
+// case "available":
+// case "creating", "modifying":
+//     return corev1.ConditionFalse, false, "Status.DBInstanceStatus is " + *ko.Status.DBInstanceStatus
+// default:
+//     return corev1.ConditionUnknown, false, "Status.DBInstanceStatus has unexpected value " + *ko.Status.DBInstanceStatus
+// }
+// return corev1.ConditionTrue, false, ""
+func SyncedStatus(
+	cfg *ackgenconfig.Config,
+	r *model.CRD,
+	// String representing the name of the variable that represents the
+	// resource, e.g. "ko"
+	koVarName string,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	statusPrefix := strings.TrimPrefix(cfg.PrefixConfig.StatusField, ".")
+	rules := r.SyncedRules()
+
+	// The Go selectors of the fields along each rule's path, e.g.
+	// "ko.Status.Endpoint" and "ko.Status.Endpoint.Address"
+	ruleSelectors := make([][]string, len(rules))
+	for i, rule := range rules {
+		selector := koVarName + "." + statusPrefix
+		for _, f := range rule.Fields {
+			selector += "." + f.Names.Camel
+			ruleSelectors[i] = append(ruleSelectors[i], selector)
+		}
+	}
+
+	// Terminal values are checked first so that a terminal state is reported
+	// even when another rule isn't satisfied yet
+	for i, rule := range rules {
+		if len(rule.Terminal) == 0 {
+			continue
+		}
+		selectors := ruleSelectors[i]
+		valueSelector := "*" + selectors[len(selectors)-1]
+		notNil := []string{}
+		for _, s := range selectors {
+			notNil = append(notNil, s+" != nil")
+		}
+		// if ko.Status.DBInstanceStatus != nil {
+		out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(notNil, " && "))
+		//   switch *ko.Status.DBInstanceStatus {
+		out += fmt.Sprintf("%s\tswitch %s {\n", indent, valueSelector)
+		//   case "failed":
+		out += fmt.Sprintf("%s\tcase %s:\n", indent, quoteValues(rule.Terminal))
+		//       return corev1.ConditionFalse, true, "Status.DBInstanceStatus is " + *ko.Status.DBInstanceStatus
+		out += fmt.Sprintf(
+			"%s\t\treturn corev1.ConditionFalse, true, \"%s is \" + %s\n",
+			indent, rule.Path, valueSelector,
+		)
+		//   }
+		// }
+		out += fmt.Sprintf("%s\t}\n%s}\n", indent, indent)
+	}
+
+	for i, rule := range rules {
+		selectors := ruleSelectors[i]
+		valueSelector := "*" + selectors[len(selectors)-1]
+		isNil := []string{}
+		for _, s := range selectors {
+			isNil = append(isNil, s+" == nil")
+		}
+		// if ko.Status.DBInstanceStatus == nil {
+		//     return corev1.ConditionUnknown, false, "Status.DBInstanceStatus is not set"
+		// }
+		out += fmt.Sprintf("%sif %s {\n", indent, strings.Join(isNil, " || "))
+		out += fmt.Sprintf(
+			"%s\treturn corev1.ConditionUnknown, false, \"%s is not set\"\n",
+			indent, rule.Path,
+		)
+		out += fmt.Sprintf("%s}\n", indent)
+		// switch *ko.Status.DBInstanceStatus {
+		// case "available":
+		out += fmt.Sprintf("%sswitch %s {\n", indent, valueSelector)
+		out += fmt.Sprintf("%scase %s:\n", indent, quoteValues(rule.Ready))
+		if len(rule.InProgress) > 0 {
+			// case "creating", "modifying":
+			//     return corev1.ConditionFalse, false, "Status.DBInstanceStatus is " + *ko.Status.DBInstanceStatus
+			// default:
+			//     return corev1.ConditionUnknown, false, "Status.DBInstanceStatus has unexpected value " + *ko.Status.DBInstanceStatus
+			out += fmt.Sprintf("%scase %s:\n", indent, quoteValues(rule.InProgress))
+			out += fmt.Sprintf(
+				"%s\treturn corev1.ConditionFalse, false, \"%s is \" + %s\n",
+				indent, rule.Path, valueSelector,
+			)
+			out += fmt.Sprintf("%sdefault:\n", indent)
+			out += fmt.Sprintf(
+				"%s\treturn corev1.ConditionUnknown, false, \"%s has unexpected value \" + %s\n",
+				indent, rule.Path, valueSelector,
+			)
+		} else {
+			// Without in-progress values, any value that isn't ready means
+			// the resource is still in progress
+			out += fmt.Sprintf("%sdefault:\n", indent)
+			out += fmt.Sprintf(
+				"%s\treturn corev1.ConditionFalse, false, \"%s is \" + %s\n",
+				indent, rule.Path, valueSelector,
+			)
+		}
+		// }
+		out += fmt.Sprintf("%s}\n", indent)
+	}
+	// return corev1.ConditionTrue, false, ""
+	out += fmt.Sprintf("%sreturn corev1.ConditionTrue, false, \"\"\n", indent)
+	return out
+}
+
+// quoteValues returns the supplied values as a comma-separated list of Go
+// string literals, e.g. `"creating", "modifying"`
+func quoteValues(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return strings.Join(quoted, ", ")
+}
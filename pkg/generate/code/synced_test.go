@@ -0,0 +1,69 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestSyncedStatus_RDS_DBInstance(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-synced.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	// The second rule has no in_progress values, so any value that isn't
+	// ready means the resource is still in progress
+	expected := `	if ko.Status.DBInstanceStatus != nil {
+		switch *ko.Status.DBInstanceStatus {
+		case "failed", "incompatible-parameters":
+			return corev1.ConditionFalse, true, "Status.DBInstanceStatus is " + *ko.Status.DBInstanceStatus
+		}
+	}
+	if ko.Status.DBInstanceStatus == nil {
+		return corev1.ConditionUnknown, false, "Status.DBInstanceStatus is not set"
+	}
+	switch *ko.Status.DBInstanceStatus {
+	case "available":
+	case "creating", "modifying", "backing-up":
+		return corev1.ConditionFalse, false, "Status.DBInstanceStatus is " + *ko.Status.DBInstanceStatus
+	default:
+		return corev1.ConditionUnknown, false, "Status.DBInstanceStatus has unexpected value " + *ko.Status.DBInstanceStatus
+	}
+	if ko.Status.DBSubnetGroup == nil || ko.Status.DBSubnetGroup.SubnetGroupStatus == nil {
+		return corev1.ConditionUnknown, false, "Status.DBSubnetGroup.SubnetGroupStatus is not set"
+	}
+	switch *ko.Status.DBSubnetGroup.SubnetGroupStatus {
+	case "Complete":
+	default:
+		return corev1.ConditionFalse, false, "Status.DBSubnetGroup.SubnetGroupStatus is " + *ko.Status.DBSubnetGroup.SubnetGroupStatus
+	}
+	return corev1.ConditionTrue, false, ""
+`
+	assert.Equal(
+		expected,
+		code.SyncedStatus(crd.Config(), crd, "ko", 1),
+	)
+}
@@ -0,0 +1,129 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// normalizedListTags returns true if the supplied field is the tags field of
+// the resource, normalized to a `map[string]*string` while the API model
+// represents the tags as a list of key and value structures. The
+// `toACKTags` and `fromACKTags` helpers of the generated `tags.go` file
+// convert between the two representations.
+func normalizedListTags(
+	r *model.CRD,
+	f *model.Field,
+) bool {
+	tagging := r.Tagging()
+	return tagging != nil && tagging.IsNormalized &&
+		tagging.Field == f && tagging.Format == model.TagsFormatList
+}
+
+// setSDKForNormalizedTags returns Go code that sets the tags member of an
+// input shape from the normalized tags field of the resource:
+//
+// if r.ko.Spec.Tags != nil {
+//     res.SetTags(fromACKTags(r.ko.Spec.Tags))
+// }
+func setSDKForNormalizedTags(
+	targetVarName string,
+	memberName string,
+	sourceVarName string,
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf("%sif %s != nil {\n", indent, sourceVarName)
+	out += fmt.Sprintf(
+		"%s\t%s.Set%s(fromACKTags(%s))\n",
+		indent, targetVarName, memberName, sourceVarName,
+	)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// setResourceForNormalizedTags returns Go code that sets the normalized tags
+// field of the resource from the tags member of an output shape:
+//
+// if resp.Tags != nil {
+//     ko.Spec.Tags = toACKTags(resp.Tags)
+// } else {
+//     ko.Spec.Tags = nil
+// }
+func setResourceForNormalizedTags(
+	targetVarName string,
+	sourceVarName string,
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf("%sif %s != nil {\n", indent, sourceVarName)
+	out += fmt.Sprintf(
+		"%s\t%s = toACKTags(%s)\n", indent, targetVarName, sourceVarName,
+	)
+	out += fmt.Sprintf("%s} else {\n", indent)
+	out += fmt.Sprintf("%s\t%s = nil\n", indent, targetVarName)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// compareTags returns Go code that compares the tags field of two resources
+// regardless of the order of the tags, or an empty string if the supplied
+// field isn't a tags field represented as a list in the API model. Tags
+// represented as a map are already compared by `compareMap`.
+//
+// Normalized tags are compared as maps:
+//
+// if !ackcompare.MapStringStringPEqual(a.ko.Spec.Tags, b.ko.Spec.Tags) {
+//     delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
+// }
+//
+// Tags represented as a list use the `equalTags` helper of the generated
+// `delta.go` file:
+//
+// if !equalTags(a.ko.Spec.Tags, b.ko.Spec.Tags) {
+//     delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
+// }
+func compareTags(
+	r *model.CRD,
+	f *model.Field,
+	deltaVarName string,
+	firstResVarName string,
+	secondResVarName string,
+	fieldPath string,
+	indentLevel int,
+) string {
+	tagging := r.Tagging()
+	if tagging == nil || tagging.Field != f ||
+		tagging.Format != model.TagsFormatList {
+		return ""
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	equalFunc := "equalTags"
+	if tagging.IsNormalized {
+		equalFunc = "ackcompare.MapStringStringPEqual"
+	}
+	out := fmt.Sprintf(
+		"%sif !%s(%s, %s) {\n",
+		indent, equalFunc, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf(
+		"%s\t%s.Add(\"%s\", %s, %s)\n", indent, deltaVarName, fieldPath,
+		firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
@@ -0,0 +1,225 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// setSDKForTypeOverride returns Go code that sets a member of an input shape
+// from a field whose Go type is overridden. The output code depends on the
+// kind of the overriding type, e.g. for an `apiextensionsv1.JSON`:
+//
+// if ko.Spec.PolicyText != nil {
+//     res.SetPolicyText(string(ko.Spec.PolicyText.Raw))
+// }
+//
+// or for a Go type declared in the API package:
+//
+// if ko.Spec.PolicyText != nil {
+//     if tmpJSON, err := json.Marshal(ko.Spec.PolicyText); err == nil {
+//         res.SetPolicyText(string(tmpJSON))
+//     }
+// }
+func setSDKForTypeOverride(
+	override *model.TypeOverride,
+	// The type of the member's shape
+	shapeType string,
+	targetVarName string,
+	memberName string,
+	sourceVarName string,
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf("%sif %s != nil {\n", indent, sourceVarName)
+	setTo := ""
+	switch override.Kind {
+	case model.TypeOverrideJSON:
+		setTo = fmt.Sprintf("string(%s.Raw)", sourceVarName)
+	case model.TypeOverrideDuration:
+		setTo = fmt.Sprintf("int64(%s.Duration.Seconds())", sourceVarName)
+	case model.TypeOverrideQuantity:
+		setTo = fmt.Sprintf("%s.Value()", sourceVarName)
+	case model.TypeOverrideIntOrString:
+		if shapeType == "string" {
+			setTo = fmt.Sprintf("%s.String()", sourceVarName)
+		} else {
+			setTo = fmt.Sprintf("int64(%s.IntValue())", sourceVarName)
+		}
+	case model.TypeOverrideCustom:
+		out += fmt.Sprintf(
+			"%s\tif tmpJSON, err := json.Marshal(%s); err == nil {\n",
+			indent, sourceVarName,
+		)
+		out += fmt.Sprintf(
+			"%s\t\t%s.Set%s(string(tmpJSON))\n", indent, targetVarName, memberName,
+		)
+		out += fmt.Sprintf("%s\t}\n", indent)
+		out += fmt.Sprintf("%s}\n", indent)
+		return out
+	}
+	out += fmt.Sprintf(
+		"%s\t%s.Set%s(%s)\n", indent, targetVarName, memberName, setTo,
+	)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// setResourceForTypeOverride returns Go code that sets a field whose Go type
+// is overridden from a member of an output shape. The output code depends on
+// the kind of the overriding type, e.g. for an `apiextensionsv1.JSON`:
+//
+// if resp.PolicyText != nil {
+//     ko.Spec.PolicyText = &apiextensionsv1.JSON{Raw: []byte(*resp.PolicyText)}
+// } else {
+//     ko.Spec.PolicyText = nil
+// }
+//
+// or for a Go type declared in the API package, whose value is left unchanged
+// when the member doesn't contain a valid JSON document:
+//
+// if resp.PolicyText != nil {
+//     tmpJSON := &svcapitypes.PolicyDocument{}
+//     if err := json.Unmarshal([]byte(*resp.PolicyText), tmpJSON); err == nil {
+//         ko.Spec.PolicyText = tmpJSON
+//     }
+// } else {
+//     ko.Spec.PolicyText = nil
+// }
+func setResourceForTypeOverride(
+	override *model.TypeOverride,
+	// The type of the member's shape
+	shapeType string,
+	targetVarName string,
+	sourceVarName string,
+	indentLevel int,
+) string {
+	indent := strings.Repeat("\t", indentLevel)
+	out := fmt.Sprintf("%sif %s != nil {\n", indent, sourceVarName)
+	switch override.Kind {
+	case model.TypeOverrideJSON:
+		out += fmt.Sprintf(
+			"%s\t%s = &apiextensionsv1.JSON{Raw: []byte(*%s)}\n",
+			indent, targetVarName, sourceVarName,
+		)
+	case model.TypeOverrideDuration:
+		out += fmt.Sprintf(
+			"%s\t%s = &metav1.Duration{Duration: time.Duration(*%s) * time.Second}\n",
+			indent, targetVarName, sourceVarName,
+		)
+	case model.TypeOverrideQuantity:
+		out += fmt.Sprintf(
+			"%s\t%s = k8sresource.NewQuantity(*%s, k8sresource.DecimalSI)\n",
+			indent, targetVarName, sourceVarName,
+		)
+	case model.TypeOverrideIntOrString:
+		if shapeType == "string" {
+			out += fmt.Sprintf(
+				"%s\ttmpIntOrString := intstr.Parse(*%s)\n", indent, sourceVarName,
+			)
+		} else {
+			out += fmt.Sprintf(
+				"%s\ttmpIntOrString := intstr.FromInt(int(*%s))\n",
+				indent, sourceVarName,
+			)
+		}
+		out += fmt.Sprintf("%s\t%s = &tmpIntOrString\n", indent, targetVarName)
+	case model.TypeOverrideCustom:
+		out += fmt.Sprintf(
+			"%s\ttmpJSON := &svcapitypes.%s{}\n", indent, override.GoType,
+		)
+		out += fmt.Sprintf(
+			"%s\tif err := json.Unmarshal([]byte(*%s), tmpJSON); err == nil {\n",
+			indent, sourceVarName,
+		)
+		out += fmt.Sprintf("%s\t\t%s = tmpJSON\n", indent, targetVarName)
+		out += fmt.Sprintf("%s\t}\n", indent)
+	}
+	out += fmt.Sprintf("%s} else {\n", indent)
+	out += fmt.Sprintf("%s\t%s = nil\n", indent, targetVarName)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
+
+// compareTypeOverride returns Go code that compares two fields whose Go type
+// is overridden, or an empty string if the supplied field's Go type isn't
+// overridden. The values are compared semantically, e.g. JSON documents are
+// compared as JSON values by the `equalJSON` helper of the generated
+// `delta.go` file:
+//
+// if ackcompare.HasNilDifference(a.ko.Spec.PolicyText, b.ko.Spec.PolicyText) {
+//     delta.Add("Spec.PolicyText", a.ko.Spec.PolicyText, b.ko.Spec.PolicyText)
+// } else if a.ko.Spec.PolicyText != nil && b.ko.Spec.PolicyText != nil {
+//     if !equalJSON(a.ko.Spec.PolicyText.Raw, b.ko.Spec.PolicyText.Raw) {
+//         delta.Add("Spec.PolicyText", a.ko.Spec.PolicyText, b.ko.Spec.PolicyText)
+//     }
+// }
+func compareTypeOverride(
+	f *model.Field,
+	deltaVarName string,
+	firstResVarName string,
+	secondResVarName string,
+	fieldPath string,
+	indentLevel int,
+) string {
+	override := f.TypeOverride()
+	if override == nil {
+		return ""
+	}
+	differ := ""
+	switch override.Kind {
+	case model.TypeOverrideJSON:
+		differ = fmt.Sprintf(
+			"!equalJSON(%s.Raw, %s.Raw)", firstResVarName, secondResVarName,
+		)
+	case model.TypeOverrideDuration:
+		differ = fmt.Sprintf(
+			"%s.Duration != %s.Duration", firstResVarName, secondResVarName,
+		)
+	case model.TypeOverrideQuantity:
+		differ = fmt.Sprintf(
+			"%s.Cmp(*%s) != 0", firstResVarName, secondResVarName,
+		)
+	case model.TypeOverrideIntOrString:
+		differ = fmt.Sprintf(
+			"%s.String() != %s.String()", firstResVarName, secondResVarName,
+		)
+	case model.TypeOverrideCustom:
+		differ = fmt.Sprintf(
+			"!reflect.DeepEqual(%s, %s)", firstResVarName, secondResVarName,
+		)
+	}
+	indent := strings.Repeat("\t", indentLevel)
+	add := fmt.Sprintf(
+		"%s.Add(\"%s\", %s, %s)\n", deltaVarName, fieldPath,
+		firstResVarName, secondResVarName,
+	)
+	out := fmt.Sprintf(
+		"%sif ackcompare.HasNilDifference(%s, %s) {\n",
+		indent, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s\t%s", indent, add)
+	out += fmt.Sprintf(
+		"%s} else if %s != nil && %s != nil {\n",
+		indent, firstResVarName, secondResVarName,
+	)
+	out += fmt.Sprintf("%s\tif %s {\n", indent, differ)
+	out += fmt.Sprintf("%s\t\t%s", indent, add)
+	out += fmt.Sprintf("%s\t}\n", indent)
+	out += fmt.Sprintf("%s}\n", indent)
+	return out
+}
@@ -0,0 +1,79 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+)
+
+// WaiterAcceptors returns the elements of a `[]request.WaiterAcceptor` slice
+// literal holding the path acceptors of the resource's readiness waiter, in
+// the format the aws-sdk-go uses for its own waiters.
+//
+// Output code will look something like this:
+//
+// {
+//     State:    request.SuccessWaiterState,
+//     Matcher:  request.PathAllWaiterMatch,
+//     Argument: "DBInstances[].DBInstanceStatus",
+//     Expected: "available",
+// },
+// {
+//     State:    request.FailureWaiterState,
+//     Matcher:  request.PathAnyWaiterMatch,
+//     Argument: "DBInstances[].DBInstanceStatus",
+//     Expected: "deleted",
+// },
+func WaiterAcceptors(
+	r *model.CRD,
+	// Number of levels of indentation to use
+	indentLevel int,
+) string {
+	out := ""
+	indent := strings.Repeat("\t", indentLevel)
+	w := r.ReadinessWaiter()
+	if w == nil {
+		return out
+	}
+	for _, a := range w.PathAcceptors {
+		out += fmt.Sprintf("%s{\n", indent)
+		out += fmt.Sprintf("%s\tState:    request.%sWaiterState,\n", indent, strings.Title(a.State))
+		out += fmt.Sprintf("%s\tMatcher:  request.%sWaiterMatch,\n", indent, strings.Title(a.Matcher))
+		out += fmt.Sprintf("%s\tArgument: %q,\n", indent, a.Argument)
+		out += fmt.Sprintf("%s\tExpected: %s,\n", indent, waiterExpected(a.Expected))
+		out += fmt.Sprintf("%s},\n", indent)
+	}
+	return out
+}
+
+// waiterExpected returns the Go literal of the value expected by a waiter
+// acceptor. Integers are typed as int64, like the numbers of the aws-sdk-go
+// shapes, so that they compare equal to the values found at the acceptor's
+// path.
+func waiterExpected(expected interface{}) string {
+	switch v := expected.(type) {
+	case string:
+		return fmt.Sprintf("%q", v)
+	case float64:
+		if v == float64(int64(v)) {
+			return fmt.Sprintf("int64(%d)", int64(v))
+		}
+		return fmt.Sprintf("%v", v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package code_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/code"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestWaiterAcceptors_DynamoDB_Table(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "dynamodb")
+
+	crd := testutil.GetCRDByName(t, g, "Table")
+	require.NotNil(crd)
+
+	expected := `	{
+		State:    request.SuccessWaiterState,
+		Matcher:  request.PathWaiterMatch,
+		Argument: "Table.TableStatus",
+		Expected: "ACTIVE",
+	},
+`
+	assert.Equal(expected, code.WaiterAcceptors(crd, 1))
+}
+
+func TestWaiterAcceptors_RDS_DBInstance(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-waiters.yaml",
+	})
+
+	crd := testutil.GetCRDByName(t, g, "DBInstance")
+	require.NotNil(crd)
+
+	expected := `	{
+		State:    request.SuccessWaiterState,
+		Matcher:  request.PathAllWaiterMatch,
+		Argument: "DBInstances[].DBInstanceStatus",
+		Expected: "available",
+	},
+	{
+		State:    request.FailureWaiterState,
+		Matcher:  request.PathAnyWaiterMatch,
+		Argument: "DBInstances[].DBInstanceStatus",
+		Expected: "deleted",
+	},
+	{
+		State:    request.FailureWaiterState,
+		Matcher:  request.PathAnyWaiterMatch,
+		Argument: "DBInstances[].DBInstanceStatus",
+		Expected: "deleting",
+	},
+	{
+		State:    request.FailureWaiterState,
+		Matcher:  request.PathAnyWaiterMatch,
+		Argument: "DBInstances[].DBInstanceStatus",
+		Expected: "failed",
+	},
+	{
+		State:    request.FailureWaiterState,
+		Matcher:  request.PathAnyWaiterMatch,
+		Argument: "DBInstances[].DBInstanceStatus",
+		Expected: "incompatible-restore",
+	},
+	{
+		State:    request.FailureWaiterState,
+		Matcher:  request.PathAnyWaiterMatch,
+		Argument: "DBInstances[].DBInstanceStatus",
+		Expected: "incompatible-parameters",
+	},
+`
+	assert.Equal(expected, code.WaiterAcceptors(crd, 1))
+
+	// Resources without a readiness waiter have no acceptors
+	crd = testutil.GetCRDByName(t, g, "DBSnapshot")
+	require.NotNil(crd)
+	assert.Equal("", code.WaiterAcceptors(crd, 1))
+}
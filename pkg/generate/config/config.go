@@ -39,6 +39,9 @@ type Config struct {
 	// SetManyOutput function fails with NotFound error.
 	// Default is "return nil, ackerr.NotFound"
 	SetManyOutputNotFoundErrReturn string `json:"set_many_output_notfound_err_return,omitempty"`
+	// source records the position of the keys found in the generator config
+	// file. It is nil when no generator config file was loaded.
+	source *configSource
 }
 
 // IgnoreSpec represents instructions to the ACK code generator to
@@ -98,5 +101,8 @@ func New(
 	if err = yaml.Unmarshal(content, &gc); err != nil {
 		return Config{}, err
 	}
+	if gc.source, err = loadSource(configPath, content); err != nil {
+		return Config{}, err
+	}
 	return gc, nil
 }
@@ -114,6 +114,53 @@ type CompareFieldConfig struct {
 	// NilEqualsZeroValue indicates a nil pointer and zero-value pointed-to
 	// value should be considered equal for the purposes of comparison
 	NilEqualsZeroValue bool `json:"nil_equals_zero_value"`
+	// IsSet indicates the elements of a list field can be in any order. Lists
+	// of strings are always compared regardless of the order of their
+	// elements.
+	IsSet bool `json:"is_set"`
+	// Key is the name of the member of the struct elements of a list field
+	// that identifies an element. The elements of the lists being compared
+	// are matched by key, regardless of their order, and compared member by
+	// member. For example, the following generator config produces
+	// differences like `Spec.FileSystemConfigs[arn=...].LocalMountPath`:
+	//
+	// resources:
+	//   Function:
+	//     fields:
+	//       FileSystemConfigs:
+	//         compare:
+	//           key: Arn
+	Key string `json:"key,omitempty"`
+}
+
+// DefaultFieldConfig informs the code generator about the value the AWS
+// service gives a field that is left unset. When a field with a default
+// isn't set in the desired state of a resource, it isn't compared to the
+// observed value, unless the default is a literal different from the observed
+// value.
+//
+// For example, the following generator config lets the Kubernetes API server
+// set the `ImageTagMutability` field of ECR Repositories to "MUTABLE" and
+// doesn't compare the `ScanOnPush` field of the repositories leaving it
+// unset:
+//
+// resources:
+//   Repository:
+//     fields:
+//       ImageTagMutability:
+//         default:
+//           value: MUTABLE
+//       ImageScanningConfiguration.ScanOnPush:
+//         default:
+//           is_computed: true
+type DefaultFieldConfig struct {
+	// Value is the literal default value of a string, number or boolean
+	// field, e.g. "MUTABLE", "7" or "true". It is rendered as a
+	// `+kubebuilder:default` marker of the field.
+	Value *string `json:"value,omitempty"`
+	// IsComputed indicates the default value is computed by the AWS service,
+	// e.g. from other fields, and can't be known by the code generator
+	IsComputed bool `json:"is_computed,omitempty"`
 }
 
 // PrintFieldConfig instructs the code generator how to handle kubebuilder:printcolumn
@@ -152,6 +199,137 @@ type LateInitializeConfig struct {
 	MaxBackoffSeconds int `json:"max_backoff_seconds"`
 }
 
+// SupportedValidationConstraints contains the names of the constraints that
+// ValidationFieldConfig.Ignore accepts
+var SupportedValidationConstraints = []string{
+	"enum",
+	"max_items",
+	"max_length",
+	"maximum",
+	"min_items",
+	"min_length",
+	"minimum",
+	"pattern",
+}
+
+// ValidationFieldConfig instructs the code generator how to produce the
+// `+kubebuilder:validation` markers of a field. By default, the markers are
+// derived from the constraints of the field's shape in the API model: length
+// of strings, number of list items, numeric ranges, regular expressions and
+// enum values.
+//
+// For example, the following generator config drops the pattern of the ECR
+// Repository's `RepositoryName` field and restricts its length:
+//
+// resources:
+//   Repository:
+//     fields:
+//       RepositoryName:
+//         validation:
+//           ignore:
+//             - pattern
+//           max_length: 64
+type ValidationFieldConfig struct {
+	// IsIgnored suppresses all the constraints derived from the API model.
+	// The constraints set in this config are still used.
+	IsIgnored bool `json:"is_ignored,omitempty"`
+	// Ignore contains the names of the constraints derived from the API model
+	// to suppress. See SupportedValidationConstraints.
+	Ignore []string `json:"ignore,omitempty"`
+	// MinLength overrides the minimum length of a string field
+	MinLength *int64 `json:"min_length,omitempty"`
+	// MaxLength overrides the maximum length of a string field
+	MaxLength *int64 `json:"max_length,omitempty"`
+	// Minimum overrides the minimum value of a number field
+	Minimum *float64 `json:"minimum,omitempty"`
+	// Maximum overrides the maximum value of a number field
+	Maximum *float64 `json:"maximum,omitempty"`
+	// MinItems overrides the minimum number of items of a list field
+	MinItems *int64 `json:"min_items,omitempty"`
+	// MaxItems overrides the maximum number of items of a list field
+	MaxItems *int64 `json:"max_items,omitempty"`
+	// Pattern overrides the regular expression a string field must match
+	Pattern *string `json:"pattern,omitempty"`
+	// Enum overrides the values a string field accepts
+	Enum []string `json:"enum,omitempty"`
+}
+
+// ReferencesFieldConfig instructs the code generator to produce, next to a
+// field containing the identifier of another AWS resource, the Spec fields that
+// refer to the custom resource managing it. The reference is resolved and the
+// identifier copied into the field before the resource is created or updated.
+//
+// For example, the following generator config lets users refer to the KMS Key
+// encrypting an ECR Repository with a `kmsKeyRef` or `kmsKeySelector` field:
+//
+// resources:
+//   Repository:
+//     fields:
+//       KmsKey:
+//         references:
+//           resource: Key
+//           service_name: kms
+//           api_version: v1alpha1
+//           path: Status.ACKResourceMetadata.ARN
+type ReferencesFieldConfig struct {
+	// Resource is the Kind of the referenced custom resource, e.g. "Subnet"
+	Resource string `json:"resource"`
+	// ServiceName is the service alias of the controller managing the
+	// referenced custom resource, e.g. "ec2". Defaults to the service of the
+	// referencing resource.
+	ServiceName string `json:"service_name,omitempty"`
+	// APIVersion is the API version of the referenced custom resource, e.g.
+	// "v1alpha1". Required when the referenced resource belongs to another
+	// service. Defaults to the API version being generated otherwise.
+	APIVersion string `json:"api_version,omitempty"`
+	// Path is the field path of the identifier in the referenced custom
+	// resource, e.g. "Status.SubnetID"
+	Path string `json:"path"`
+}
+
+// ChildFieldConfig instructs the code generator that a list field of the
+// resource contains the identifiers of children (e.g. the IAM Roles associated
+// with an RDS DBCluster) that are added and removed with dedicated API
+// operations. The desired children are diffed against the observed ones and
+// the add and remove operations called for the difference.
+//
+// The add and remove operations default to the resource's operations of type
+// `AddChild`/`AddChildren` and `RemoveChild`/`RemoveChildren`. The children
+// are observed either with a list operation or in a Status field of the
+// resource.
+//
+// For example, the following generator config reconciles the IAM Roles of an
+// RDS DBCluster with AddRoleToDBCluster and RemoveRoleFromDBCluster, reading
+// the associated roles from the DBCluster's `Status.AssociatedRoles` field:
+//
+// resources:
+//   DBCluster:
+//     fields:
+//       Roles:
+//         child:
+//           path: AssociatedRoles.RoleArn
+type ChildFieldConfig struct {
+	// AddOperation is the name of the operation adding a child, or a list of
+	// children, to the resource
+	AddOperation string `json:"add_operation,omitempty"`
+	// RemoveOperation is the name of the operation removing a child, or a
+	// list of children, from the resource
+	RemoveOperation string `json:"remove_operation,omitempty"`
+	// MemberName is the name of the member of the add and remove operations'
+	// input shapes containing the child identifier(s), e.g. "RoleArn".
+	// Defaults to the only required member that isn't a field of the
+	// resource.
+	MemberName string `json:"member_name,omitempty"`
+	// ListOperation is the name of the operation returning the children of
+	// the resource, e.g. "ListAttachedRolePolicies"
+	ListOperation string `json:"list_operation,omitempty"`
+	// Path is the dotted path of the child identifiers in the output shape of
+	// ListOperation (e.g. "AttachedPolicies.PolicyArn") or, when
+	// ListOperation is empty, in the Status of the resource (e.g.
+	// "AssociatedRoles.RoleArn")
+	Path string `json:"path"`
+}
+
 // FieldConfig contains instructions to the code generator about how
 // to interpret the value of an Attribute and how to map it to a CRD's Spec or
 // Status field
@@ -187,8 +365,30 @@ type FieldConfig struct {
 	// IsSecret instructs the code generator that this field should be a
 	// SecretKeyReference.
 	IsSecret bool `json:"is_secret"`
+	// Type overrides the Go type the code generator derives from the shape
+	// of the field. The supported types are:
+	//
+	// * `apiextensionsv1.JSON` for string fields containing JSON documents,
+	//   e.g. policies, which are compared as JSON values
+	// * `metav1.Duration` for integer fields containing a number of seconds
+	// * `resource.Quantity` for integer fields
+	// * `intstr.IntOrString` for string or integer fields
+	//
+	// Any other type is the name of a Go type declared in the API package of
+	// the service controller, e.g. `PolicyDocument`, and a string field is
+	// (un)marshalled to and from the type as a JSON document:
+	//
+	// resources:
+	//   Repository:
+	//     fields:
+	//       PolicyText:
+	//         type: apiextensionsv1.JSON
+	Type string `json:"type,omitempty"`
 	// IsImmutable instructs the code generator to add advisory conditions
-	// if user modifies the spec field after resource was created.
+	// if user modifies the spec field after resource was created. Changes to
+	// the field are also rejected by the Kubernetes API server, with a CEL
+	// validation rule and a validating webhook, unless the field is nested in
+	// the elements of a list or map.
 	IsImmutable bool `json:"is_immutable"`
 	// From instructs the code generator that the value of the field should
 	// be retrieved from the specified operation and member path
@@ -196,6 +396,9 @@ type FieldConfig struct {
 	// Compare instructs the code generator how to produce code that compares
 	// the value of the field in two resources
 	Compare *CompareFieldConfig `json:"compare,omitempty"`
+	// Default informs the code generator about the value the AWS service
+	// gives the field when it is left unset
+	Default *DefaultFieldConfig `json:"default,omitempty"`
 	// Print instructs the code generator how to generate comment markers that
 	// influence hows field are printed in `kubectl get` response. If this field
 	// is not nil, it will be added to the columns of `kubectl get`.
@@ -203,4 +406,13 @@ type FieldConfig struct {
 	// Late Initialize instructs the code generator how to handle the late initialization
 	// of the field.
 	LateInitialize *LateInitializeConfig `json:"late_initialize,omitempty"`
+	// Validation instructs the code generator how to produce the OpenAPI
+	// validation markers of the field
+	Validation *ValidationFieldConfig `json:"validation,omitempty"`
+	// References instructs the code generator to produce fields referring to
+	// the custom resource whose identifier the field contains
+	References *ReferencesFieldConfig `json:"references,omitempty"`
+	// Child instructs the code generator to reconcile the list of children
+	// contained in the field with the operations adding and removing them
+	Child *ChildFieldConfig `json:"child,omitempty"`
 }
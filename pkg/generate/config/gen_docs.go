@@ -0,0 +1,139 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// +build ignore
+
+// gen_docs generates zz_generated.docs.go, which holds the doc comments of the
+// generator config structs and their fields so that the generator config
+// schema can describe them. Run it with `go generate` whenever the structs
+// change.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const outputFile = "zz_generated.docs.go"
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") &&
+			!strings.HasPrefix(name, "zz_generated") &&
+			!strings.HasPrefix(name, "gen_")
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg, found := pkgs["config"]
+	if !found {
+		log.Fatal("cannot find package config")
+	}
+
+	typeDocs := map[string]string{}
+	fieldDocs := map[string]string{}
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok || !typeSpec.Name.IsExported() {
+					continue
+				}
+				doc := typeSpec.Doc
+				if doc == nil && len(genDecl.Specs) == 1 {
+					doc = genDecl.Doc
+				}
+				if text := docText(doc); text != "" {
+					typeDocs[typeSpec.Name.Name] = text
+				}
+				for _, field := range structType.Fields.List {
+					text := docText(field.Doc)
+					if text == "" {
+						continue
+					}
+					for _, name := range field.Names {
+						if name.IsExported() {
+							fieldDocs[typeSpec.Name.Name+"."+name.Name] = text
+						}
+					}
+				}
+			}
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	header, err := ioutil.ReadFile("schema.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Reuse the license header of schema.go
+	buf.Write(header[:bytes.Index(header, []byte("package config"))])
+	fmt.Fprintln(buf, "// Code generated by gen_docs.go. DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package config")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// typeDocs contains the doc comments of the generator config structs,")
+	fmt.Fprintln(buf, "// keyed by struct name")
+	writeMap(buf, "typeDocs", typeDocs)
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// fieldDocs contains the doc comments of the generator config struct")
+	fmt.Fprintln(buf, "// fields, keyed by \"<struct name>.<field name>\"")
+	writeMap(buf, "fieldDocs", fieldDocs)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile(outputFile, src, 0666); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// docText returns the text of a doc comment, without its comment markers
+func docText(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	return strings.TrimSpace(doc.Text())
+}
+
+// writeMap writes the declaration of a map[string]string variable with its
+// keys sorted
+func writeMap(buf *bytes.Buffer, name string, m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	fmt.Fprintf(buf, "var %s = map[string]string{\n", name)
+	for _, key := range keys {
+		fmt.Fprintf(buf, "\t%q: %q,\n", key, m[key])
+	}
+	fmt.Fprintln(buf, "}")
+}
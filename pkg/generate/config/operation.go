@@ -79,6 +79,21 @@ func (c *Config) ListOpMatchFieldNames(
 	return rConfig.ListOperation.MatchFields
 }
 
+// ListOpMaxPages returns the maximum number of pages of results the List
+// operation of the supplied resource reads when looking for the resource
+func (c *Config) ListOpMaxPages(
+	resName string,
+) int {
+	if c == nil {
+		return DefaultListOpMaxPages
+	}
+	rConfig, found := c.Resources[resName]
+	if !found || rConfig.ListOperation == nil || rConfig.ListOperation.MaxPages == nil {
+		return DefaultListOpMaxPages
+	}
+	return *rConfig.ListOperation.MaxPages
+}
+
 // UnmarshalJSON parses input for a either a string or
 // or a list and returns a StringArray.
 func (a *StringArray) UnmarshalJSON(b []byte) error {
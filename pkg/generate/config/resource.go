@@ -63,6 +63,15 @@ type ResourceConfig struct {
 	// `resourceManager` struct that will set Conditions on a `resource` struct
 	// depending on the status of the resource.
 	UpdateConditionsCustomMethodName string `json:"update_conditions_custom_method_name,omitempty"`
+	// Synced instructs the code generator to set the ACK.ResourceSynced
+	// condition of the resource from the values of its Status fields
+	Synced *SyncedConfig `json:"synced,omitempty"`
+	// Waiter instructs the code generator on which aws-sdk-go waiter tells
+	// whether the resource is ready
+	Waiter *WaiterConfig `json:"waiter,omitempty"`
+	// Delete instructs the code generator on how to delete a resource whose
+	// deletion completes asynchronously
+	Delete *DeleteConfig `json:"delete,omitempty"`
 	// Fields is a map, keyed by the field name, of instructions for how the
 	// code generator should interpret and handle a particular field in the
 	// resource.
@@ -85,6 +94,213 @@ type ResourceConfig struct {
 	// IsARNPrimaryKey determines whether the CRD uses the ARN as the primary
 	// identifier in the ReadOne operations.
 	IsARNPrimaryKey bool `json:"is_arn_primary_key"`
+	// Tags instructs the code generator to normalize the resource's tags and
+	// to synchronize them with the tagging operations of the API
+	Tags *TagsConfig `json:"tags,omitempty"`
+	// SubResources is a map, keyed by the name of a Spec field, of
+	// instructions for the code generator about groups of operations managing
+	// a part of the resource's configuration
+	SubResources map[string]*SubResourceConfig `json:"sub_resources,omitempty"`
+}
+
+// SyncedConfig instructs the code generator to set the ACK.ResourceSynced
+// condition of a resource from the values of its Status fields, instead of
+// hand-writing an update_conditions_custom_method_name method:
+//
+// resources:
+//   DBInstance:
+//     synced:
+//       when:
+//         - path: Status.DBInstanceStatus
+//           ready:
+//             - available
+//           in_progress:
+//             - creating
+//             - modifying
+//           terminal:
+//             - failed
+//             - incompatible-parameters
+//
+// The resource is synced when the fields of all the rules have a ready
+// value. When any field has a terminal value, the ACK.Terminal condition is
+// set as well. Otherwise the resource isn't synced and, like any resource
+// that isn't synced, is requeued until it is.
+type SyncedConfig struct {
+	// When lists the rules that must all be met for the resource to be synced
+	When []SyncedRule `json:"when"`
+}
+
+// SyncedRule lists the values of a Status field for which a resource is
+// synced, still being changed, or failed
+type SyncedRule struct {
+	// Path is the path of the field, e.g. "Status.DBInstanceStatus"
+	Path string `json:"path"`
+	// Ready lists the values for which the resource is synced
+	Ready []string `json:"ready"`
+	// InProgress lists the values for which the resource is still being
+	// changed. When empty, all the values that are neither ready nor terminal
+	// are considered in progress. Otherwise other values set the
+	// ACK.ResourceSynced condition to Unknown.
+	InProgress []string `json:"in_progress,omitempty"`
+	// Terminal lists the values for which the resource failed and won't
+	// recover without changes
+	Terminal []string `json:"terminal,omitempty"`
+}
+
+// WaiterConfig instructs the code generator on which waiter of the service's
+// aws-sdk-go `waiters-2.json` file tells whether a resource is ready. The
+// waiter's acceptors are matched against the output of the operation the
+// resource is read with, and set the ACK.ResourceSynced condition of the
+// resource. By default, the waiter named after the resource and ending with
+// "Available", "InService", "Active", "Running", "Ready" or "Exists" (in that
+// order of preference) is used, if the resource is read with its operation.
+//
+// resources:
+//   DBInstance:
+//     waiter:
+//       name: DBInstanceAvailable
+//
+// The synced config takes precedence over waiters.
+type WaiterConfig struct {
+	// Name is the name of the waiter, e.g. "DBInstanceAvailable"
+	Name string `json:"name,omitempty"`
+	// IsIgnored instructs the code generator not to use any waiter for the
+	// resource
+	IsIgnored bool `json:"is_ignored,omitempty"`
+}
+
+// DeleteConfig instructs the code generator on how to delete a resource whose
+// deletion completes asynchronously, for example an RDS DBInstance that stays
+// in the "deleting" state for minutes after the DeleteDBInstance call:
+//
+// resources:
+//   DBInstance:
+//     delete:
+//       wait_until_not_found: true
+//       deleting:
+//         path: Status.DBInstanceStatus
+//         values:
+//           - deleting
+//       requeue_after_seconds: 60
+//       pre_delete_updates:
+//         - path: Spec.DeletionProtection
+//           value: false
+type DeleteConfig struct {
+	// WaitUntilNotFound instructs the code generator to keep the resource,
+	// and the finalizer of its custom resource, until the resource is no
+	// longer found after its Delete operation is called. The deletion is
+	// checked again after RequeueAfterSeconds. Requires Deleting, so that the
+	// Delete operation isn't called again while the resource is being
+	// deleted.
+	WaitUntilNotFound bool `json:"wait_until_not_found,omitempty"`
+	// Deleting tells from the value of a Status field whether the resource is
+	// already being deleted, in which case the Delete operation isn't called
+	// again. Requires WaitUntilNotFound.
+	Deleting *DeletingConfig `json:"deleting,omitempty"`
+	// RequeueAfterSeconds is the number of seconds after which to check
+	// again whether a resource being deleted is gone. Defaults to the
+	// runtime's default requeue duration. Requires WaitUntilNotFound.
+	RequeueAfterSeconds *int `json:"requeue_after_seconds,omitempty"`
+	// PreDeleteUpdates lists the values that Spec fields of the resource must
+	// have for the resource to be deleted, e.g. to disable its deletion
+	// protection. The resource is updated before its Delete operation is
+	// called if any of these fields has a different value.
+	PreDeleteUpdates []PreDeleteUpdateConfig `json:"pre_delete_updates,omitempty"`
+}
+
+// DeletingConfig lists the values of a Status field for which a resource is
+// being deleted
+type DeletingConfig struct {
+	// Path is the path of the field, e.g. "Status.DBInstanceStatus"
+	Path string `json:"path"`
+	// Values lists the values for which the resource is being deleted
+	Values []string `json:"values"`
+}
+
+// PreDeleteUpdateConfig is the value a Spec field of a resource must have
+// for the resource to be deleted
+type PreDeleteUpdateConfig struct {
+	// Path is the path of the field, e.g. "Spec.DeletionProtection"
+	Path string `json:"path"`
+	// Value is the literal value of the string, number or boolean field,
+	// e.g. "false"
+	Value *string `json:"value"`
+}
+
+// SubResourceConfig instructs the code generator to add a Spec field to a
+// resource for a part of its configuration that the API manages with a
+// dedicated group of operations rather than with the resource's Create and
+// Update operations. This is typically the case of S3 Buckets, configured by
+// many Put/Get/Delete operation triples:
+//
+// resources:
+//   Bucket:
+//     sub_resources:
+//       Versioning:
+//         put_operation: PutBucketVersioning
+//         get_operation: GetBucketVersioning
+//         member: VersioningConfiguration
+//       Policy:
+//         put_operation: PutBucketPolicy
+//         get_operation: GetBucketPolicy
+//         delete_operation: DeleteBucketPolicy
+//         member: Policy
+//         unset_error_codes:
+//           - NoSuchBucketPolicy
+//
+// The Spec field has the shape of the put operation's input shape member. It
+// is read with the get operation, whose output shape either has a member with
+// the same name or, for structure members, the members of the structure. When
+// the field differs from the latest observed state, the field is updated with
+// the put operation or, when the desired value is nil, with the delete
+// operation.
+type SubResourceConfig struct {
+	// PutOperation is the ID of the operation setting the sub-resource
+	PutOperation string `json:"put_operation"`
+	// GetOperation is the ID of the operation reading the sub-resource
+	GetOperation string `json:"get_operation"`
+	// DeleteOperation is the ID of the operation removing the sub-resource.
+	// When empty, the sub-resource isn't removed when the field is unset.
+	DeleteOperation string `json:"delete_operation,omitempty"`
+	// Member is the name of the put operation's input shape member containing
+	// the sub-resource
+	Member string `json:"member"`
+	// UnsetErrorCodes are the codes of the errors the get operation returns
+	// when the sub-resource isn't set, e.g. "NoSuchBucketPolicy"
+	UnsetErrorCodes []string `json:"unset_error_codes,omitempty"`
+}
+
+// TagsConfig instructs the code generator to turn the tags of a resource into
+// a `map[string]*string` Spec field, whatever their shape in the API model, and
+// to generate the code updating them with the tagging operations of the API
+// (e.g. TagResource, UntagResource and ListTagsForResource).
+//
+// The tags field and the tagging operations are detected from the API model;
+// this config only needs to name them when the detection fails. For example:
+//
+// resources:
+//   Queue:
+//     tags:
+//       tag_operation: TagQueue
+//       untag_operation: UntagQueue
+//       list_tags_operation: ListQueueTags
+type TagsConfig struct {
+	// Path is the name of the Spec field containing the tags. Defaults to
+	// "Tags".
+	Path string `json:"path,omitempty"`
+	// KeyMemberName is the name of the member containing the key of a tag,
+	// when the tags are a list of structures. Defaults to "Key".
+	KeyMemberName string `json:"key_member_name,omitempty"`
+	// ValueMemberName is the name of the member containing the value of a
+	// tag, when the tags are a list of structures. Defaults to "Value".
+	ValueMemberName string `json:"value_member_name,omitempty"`
+	// TagOperation is the name of the operation adding or overwriting tags
+	TagOperation string `json:"tag_operation,omitempty"`
+	// UntagOperation is the name of the operation removing tags by key
+	UntagOperation string `json:"untag_operation,omitempty"`
+	// ListTagsOperation is the name of the operation returning the tags of
+	// the resource
+	ListTagsOperation string `json:"list_tags_operation,omitempty"`
 }
 
 // HooksConfig instructs the code generator how to inject custom callback hooks
@@ -118,6 +334,45 @@ type HooksConfig struct {
 	TemplatePath *string `json:"template_path,omitempty"`
 }
 
+// SupportedHookIDs contains the identifiers of the hook points supported in
+// the ACK controller resource manager code paths. See pkg/generate/ack/hook.go
+// for a description of each hook point.
+var SupportedHookIDs = []string{
+	"sdk_read_one_pre_build_request",
+	"sdk_read_many_pre_build_request",
+	"sdk_get_attributes_pre_build_request",
+	"sdk_create_pre_build_request",
+	"sdk_update_pre_build_request",
+	"sdk_delete_pre_build_request",
+	"sdk_read_one_post_build_request",
+	"sdk_read_many_post_build_request",
+	"sdk_get_attributes_post_build_request",
+	"sdk_create_post_build_request",
+	"sdk_update_post_build_request",
+	"sdk_delete_post_build_request",
+	"sdk_read_one_post_request",
+	"sdk_read_many_post_request",
+	"sdk_get_attributes_post_request",
+	"sdk_create_post_request",
+	"sdk_update_post_request",
+	"sdk_delete_post_request",
+	"sdk_read_one_pre_set_output",
+	"sdk_read_many_pre_set_output",
+	"sdk_get_attributes_pre_set_output",
+	"sdk_create_pre_set_output",
+	"sdk_update_pre_set_output",
+	"sdk_read_one_post_set_output",
+	"sdk_read_many_post_set_output",
+	"sdk_get_attributes_post_set_output",
+	"sdk_create_post_set_output",
+	"sdk_update_post_set_output",
+	"sdk_file_end",
+	"delta_pre_compare",
+	"delta_post_compare",
+	"late_initialize_pre_read_one",
+	"late_initialize_post_read_one",
+}
+
 // CompareConfig informs instruct the code generator on how to compare two different
 // two objects of the same type
 type CompareConfig struct {
@@ -204,6 +459,69 @@ type ExceptionsConfig struct {
 	Errors map[int]ErrorConfig `json:"errors"`
 	// Set of aws exception codes that are terminal exceptions for this resource
 	TerminalCodes []string `json:"terminal_codes"`
+	// Rules classifies the errors returned by the API operations of the
+	// resource by AWS error code, rather than by HTTP status code. Many AWS
+	// APIs return a 400 for every error, which doesn't tell a missing resource
+	// apart from a throttled request or a resource in use. The first rule
+	// matching an error decides what the reconciler does with it. Errors
+	// matching no rule are handled as before.
+	//
+	// resources:
+	//   DBInstance:
+	//     exceptions:
+	//       rules:
+	//         - code: DBInstanceNotFound
+	//           outcome: not_found
+	//         - code: InvalidParameterCombination
+	//           message_pattern: "^Cannot .* storage"
+	//           operations:
+	//             - ModifyDBInstance
+	//           outcome: terminal
+	//         - code: Throttling
+	//           outcome: retry
+	//           retry_after_seconds: 5
+	//           max_retry_after_seconds: 120
+	//         - code: InvalidDBInstanceState
+	//           outcome: requeue
+	//           requeue_after_seconds: 60
+	Rules []ExceptionRuleConfig `json:"rules,omitempty"`
+}
+
+// ExceptionRuleConfig matches the errors returned by the API operations of a
+// resource and tells what the reconciler should do with them
+type ExceptionRuleConfig struct {
+	// Code is the AWS error code to match, in aws-sdk-go terms awsErr.Code()
+	Code string `json:"code"`
+	// MessagePattern is an optional regular expression the error message must
+	// match, in aws-sdk-go terms awsErr.Message()
+	MessagePattern *string `json:"message_pattern,omitempty"`
+	// Operations optionally lists the names of the API operations, e.g.
+	// "DescribeDBInstances", whose errors the rule matches. When empty, the
+	// rule matches the errors of all the operations of the resource, or of its
+	// read and Delete operations for a "not_found" outcome.
+	Operations []string `json:"operations,omitempty"`
+	// Outcome is one of:
+	//
+	// - "not_found": the resource doesn't exist. A read operation reports the
+	//   resource as not found and a Delete operation as deleted.
+	// - "terminal": the resource gets a Terminal condition and won't be
+	//   reconciled again until it changes.
+	// - "retry": the resource is requeued after RetryAfterSeconds, doubled on
+	//   each consecutive error of the same operation up to
+	//   MaxRetryAfterSeconds. Meant for throttling and other transient errors.
+	// - "requeue": the resource is requeued after RequeueAfterSeconds. Meant
+	//   for conflicts, e.g. a resource in use or being modified.
+	Outcome string `json:"outcome"`
+	// RetryAfterSeconds is the number of seconds after which a resource is
+	// first requeued by a "retry" rule
+	RetryAfterSeconds *int `json:"retry_after_seconds,omitempty"`
+	// MaxRetryAfterSeconds caps the number of seconds after which a resource
+	// is requeued by a "retry" rule. Defaults to 300.
+	MaxRetryAfterSeconds *int `json:"max_retry_after_seconds,omitempty"`
+	// RequeueAfterSeconds is the number of seconds after which a resource is
+	// requeued by a "requeue" rule. Defaults to the runtime's default requeue
+	// duration.
+	RequeueAfterSeconds *int `json:"requeue_after_seconds,omitempty"`
 }
 
 // ErrorConfig contains instructions to the code generator about the exception
@@ -256,8 +574,18 @@ type ListOperationConfig struct {
 	// MatchFields lists the names of fields in the Shape of the
 	// list element in the List Operation's Output shape.
 	MatchFields []string `json:"match_fields"`
+	// MaxPages is the maximum number of pages of results the List Operation
+	// reads when looking for the resource, if the operation is paginated.
+	// When the resource isn't in the pages read and there are more, reading
+	// the resource fails instead of reporting it as not found. Defaults to
+	// DefaultListOpMaxPages.
+	MaxPages *int `json:"max_pages,omitempty"`
 }
 
+// DefaultListOpMaxPages is the default maximum number of pages of results a
+// paginated List Operation reads when looking for a resource
+const DefaultListOpMaxPages = 100
+
 // UpdateOperationConfig contains instructions for the code generator to handle
 // Update operations for service APIs that have resources that have
 // difficult-to-standardize update operations.
@@ -480,3 +808,16 @@ func (c *Config) GetResourcePrintAddAgeColumn(resourceName string) bool {
 	}
 	return false
 }
+
+// ResourceTagsConfig returns the tags config of the supplied resource, or nil
+// if the resource's tags aren't normalized
+func (c *Config) ResourceTagsConfig(resourceName string) *TagsConfig {
+	if c == nil {
+		return nil
+	}
+	resourceConfig, ok := c.Resources[resourceName]
+	if !ok {
+		return nil
+	}
+	return resourceConfig.Tags
+}
@@ -0,0 +1,196 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package config
+
+//go:generate go run gen_docs.go
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// SchemaDraft is the JSON Schema dialect of the generator config schema
+const SchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// JSONSchema is a JSON Schema document, or a subschema of one, describing the
+// generator config file. Only the keywords needed to describe the Config
+// struct are supported.
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	AdditionalProperties *JSONSchema            `json:"additionalProperties,omitempty"`
+	PropertyNames        *JSONSchema            `json:"propertyNames,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	OneOf                []*JSONSchema          `json:"oneOf,omitempty"`
+	Not                  *JSONSchema            `json:"not,omitempty"`
+	Definitions          map[string]*JSONSchema `json:"definitions,omitempty"`
+}
+
+// Schema returns the JSON Schema of the generator config file. It is derived
+// from the Config struct and the structs it contains: properties are named
+// after the json tags of the struct fields and described with their doc
+// comments.
+//
+// Unknown properties are rejected so that editors flag typos such as
+// `is_primay_key`.
+func Schema() *JSONSchema {
+	b := &schemaBuilder{definitions: map[string]*JSONSchema{}}
+	schema := b.structSchema(reflect.TypeOf(Config{}))
+	schema.Schema = SchemaDraft
+	schema.Title = "ACK code generator config"
+	schema.Definitions = b.definitions
+	return schema
+}
+
+// MarshalSchema returns the indented JSON representation of the generator
+// config schema
+func MarshalSchema() ([]byte, error) {
+	return json.MarshalIndent(Schema(), "", "  ")
+}
+
+// propertyNamesEnums contains the allowed keys of map fields, keyed by
+// "<struct name>.<field name>"
+var propertyNamesEnums = map[string][]string{
+	"ResourceConfig.Hooks": SupportedHookIDs,
+}
+
+// itemsEnums contains the allowed items of slice fields, keyed by
+// "<struct name>.<field name>"
+var itemsEnums = map[string][]string{
+	"ValidationFieldConfig.Ignore": SupportedValidationConstraints,
+}
+
+// schemaBuilder builds the JSON Schema of a Go type, collecting the named
+// struct types it meets as definitions
+type schemaBuilder struct {
+	definitions map[string]*JSONSchema
+}
+
+// typeSchema returns the JSON Schema of the supplied Go type. Named struct
+// types are referenced from the schema definitions.
+func (b *schemaBuilder) typeSchema(t reflect.Type) *JSONSchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == reflect.TypeOf(StringArray{}) {
+		// StringArray accepts either a string or an array of strings
+		return &JSONSchema{
+			OneOf: []*JSONSchema{
+				{Type: "string"},
+				{Type: "array", Items: &JSONSchema{Type: "string"}},
+			},
+		}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &JSONSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &JSONSchema{Type: "number"}
+	case reflect.String:
+		return &JSONSchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &JSONSchema{Type: "array", Items: b.typeSchema(t.Elem())}
+	case reflect.Map:
+		schema := &JSONSchema{
+			Type:                 "object",
+			AdditionalProperties: b.typeSchema(t.Elem()),
+		}
+		switch t.Key().Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			schema.PropertyNames = &JSONSchema{Pattern: "^-?[0-9]+$"}
+		}
+		return schema
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.structSchema(t)
+		}
+		if _, found := b.definitions[t.Name()]; !found {
+			// Register the definition before building it so that recursive
+			// types terminate.
+			b.definitions[t.Name()] = nil
+			b.definitions[t.Name()] = b.structSchema(t)
+		}
+		return &JSONSchema{Ref: "#/definitions/" + t.Name()}
+	}
+	// Interfaces and other types accept any value
+	return &JSONSchema{}
+}
+
+// structSchema returns the JSON Schema of a struct type, with a property for
+// each exported field
+func (b *schemaBuilder) structSchema(t reflect.Type) *JSONSchema {
+	schema := &JSONSchema{
+		Description:          typeDocs[t.Name()],
+		Type:                 "object",
+		Properties:           map[string]*JSONSchema{},
+		// {"not": {}} matches nothing
+		AdditionalProperties: &JSONSchema{Not: &JSONSchema{}},
+	}
+	b.addProperties(schema, t)
+	return schema
+}
+
+// addProperties adds a property for each exported field of a struct type to
+// the supplied schema. The fields of untagged embedded structs are promoted,
+// just like encoding/json does.
+func (b *schemaBuilder) addProperties(schema *JSONSchema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				b.addProperties(schema, embedded)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		property := b.typeSchema(field.Type)
+		if doc := fieldDocs[t.Name()+"."+field.Name]; doc != "" {
+			property.Description = doc
+		}
+		if enum, found := propertyNamesEnums[t.Name()+"."+field.Name]; found {
+			property.PropertyNames = &JSONSchema{Enum: enum}
+		}
+		if enum, found := itemsEnums[t.Name()+"."+field.Name]; found && property.Items != nil {
+			property.Items = &JSONSchema{Type: property.Items.Type, Enum: enum}
+		}
+		schema.Properties[name] = property
+	}
+}
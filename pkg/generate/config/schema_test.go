@@ -0,0 +1,72 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package config_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+)
+
+func TestSchema(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	schema := ackgenconfig.Schema()
+	assert.Equal(ackgenconfig.SchemaDraft, schema.Schema)
+	assert.Equal("object", schema.Type)
+
+	resources, found := schema.Properties["resources"]
+	require.True(found)
+	assert.Equal("object", resources.Type)
+	assert.Equal("#/definitions/ResourceConfig", resources.AdditionalProperties.Ref)
+	assert.Contains(resources.Description, "generator instructions for individual CRDs")
+
+	resourceConfig, found := schema.Definitions["ResourceConfig"]
+	require.True(found)
+	assert.NotNil(resourceConfig.AdditionalProperties.Not)
+	hooks, found := resourceConfig.Properties["hooks"]
+	require.True(found)
+	assert.Equal(ackgenconfig.SupportedHookIDs, hooks.PropertyNames.Enum)
+	assert.Equal("#/definitions/HooksConfig", hooks.AdditionalProperties.Ref)
+
+	fieldConfig, found := schema.Definitions["FieldConfig"]
+	require.True(found)
+	assert.Contains(fieldConfig.Description, "FieldConfig contains instructions")
+	isPrimaryKey, found := fieldConfig.Properties["is_primary_key"]
+	require.True(found)
+	assert.Equal("boolean", isPrimaryKey.Type)
+	assert.Contains(isPrimaryKey.Description, "IsPrimaryKey indicates")
+	from, found := fieldConfig.Properties["from"]
+	require.True(found)
+	assert.Equal("#/definitions/SourceFieldConfig", from.Ref)
+
+	// StringArray accepts either a string or a list of strings
+	operationType := schema.Definitions["OperationConfig"].Properties["operation_type"]
+	require.Len(operationType.OneOf, 2)
+	assert.Equal("string", operationType.OneOf[0].Type)
+	assert.Equal("array", operationType.OneOf[1].Type)
+
+	// HTTP status codes key the exceptions
+	errors := schema.Definitions["ExceptionsConfig"].Properties["errors"]
+	assert.NotEmpty(errors.PropertyNames.Pattern)
+
+	b, err := ackgenconfig.MarshalSchema()
+	require.Nil(err)
+	assert.True(json.Valid(b))
+}
@@ -0,0 +1,323 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
+)
+
+// ValidationError describes a problem found in a generator config file
+type ValidationError struct {
+	// File is the path of the generator config file
+	File string
+	// Line and Column of the offending key in the generator config file.
+	// Both are zero when the position is unknown.
+	Line   int
+	Column int
+	// Path is the list of keys leading to the offending key, e.g.
+	// ["resources", "Repository", "fields", "Name"]
+	Path []string
+	// Message describes the problem
+	Message string
+	// Warning is true for problems that don't prevent the code generator from
+	// running, such as ignore rules that match nothing.
+	Warning bool
+}
+
+// Error returns a string representation of the validation error prefixed
+// with its file:line:column position, e.g.:
+//
+// generator.yaml:12:7: resources.Repository.fields.Name: unknown key "is_primay_key" (did you mean "is_primary_key"?)
+func (e *ValidationError) Error() string {
+	message := e.Message
+	if e.Warning {
+		message = "warning: " + message
+	}
+	position := e.File
+	if position == "" {
+		position = "<default config>"
+	}
+	if e.Line > 0 {
+		position = fmt.Sprintf("%s:%d:%d", position, e.Line, e.Column)
+	}
+	if len(e.Path) == 0 {
+		return fmt.Sprintf("%s: %s", position, message)
+	}
+	return fmt.Sprintf("%s: %s: %s", position, strings.Join(e.Path, "."), message)
+}
+
+// ValidationErrors is a list of problems found in a generator config file
+type ValidationErrors []*ValidationError
+
+// Error returns all the validation errors, one per line
+func (e ValidationErrors) Error() string {
+	lines := make([]string, 0, len(e))
+	for _, err := range e {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Errors returns the validation errors that aren't warnings
+func (e ValidationErrors) Errors() ValidationErrors {
+	res := ValidationErrors{}
+	for _, err := range e {
+		if !err.Warning {
+			res = append(res, err)
+		}
+	}
+	return res
+}
+
+// Sort sorts the validation errors by position
+func (e ValidationErrors) Sort() {
+	sort.SliceStable(e, func(i, j int) bool {
+		if e[i].Line != e[j].Line {
+			return e[i].Line < e[j].Line
+		}
+		return e[i].Column < e[j].Column
+	})
+}
+
+// DidYouMean returns a " (did you mean ...?)" suggestion for the candidate
+// closest to a misspelled subject, or an empty string if there is none.
+func DidYouMean(subject string, candidates []string) string {
+	if closest := util.ClosestString(subject, candidates); closest != "" {
+		return fmt.Sprintf(" (did you mean %q?)", closest)
+	}
+	return ""
+}
+
+// position is the line and column of a key in a generator config file
+type position struct {
+	line   int
+	column int
+}
+
+// configSource records where the keys of a generator config file were found
+// and the problems found while loading it.
+type configSource struct {
+	file string
+	// positions is keyed by the path of each key, joined with pathSeparator
+	positions map[string]position
+	errors    ValidationErrors
+}
+
+// pathSeparator joins the keys of a path in configSource.positions. Keys may
+// contain dots (e.g. nested field paths) so we can't use them.
+const pathSeparator = "\x00"
+
+// NewValidationError returns a ValidationError for the key found at the
+// supplied path. When the key isn't found in the generator config file (e.g.
+// it comes from the default config), the position of its closest parent is
+// used.
+func (c *Config) NewValidationError(
+	path []string,
+	format string,
+	args ...interface{},
+) *ValidationError {
+	err := &ValidationError{
+		Path:    append([]string{}, path...),
+		Message: fmt.Sprintf(format, args...),
+	}
+	if c == nil || c.source == nil {
+		return err
+	}
+	err.File = c.source.file
+	for i := len(path); i > 0; i-- {
+		if pos, found := c.source.positions[strings.Join(path[:i], pathSeparator)]; found {
+			err.Line = pos.line
+			err.Column = pos.column
+			break
+		}
+	}
+	return err
+}
+
+// NewValidationWarning returns a ValidationError for the key found at the
+// supplied path, flagged as a warning
+func (c *Config) NewValidationWarning(
+	path []string,
+	format string,
+	args ...interface{},
+) *ValidationError {
+	err := c.NewValidationError(path, format, args...)
+	err.Warning = true
+	return err
+}
+
+// Validate returns the problems found in the generator config file that can
+// be detected without an AWS service API model, such as unknown keys, which
+// are reported as warnings.
+func (c *Config) Validate() ValidationErrors {
+	if c == nil || c.source == nil {
+		return nil
+	}
+	return c.source.errors
+}
+
+// loadSource parses a generator config file and returns the position of all
+// its keys, along with the keys not matching any field of the Config struct.
+func loadSource(configPath string, content []byte) (*configSource, error) {
+	src := &configSource{
+		file:      configPath,
+		positions: map[string]position{},
+	}
+	root := &yaml.Node{}
+	if err := yaml.Unmarshal(content, root); err != nil {
+		return nil, err
+	}
+	src.walk(root, reflect.TypeOf(Config{}), []string{})
+	src.errors.Sort()
+	return src, nil
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// walk records the positions of the keys of a YAML node and checks them
+// against the JSON field names of the supplied Go type.
+func (src *configSource) walk(node *yaml.Node, t reflect.Type, path []string) {
+	for node.Kind == yaml.DocumentNode || node.Kind == yaml.AliasNode {
+		if node.Kind == yaml.AliasNode {
+			node = node.Alias
+			continue
+		}
+		if len(node.Content) == 0 {
+			return
+		}
+		node = node.Content[0]
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	// Types with a custom JSON representation (e.g. StringArray) are
+	// considered as leaves.
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+		fields := jsonFields(t)
+		fieldNames := make([]string, 0, len(fields))
+		for name := range fields {
+			fieldNames = append(fieldNames, name)
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode, valueNode := node.Content[i], node.Content[i+1]
+			key := keyNode.Value
+			keyPath := append(append([]string{}, path...), key)
+			src.record(keyPath, keyNode)
+			field, found := fields[key]
+			if !found {
+				// encoding/json matches keys case-insensitively
+				for name, f := range fields {
+					if strings.EqualFold(name, key) {
+						field, found = f, true
+						break
+					}
+				}
+			}
+			if !found {
+				src.errors = append(src.errors, &ValidationError{
+					File:    src.file,
+					Line:    keyNode.Line,
+					Column:  keyNode.Column,
+					Path:    path,
+					Message: fmt.Sprintf("unknown key %q%s", key, DidYouMean(key, fieldNames)),
+					// Unknown keys are ignored when loading the generator
+					// config. They are only reported, so that generator
+					// configs written for newer versions of the code
+					// generator keep working.
+					Warning: true,
+				})
+				continue
+			}
+			src.walk(valueNode, field.Type, keyPath)
+		}
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode, valueNode := node.Content[i], node.Content[i+1]
+			keyPath := append(append([]string{}, path...), keyNode.Value)
+			src.record(keyPath, keyNode)
+			src.walk(valueNode, t.Elem(), keyPath)
+		}
+	case reflect.Slice, reflect.Array:
+		if node.Kind != yaml.SequenceNode {
+			return
+		}
+		for i, elemNode := range node.Content {
+			elemPath := append(append([]string{}, path...), fmt.Sprintf("%d", i))
+			src.record(elemPath, elemNode)
+			src.walk(elemNode, t.Elem(), elemPath)
+		}
+	}
+}
+
+// record saves the position of a node found at the supplied path
+func (src *configSource) record(path []string, node *yaml.Node) {
+	src.positions[strings.Join(path, pathSeparator)] = position{
+		line:   node.Line,
+		column: node.Column,
+	}
+}
+
+// jsonFields returns the struct fields of a struct type, keyed by their JSON
+// name. Like encoding/json, the fields of untagged embedded structs are
+// promoted.
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for embeddedName, embeddedField := range jsonFields(embedded) {
+					fields[embeddedName] = embeddedField
+				}
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			// unexported field
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field
+	}
+	return fields
+}
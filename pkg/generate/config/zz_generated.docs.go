@@ -0,0 +1,207 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Code generated by gen_docs.go. DO NOT EDIT.
+
+package config
+
+// typeDocs contains the doc comments of the generator config structs,
+// keyed by struct name
+var typeDocs = map[string]string{
+	"ChildFieldConfig":          "ChildFieldConfig instructs the code generator that a list field of the\nresource contains the identifiers of children (e.g. the IAM Roles associated\nwith an RDS DBCluster) that are added and removed with dedicated API\noperations. The desired children are diffed against the observed ones and\nthe add and remove operations called for the difference.\n\nThe add and remove operations default to the resource's operations of type\n`AddChild`/`AddChildren` and `RemoveChild`/`RemoveChildren`. The children\nare observed either with a list operation or in a Status field of the\nresource.\n\nFor example, the following generator config reconciles the IAM Roles of an\nRDS DBCluster with AddRoleToDBCluster and RemoveRoleFromDBCluster, reading\nthe associated roles from the DBCluster's `Status.AssociatedRoles` field:\n\nresources:\n  DBCluster:\n    fields:\n      Roles:\n        child:\n          path: AssociatedRoles.RoleArn",
+	"CompareConfig":             "CompareConfig informs instruct the code generator on how to compare two different\ntwo objects of the same type",
+	"CompareFieldConfig":        "CompareFieldConfig informs the code generator how to compare two values of a\nfield",
+	"Config":                    "Config represents instructions to the ACK code generator for a particular\nAWS service API",
+	"DefaultFieldConfig":        "DefaultFieldConfig informs the code generator about the value the AWS\nservice gives a field that is left unset. When a field with a default\nisn't set in the desired state of a resource, it isn't compared to the\nobserved value, unless the default is a literal different from the observed\nvalue.\n\nFor example, the following generator config lets the Kubernetes API server\nset the `ImageTagMutability` field of ECR Repositories to \"MUTABLE\" and\ndoesn't compare the `ScanOnPush` field of the repositories leaving it\nunset:\n\nresources:\n  Repository:\n    fields:\n      ImageTagMutability:\n        default:\n          value: MUTABLE\n      ImageScanningConfiguration.ScanOnPush:\n        default:\n          is_computed: true",
+	"DeleteConfig":              "DeleteConfig instructs the code generator on how to delete a resource whose\ndeletion completes asynchronously, for example an RDS DBInstance that stays\nin the \"deleting\" state for minutes after the DeleteDBInstance call:\n\nresources:\n  DBInstance:\n    delete:\n      wait_until_not_found: true\n      deleting:\n        path: Status.DBInstanceStatus\n        values:\n          - deleting\n      requeue_after_seconds: 60\n      pre_delete_updates:\n        - path: Spec.DeletionProtection\n          value: false",
+	"DeletingConfig":            "DeletingConfig lists the values of a Status field for which a resource is\nbeing deleted",
+	"ErrorConfig":               "ErrorConfig contains instructions to the code generator about the exception\ncorresponding to a HTTP status code",
+	"ExceptionRuleConfig":       "ExceptionRuleConfig matches the errors returned by the API operations of a\nresource and tells what the reconciler should do with them",
+	"ExceptionsConfig":          "ExceptionsConfig contains instructions to the code generator about how to\nhandle the exceptions for the operations on a resource. These instructions\nare necessary for those APIs where the API models do not contain any\ninformation about the HTTP status codes a particular exception has (or, like\nthe EC2 API, where the API model has no information at all about error\nresponses for any operation)",
+	"FieldConfig":               "FieldConfig contains instructions to the code generator about how\nto interpret the value of an Attribute and how to map it to a CRD's Spec or\nStatus field",
+	"GetAttributesInputConfig":  "GetAttributesInputConfig is used to instruct the code generator how to\nhandle the GetAttributes API operation's Input shape.",
+	"HooksConfig":               "HooksConfig instructs the code generator how to inject custom callback hooks\nat various places in the resource manager and SDK linkage code.\n\nExample usage from the AmazonMQ generator config:\n\nresources:\n  Broker:\n    hooks:\n      sdk_update_pre_build_request:\n       code: if err := rm.requeueIfNotRunning(latest); err != nil { return nil, err }\n\nNote that the implementor of the AmazonMQ service controller for ACK should\nensure that there is a `requeueIfNotRunning()` method implementation in\n`pkg/resource/broker`\n\nInstead of placing Go code directly into the generator.yaml file using the\n`code` field, you can reference a template file containing Go code with the\n`template_path` field:\n\nresources:\n  Broker:\n    hooks:\n      sdk_update_pre_build_update_request:\n       template_path: templates/sdk_update_pre_build_request.go.tpl",
+	"IgnoreSpec":                "IgnoreSpec represents instructions to the ACK code generator to\nignore operations, resources on an AWS service API",
+	"JSONSchema":                "JSONSchema is a JSON Schema document, or a subschema of one, describing the\ngenerator config file. Only the keywords needed to describe the Config\nstruct are supported.",
+	"LateInitializeConfig":      "LateInitializeConfig contains instructions for how to handle the\nretrieval and setting of server-side defaulted fields.\nNOTE: Currently the members of this have no effect on late initialization of fields.\nCurrently the late initialization is requeued with static delay of 5 second.\nTODO: (vijat@) Add support of retry/backoff for late initialization.",
+	"ListOperationConfig":       "ListOperationConfig contains instructions for the code generator to handle\nList operations for service APIs that have no built-in filtering ability and\nwhose List Operation always returns all objects.",
+	"MemberConstructorConfig":   "MemberConstructorConfig contains override instructions for how to handle the\nconstruction of a particular member for a Shape in the API.",
+	"OperationConfig":           "OperationConfig represents instructions to the ACK code generator to\nspecify the overriding values for API operation parameters and its custom implementation.",
+	"OperationRenamesConfig":    "OperationRenamesConfig contains instructions to the code generator on how to\nrename fields in an Operation's input and output payload shapes",
+	"PreDeleteUpdateConfig":     "PreDeleteUpdateConfig is the value a Spec field of a resource must have\nfor the resource to be deleted",
+	"PrintConfig":               "PrintConfig informs instruct the code generator on how to sort kubebuilder\nprintcolumn marker coments.",
+	"PrintFieldConfig":          "PrintFieldConfig instructs the code generator how to handle kubebuilder:printcolumn\ncomment marker generation. If this struct is not nil, the field will be added to the\ncolumns of `kubectl get` response.",
+	"ReconcileConfig":           "ReconcileConfig describes options for controlling the reconciliation\nlogic for a particular resource.",
+	"ReferencesFieldConfig":     "ReferencesFieldConfig instructs the code generator to produce, next to a\nfield containing the identifier of another AWS resource, the Spec fields that\nrefer to the custom resource managing it. The reference is resolved and the\nidentifier copied into the field before the resource is created or updated.\n\nFor example, the following generator config lets users refer to the KMS Key\nencrypting an ECR Repository with a `kmsKeyRef` or `kmsKeySelector` field:\n\nresources:\n  Repository:\n    fields:\n      KmsKey:\n        references:\n          resource: Key\n          service_name: kms\n          api_version: v1alpha1\n          path: Status.ACKResourceMetadata.ARN",
+	"RenamesConfig":             "RenamesConfig contains instructions to the code generator how to rename\nfields in various Operation payloads",
+	"ResourceConfig":            "ResourceConfig represents instructions to the ACK code generator\nfor a particular CRD/resource on an AWS service API",
+	"SourceFieldConfig":         "SourceFieldConfig instructs the code generator how to handle a field in the\nResource's SpecFields/StatusFields collection that takes its value from an\nabnormal source -- in other words, not the Create operation's Input or\nOutput shape.\n\nThis additional field can source its value from a shape in a different API\nOperation entirely.\n\nThe data type (Go type) that a field is assigned during code generation\ndepends on whether the field is part of the Create Operation's Input shape\nwhich go into the Resource's Spec fields collection, or the Create\nOperation's Output shape which, if not present in the Input shape, means the\nfield goes into the Resource's Status fields collection).\n\nEach Resource typically also has a ReadOne Operation. The ACK service\ncontroller will call this ReadOne Operation to get the latest observed state\nof a particular resource in the backend AWS API service. The service\ncontroller sets the observed Resource's Spec and Status fields from the\nOutput shape of the ReadOne Operation. The code generator is responsible for\nproducing the Go code that performs these \"setter\" methods on the Resource.\nThe way the code generator determines how to set the Spec or Status fields\nfrom the Output shape's member fields is by looking at the data type of the\nSpec or Status field with the same name as the Output shape's member field.\n\nImportantly, in producing this \"setter\" Go code the code generator **assumes\nthat the data types (Go types) in the source (the Output shape's member\nfield) and target (the Spec or Status field) are the same**.\n\nThere are some APIs, however, where the Go type of the field in the Create\nOperation's Input shape is actually different from the same-named field in\nthe ReadOne Operation's Output shape. A good example of this is the Lambda\nCreateFunction API call, which has a `Code` member of its Input shape that\nlooks like this:\n\n\"Code\": {\n  \"ImageUri\": \"string\",\n  \"S3Bucket\": \"string\",\n  \"S3Key\": \"string\",\n  \"S3ObjectVersion\": \"string\",\n  \"ZipFile\": blob\n},\n\nThe GetFunction API call's Output shape has a same-named field called\n`Code` in it, but this field looks like this:\n\n\"Code\": {\n  \"ImageUri\": \"string\",\n  \"Location\": \"string\",\n  \"RepositoryType\": \"string\",\n  \"ResolvedImageUri\": \"string\"\n},\n\nThis presents a conundrum to the ACK code generator, which, as noted above,\nassumes the data types of same-named fields in the Create Operation's Input\nshape and ReadOne Operation's Output shape are the same.\n\nThe SourceFieldConfig struct allows us to explain to the code generator\nhow to handle situations like this.\n\nFor the Lambda Function Resource's `Code` field, we can inform the code\ngenerator to create three new Status fields (readonly) from the `Location`,\n`RepositoryType` and `ResolvedImageUri` fields in the `Code` member of the\nReadOne Operation's Output shape:\n\nresources:\n  Function:\n    fields:\n      CodeLocation:\n        is_read_only: true\n        from:\n          operation: GetFunction\n          path: Code.Location\n      CodeRepositoryType:\n        is_read_only: true\n        from:\n          operation: GetFunction\n          path: Code.RepositoryType\n      CodeRegisteredImageURI:\n        is_read_only: true\n        from:\n          operation: GetFunction\n          path: Code.RegisteredImageUri",
+	"SubResourceConfig":         "SubResourceConfig instructs the code generator to add a Spec field to a\nresource for a part of its configuration that the API manages with a\ndedicated group of operations rather than with the resource's Create and\nUpdate operations. This is typically the case of S3 Buckets, configured by\nmany Put/Get/Delete operation triples:\n\nresources:\n  Bucket:\n    sub_resources:\n      Versioning:\n        put_operation: PutBucketVersioning\n        get_operation: GetBucketVersioning\n        member: VersioningConfiguration\n      Policy:\n        put_operation: PutBucketPolicy\n        get_operation: GetBucketPolicy\n        delete_operation: DeleteBucketPolicy\n        member: Policy\n        unset_error_codes:\n          - NoSuchBucketPolicy\n\nThe Spec field has the shape of the put operation's input shape member. It\nis read with the get operation, whose output shape either has a member with\nthe same name or, for structure members, the members of the structure. When\nthe field differs from the latest observed state, the field is updated with\nthe put operation or, when the desired value is nil, with the delete\noperation.",
+	"SyncedConfig":              "SyncedConfig instructs the code generator to set the ACK.ResourceSynced\ncondition of a resource from the values of its Status fields, instead of\nhand-writing an update_conditions_custom_method_name method:\n\nresources:\n  DBInstance:\n    synced:\n      when:\n        - path: Status.DBInstanceStatus\n          ready:\n            - available\n          in_progress:\n            - creating\n            - modifying\n          terminal:\n            - failed\n            - incompatible-parameters\n\nThe resource is synced when the fields of all the rules have a ready\nvalue. When any field has a terminal value, the ACK.Terminal condition is\nset as well. Otherwise the resource isn't synced and, like any resource\nthat isn't synced, is requeued until it is.",
+	"SyncedRule":                "SyncedRule lists the values of a Status field for which a resource is\nsynced, still being changed, or failed",
+	"TagsConfig":                "TagsConfig instructs the code generator to turn the tags of a resource into\na `map[string]*string` Spec field, whatever their shape in the API model, and\nto generate the code updating them with the tagging operations of the API\n(e.g. TagResource, UntagResource and ListTagsForResource).\n\nThe tags field and the tagging operations are detected from the API model;\nthis config only needs to name them when the detection fails. For example:\n\nresources:\n  Queue:\n    tags:\n      tag_operation: TagQueue\n      untag_operation: UntagQueue\n      list_tags_operation: ListQueueTags",
+	"UnpackAttributesMapConfig": "UnpackAttributesMapConfig informs the code generator that the API follows a\npattern or using an \"Attributes\" `map[string]*string` that contains real,\nschema'd fields of the primary resource, and that those fields should be\n\"unpacked\" from the raw map and into CRD's Spec and Status struct fields.\n\nAWS Simple Notification Service (SNS) and AWS Simple Queue Service (SQS) are\nexamples of APIs that use this pattern. For instance, the SNS CreateTopic\nAPI accepts a parameter called \"Attributes\" that can contain one of four\nkeys:\n\n* DeliveryPolicy – The policy that defines how Amazon SNS retries failed\n  deliveries to HTTP/S endpoints.\n* DisplayName – The display name to use for a topic with SMS subscriptions\n* Policy – The policy that defines who can access your topic.\n* KmsMasterKeyId - The ID of an AWS-managed customer master key (CMK) for\n  Amazon SNS or a custom CMK.\n\nThe `CreateTopic` API call **returns** only a single field: the TopicARN.\nBut there is a separate `GetTopicAttributes` call that needs to be made that\nreturns the above attributes (that are ReadWrite) along with a set of\nkey/values that are ReadOnly:\n\n* Owner – The AWS account ID of the topic's owner.\n* SubscriptionsConfirmed – The number of confirmed subscriptions for the\n  topic.\n* SubscriptionsDeleted – The number of deleted subscriptions for the topic.\n* SubscriptionsPending – The number of subscriptions pending confirmation\n  for the topic.\n* TopicArn – The topic's ARN.\n* EffectiveDeliveryPolicy – The JSON serialization of the effective delivery\n  policy, taking system defaults into account.\n\nThis structure instructs the code generator about the above real, schema'd\nfields that are masquerading as raw key/value pairs.",
+	"UpdateOperationConfig":     "UpdateOperationConfig contains instructions for the code generator to handle\nUpdate operations for service APIs that have resources that have\ndifficult-to-standardize update operations.",
+	"ValidationError":           "ValidationError describes a problem found in a generator config file",
+	"ValidationFieldConfig":     "ValidationFieldConfig instructs the code generator how to produce the\n`+kubebuilder:validation` markers of a field. By default, the markers are\nderived from the constraints of the field's shape in the API model: length\nof strings, number of list items, numeric ranges, regular expressions and\nenum values.\n\nFor example, the following generator config drops the pattern of the ECR\nRepository's `RepositoryName` field and restricts its length:\n\nresources:\n  Repository:\n    fields:\n      RepositoryName:\n        validation:\n          ignore:\n            - pattern\n          max_length: 64",
+	"WaiterConfig":              "WaiterConfig instructs the code generator on which waiter of the service's\naws-sdk-go `waiters-2.json` file tells whether a resource is ready. The\nwaiter's acceptors are matched against the output of the operation the\nresource is read with, and set the ACK.ResourceSynced condition of the\nresource. By default, the waiter named after the resource and ending with\n\"Available\", \"InService\", \"Active\", \"Running\", \"Ready\" or \"Exists\" (in that\norder of preference) is used, if the resource is read with its operation.\n\nresources:\n  DBInstance:\n    waiter:\n      name: DBInstanceAvailable\n\nThe synced config takes precedence over waiters.",
+}
+
+// fieldDocs contains the doc comments of the generator config struct
+// fields, keyed by "<struct name>.<field name>"
+var fieldDocs = map[string]string{
+	"ChildFieldConfig.AddOperation":                          "AddOperation is the name of the operation adding a child, or a list of\nchildren, to the resource",
+	"ChildFieldConfig.ListOperation":                         "ListOperation is the name of the operation returning the children of\nthe resource, e.g. \"ListAttachedRolePolicies\"",
+	"ChildFieldConfig.MemberName":                            "MemberName is the name of the member of the add and remove operations'\ninput shapes containing the child identifier(s), e.g. \"RoleArn\".\nDefaults to the only required member that isn't a field of the\nresource.",
+	"ChildFieldConfig.Path":                                  "Path is the dotted path of the child identifiers in the output shape of\nListOperation (e.g. \"AttachedPolicies.PolicyArn\") or, when\nListOperation is empty, in the Status of the resource (e.g.\n\"AssociatedRoles.RoleArn\")",
+	"ChildFieldConfig.RemoveOperation":                       "RemoveOperation is the name of the operation removing a child, or a\nlist of children, from the resource",
+	"CompareConfig.Ignore":                                   "Ignore is a list of field paths to ignore when comparing two objects",
+	"CompareFieldConfig.IsIgnored":                           "IsIgnored indicates the field should be ignored when comparing a\nresource",
+	"CompareFieldConfig.IsSet":                               "IsSet indicates the elements of a list field can be in any order. Lists\nof strings are always compared regardless of the order of their\nelements.",
+	"CompareFieldConfig.Key":                                 "Key is the name of the member of the struct elements of a list field\nthat identifies an element. The elements of the lists being compared\nare matched by key, regardless of their order, and compared member by\nmember. For example, the following generator config produces\ndifferences like `Spec.FileSystemConfigs[arn=...].LocalMountPath`:\n\nresources:\n  Function:\n    fields:\n      FileSystemConfigs:\n        compare:\n          key: Arn",
+	"CompareFieldConfig.NilEqualsZeroValue":                  "NilEqualsZeroValue indicates a nil pointer and zero-value pointed-to\nvalue should be considered equal for the purposes of comparison",
+	"Config.Ignore":                                          "CRDs to ignore. ACK generator would skip these resources.",
+	"Config.IncludeACKMetadata":                              "IncludeACKMetadata lets you specify whether ACK Metadata should be included\nin the status. Default is true.",
+	"Config.Operations":                                      "Contains generator instructions for individual API operations.",
+	"Config.PrefixConfig":                                    "PrefixConfig contains the prefixes to access certain fields in the generated\nGo code.",
+	"Config.Resources":                                       "Resources contains generator instructions for individual CRDs within an\nAPI",
+	"Config.SetManyOutputNotFoundErrReturn":                  "SetManyOutputNotFoundErrReturn is the return statement when generated\nSetManyOutput function fails with NotFound error.\nDefault is \"return nil, ackerr.NotFound\"",
+	"DefaultFieldConfig.IsComputed":                          "IsComputed indicates the default value is computed by the AWS service,\ne.g. from other fields, and can't be known by the code generator",
+	"DefaultFieldConfig.Value":                               "Value is the literal default value of a string, number or boolean\nfield, e.g. \"MUTABLE\", \"7\" or \"true\". It is rendered as a\n`+kubebuilder:default` marker of the field.",
+	"DeleteConfig.Deleting":                                  "Deleting tells from the value of a Status field whether the resource is\nalready being deleted, in which case the Delete operation isn't called\nagain. Requires WaitUntilNotFound.",
+	"DeleteConfig.PreDeleteUpdates":                          "PreDeleteUpdates lists the values that Spec fields of the resource must\nhave for the resource to be deleted, e.g. to disable its deletion\nprotection. The resource is updated before its Delete operation is\ncalled if any of these fields has a different value.",
+	"DeleteConfig.RequeueAfterSeconds":                       "RequeueAfterSeconds is the number of seconds after which to check\nagain whether a resource being deleted is gone. Defaults to the\nruntime's default requeue duration. Requires WaitUntilNotFound.",
+	"DeleteConfig.WaitUntilNotFound":                         "WaitUntilNotFound instructs the code generator to keep the resource,\nand the finalizer of its custom resource, until the resource is no\nlonger found after its Delete operation is called. The deletion is\nchecked again after RequeueAfterSeconds. Requires Deleting, so that the\nDelete operation isn't called again while the resource is being\ndeleted.",
+	"DeletingConfig.Path":                                    "Path is the path of the field, e.g. \"Status.DBInstanceStatus\"",
+	"DeletingConfig.Values":                                  "Values lists the values for which the resource is being deleted",
+	"ErrorConfig.Code":                                       "Code corresponds to name of Exception returned by AWS API.\nIn AWS Go SDK terms - awsErr.Code()",
+	"ErrorConfig.MessagePrefix":                              "MessagePrefix is an optional string field to be checked as prefix of the\nexception message in addition to exception name. This is needed for HTTP codes\nwhere the exception name alone is not sufficient to determine the type of error.\nExample: SageMaker service throws ValidationException if job does not exist\nas well as if IAM role does not have sufficient permission to fetch the dataset\nFor the former controller should proceed with creation of job whereas the\nlater is a terminal state.\nIn Go SDK terms - awsErr.Message()",
+	"ErrorConfig.MessageSuffix":                              "MessageSuffix is an optional string field to be checked as suffix of the\nexception message in addition to exception name. This is needed for HTTP codes\nwhere the exception name alone is not sufficient to determine the type of error.\nExample: SageMaker service throws ValidationException if job does not exist\nas well as if IAM role does not have sufficient permission to fetch the dataset\nFor the former controller should proceed with creation of job whereas the\nlater is a terminal state.\nIn Go SDK terms - awsErr.Message()",
+	"ExceptionRuleConfig.Code":                               "Code is the AWS error code to match, in aws-sdk-go terms awsErr.Code()",
+	"ExceptionRuleConfig.MaxRetryAfterSeconds":               "MaxRetryAfterSeconds caps the number of seconds after which a resource\nis requeued by a \"retry\" rule. Defaults to 300.",
+	"ExceptionRuleConfig.MessagePattern":                     "MessagePattern is an optional regular expression the error message must\nmatch, in aws-sdk-go terms awsErr.Message()",
+	"ExceptionRuleConfig.Operations":                         "Operations optionally lists the names of the API operations, e.g.\n\"DescribeDBInstances\", whose errors the rule matches. When empty, the\nrule matches the errors of all the operations of the resource, or of its\nread and Delete operations for a \"not_found\" outcome.",
+	"ExceptionRuleConfig.Outcome":                            "Outcome is one of:\n\n- \"not_found\": the resource doesn't exist. A read operation reports the\n  resource as not found and a Delete operation as deleted.\n- \"terminal\": the resource gets a Terminal condition and won't be\n  reconciled again until it changes.\n- \"retry\": the resource is requeued after RetryAfterSeconds, doubled on\n  each consecutive error of the same operation up to\n  MaxRetryAfterSeconds. Meant for throttling and other transient errors.\n- \"requeue\": the resource is requeued after RequeueAfterSeconds. Meant\n  for conflicts, e.g. a resource in use or being modified.",
+	"ExceptionRuleConfig.RequeueAfterSeconds":                "RequeueAfterSeconds is the number of seconds after which a resource is\nrequeued by a \"requeue\" rule. Defaults to the runtime's default requeue\nduration.",
+	"ExceptionRuleConfig.RetryAfterSeconds":                  "RetryAfterSeconds is the number of seconds after which a resource is\nfirst requeued by a \"retry\" rule",
+	"ExceptionsConfig.Errors":                                "Errors is a map of HTTP status code to information about the Exception\nthat corresponds to that HTTP status code for this resource",
+	"ExceptionsConfig.Rules":                                 "Rules classifies the errors returned by the API operations of the\nresource by AWS error code, rather than by HTTP status code. Many AWS\nAPIs return a 400 for every error, which doesn't tell a missing resource\napart from a throttled request or a resource in use. The first rule\nmatching an error decides what the reconciler does with it. Errors\nmatching no rule are handled as before.\n\nresources:\n  DBInstance:\n    exceptions:\n      rules:\n        - code: DBInstanceNotFound\n          outcome: not_found\n        - code: InvalidParameterCombination\n          message_pattern: \"^Cannot .* storage\"\n          operations:\n            - ModifyDBInstance\n          outcome: terminal\n        - code: Throttling\n          outcome: retry\n          retry_after_seconds: 5\n          max_retry_after_seconds: 120\n        - code: InvalidDBInstanceState\n          outcome: requeue\n          requeue_after_seconds: 60",
+	"ExceptionsConfig.TerminalCodes":                         "Set of aws exception codes that are terminal exceptions for this resource",
+	"FieldConfig.Child":                                      "Child instructs the code generator to reconcile the list of children\ncontained in the field with the operations adding and removing them",
+	"FieldConfig.Compare":                                    "Compare instructs the code generator how to produce code that compares\nthe value of the field in two resources",
+	"FieldConfig.Default":                                    "Default informs the code generator about the value the AWS service\ngives the field when it is left unset",
+	"FieldConfig.From":                                       "From instructs the code generator that the value of the field should\nbe retrieved from the specified operation and member path",
+	"FieldConfig.IsARN":                                      "IsARN indicates the field represents the ARN for the resource.\nThis allows the generator config to override the\ndefault behaviour of considering a field called \"Arn\" or\n\"{Resource}Arn\" (case in-sensitive) as the \"ARN field\" for the resource.",
+	"FieldConfig.IsAttribute":                                "IsAttribute informs the code generator that this field is part of an\n\"Attributes Map\".\n\nSome resources for some service APIs follow a pattern or using an\n\"Attributes\" `map[string]*string` that contains real, schema'd fields of\nthe primary resource, and that those fields should be \"unpacked\" from\nthe raw map and into CRD's Spec and Status struct fields.",
+	"FieldConfig.IsImmutable":                                "IsImmutable instructs the code generator to add advisory conditions\nif user modifies the spec field after resource was created. Changes to\nthe field are also rejected by the Kubernetes API server, with a CEL\nvalidation rule and a validating webhook, unless the field is nested in\nthe elements of a list or map.",
+	"FieldConfig.IsOwnerAccountID":                           "IsOwnerAccountID indicates the field contains the AWS Account ID\nthat owns the resource. This is a special field that we direct to\nstorage in the common `Status.ACKResourceMetadata.OwnerAccountID` field.",
+	"FieldConfig.IsPrimaryKey":                               "IsPrimaryKey indicates the field represents the primary name/string\nidentifier field for the resource.  This allows the generator config to\noverride the default behaviour of considering a field called \"Name\" or\n\"{Resource}Name\" or \"{Resource}Id\" as the \"name field\" for the resource.",
+	"FieldConfig.IsReadOnly":                                 "IsReadOnly indicates the field's value can not be set by a Kubernetes\nuser; in other words, the field should go in the CR's Status struct",
+	"FieldConfig.IsRequired":                                 "Required indicates whether this field is a required member or not.\nThis field is used to configure '+kubebuilder:validation:Required' on API object's members.",
+	"FieldConfig.IsSecret":                                   "IsSecret instructs the code generator that this field should be a\nSecretKeyReference.",
+	"FieldConfig.LateInitialize":                             "Late Initialize instructs the code generator how to handle the late initialization\nof the field.",
+	"FieldConfig.Print":                                      "Print instructs the code generator how to generate comment markers that\ninfluence hows field are printed in `kubectl get` response. If this field\nis not nil, it will be added to the columns of `kubectl get`.",
+	"FieldConfig.References":                                 "References instructs the code generator to produce fields referring to\nthe custom resource whose identifier the field contains",
+	"FieldConfig.Type":                                       "Type overrides the Go type the code generator derives from the shape\nof the field. The supported types are:\n\n* `apiextensionsv1.JSON` for string fields containing JSON documents,\n  e.g. policies, which are compared as JSON values\n* `metav1.Duration` for integer fields containing a number of seconds\n* `resource.Quantity` for integer fields\n* `intstr.IntOrString` for string or integer fields\n\nAny other type is the name of a Go type declared in the API package of\nthe service controller, e.g. `PolicyDocument`, and a string field is\n(un)marshalled to and from the type as a JSON document:\n\nresources:\n  Repository:\n    fields:\n      PolicyText:\n        type: apiextensionsv1.JSON",
+	"FieldConfig.Validation":                                 "Validation instructs the code generator how to produce the OpenAPI\nvalidation markers of the field",
+	"GetAttributesInputConfig.Overrides":                     "Overrides is a map of structures instructing the code generator how to\nhandle the override of a particular field in the Input shape for the\nGetAttributes operation. The map keys are the names of the field in the\nInput shape to override.",
+	"HooksConfig.Code":                                       "Code is the Go code to be injected at the hook point",
+	"HooksConfig.TemplatePath":                               "TemplatePath is a path to the template containing the hook code",
+	"IgnoreSpec.FieldPaths":                                  "Set of field paths to ignore. The name here should be the original name of\nthe field as it appears in AWS SDK objects. You can refer to a field by\ngiving its \"<shape_name>.<field_name>\". For example, \"CreateApiInput.Name\".",
+	"IgnoreSpec.Operations":                                  "Set of operation IDs/names that should be ignored by the\ngenerator when constructing SDK linkage",
+	"IgnoreSpec.ResourceNames":                               "Set of resource names that should be ignored by the\ngenerator",
+	"IgnoreSpec.ShapeNames":                                  "Set of shapes to ignore when constructing API type definitions and\nassociated SDK code for structs that have these shapes as members",
+	"LateInitializeConfig.MaxBackoffSeconds":                 "MaxBackoffSeconds provide the maximum allowed backoff when retrying late initialization after an\nunsuccessful attempt.",
+	"LateInitializeConfig.MinBackoffSeconds":                 "MinBackoffSeconds provides the minimum backoff to attempt late initialization again after an unsuccessful\nattempt to late initialized fields from ReadOne output\nFor every attempt, the reconciler will calculate the delay between MinBackoffSeconds and MaxBackoffSeconds\nusing exponential backoff and retry strategy",
+	"ListOperationConfig.MatchFields":                        "MatchFields lists the names of fields in the Shape of the\nlist element in the List Operation's Output shape.",
+	"ListOperationConfig.MaxPages":                           "MaxPages is the maximum number of pages of results the List Operation\nreads when looking for the resource, if the operation is paginated.\nWhen the resource isn't in the pages read and there are more, reading\nthe resource fails instead of reporting it as not found. Defaults to\nDefaultListOpMaxPages.",
+	"MemberConstructorConfig.Values":                         "Values contains the value or values of the member to always set the\nmember to. If the member's type is a []string, the member is set to the\nValues list. If the type is a string, the member's value is set to the\nfirst list element in the Values list.",
+	"OperationConfig.OperationType":                          "Override for operation type in case of heuristic failure\nAn example of this is `Put...` or `Register...` API operations not being correctly classified as `Create` op type\nOperationType []string `json:\"operation_type\"`",
+	"OperationConfig.OutputWrapperFieldPath":                 "OutputWrapperFieldPath provides the JSON-Path like to the struct field containing\ninformation that will be merged into a `resource` object.",
+	"OperationConfig.ResourceName":                           "Override for resource name in case of heuristic failure\nAn example of this is correcting stutter when the resource logic doesn't properly determine the resource name",
+	"OperationConfig.SetOutputCustomMethodName":              "SetOutputCustomMethodName provides the name of the custom method on the\n`resourceManager` struct that will set fields on a `resource` struct\ndepending on the output of the operation.",
+	"OperationRenamesConfig.InputFields":                     "InputFields is a map of Input shape fields to renamed field name.",
+	"OperationRenamesConfig.OutputFields":                    "OutputFields is a map of Output shape fields to renamed field name.",
+	"PreDeleteUpdateConfig.Path":                             "Path is the path of the field, e.g. \"Spec.DeletionProtection\"",
+	"PreDeleteUpdateConfig.Value":                            "Value is the literal value of the string, number or boolean field,\ne.g. \"false\"",
+	"PrefixConfig.SpecField":                                 "SpecField stores the string prefix to use for information that will be\nsent to AWS. Defaults to `.Spec`",
+	"PrefixConfig.StatusField":                               "StatusField stores the string prefix to use for information fetched from\nAWS. Defaults to `.Status`",
+	"PrintConfig.AddAgeColumn":                               "AddAgeColumn a boolean informing the code generator whether to append a kubebuilder\nmarker comment to show a resource Age (created since date) in `kubectl get` response.\nThe Age value is parsed from '.metadata.creationTimestamp'.\n\nNOTE: this is the Kubernetes resource Age (creation time at the api-server/etcd)\nand not the AWS resource Age.",
+	"PrintConfig.OrderBy":                                    "OrderBy is the field used to sort the list of PrinterColumn options.",
+	"PrintFieldConfig.Index":                                 "Index informs the code generator about the position/order of a specific field/column in\n`kubectl get` response. To enable ordering by index, `$resource.print.orderBy` must be set\nto `index`\nThe field with the smallest index will be right next to the first column (NAME).\nThe field with the biggest index will be positioned right before the last column (AGE).",
+	"PrintFieldConfig.Name":                                  "Name instructs the code generator to override the column name used to\ninclude the field in `kubectl get` response. This field is generally used\nto override very long and redundant columns names.",
+	"PrintFieldConfig.Priority":                              "Priority differentiates between fields/columns shown in standard view or wide\nview (using the -o wide flag). Fields with priority 0 are shown in standard view.\nFields with priority greater than 0 are only shown in wide view. Default is 0",
+	"ReconcileConfig.RequeueOnSuccessSeconds":                "RequeueOnSuccessSeconds indicates the number of seconds after which to requeue a\nresource that has been successfully reconciled (i.e. ConditionTypeResourceSynced=true)\nThis is useful for resources that are long-lived and may have observable status fields\nchange over time that would be useful to refresh those field values for users.\nThis field is optional and the default behaviour of the ACK runtime is to not requeue\nresources that have been successfully reconciled. Note that all ACK controllers will\n*flush and resync their watch caches* every 10 hours by default, which will end up\ncausing ACK controllers to refresh the status views of all watched resources, but this\nbehaviour is expensive and may be turned off in future ACK runtime options.",
+	"ReferencesFieldConfig.APIVersion":                       "APIVersion is the API version of the referenced custom resource, e.g.\n\"v1alpha1\". Required when the referenced resource belongs to another\nservice. Defaults to the API version being generated otherwise.",
+	"ReferencesFieldConfig.Path":                             "Path is the field path of the identifier in the referenced custom\nresource, e.g. \"Status.SubnetID\"",
+	"ReferencesFieldConfig.Resource":                         "Resource is the Kind of the referenced custom resource, e.g. \"Subnet\"",
+	"ReferencesFieldConfig.ServiceName":                      "ServiceName is the service alias of the controller managing the\nreferenced custom resource, e.g. \"ec2\". Defaults to the service of the\nreferencing resource.",
+	"RenamesConfig.Operations":                               "Operations is a map, keyed by Operation ID, of instructions on how to\nhandle renamed fields in Input and Output shapes.",
+	"ResourceConfig.Compare":                                 "Compare contains instructions for the code generation to generate custom\ncomparison logic.",
+	"ResourceConfig.Delete":                                  "Delete instructs the code generator on how to delete a resource whose\ndeletion completes asynchronously",
+	"ResourceConfig.Exceptions":                              "Exceptions identifies the exception codes for the resource. Some API\nmodel files don't contain the ErrorInfo struct that contains the\nHTTPStatusCode attribute that we usually look for to identify 404 Not\nFound and other common error types for primary resources, and thus we\nneed these instructions.",
+	"ResourceConfig.Fields":                                  "Fields is a map, keyed by the field name, of instructions for how the\ncode generator should interpret and handle a particular field in the\nresource.",
+	"ResourceConfig.Hooks":                                   "Hooks is a map, keyed by the hook identifier, of instructions for the\nthe code generator about a custom callback hooks that should be injected\ninto the resource's manager or SDK binding code.",
+	"ResourceConfig.IsARNPrimaryKey":                         "IsARNPrimaryKey determines whether the CRD uses the ARN as the primary\nidentifier in the ReadOne operations.",
+	"ResourceConfig.IsAdoptable":                             "IsAdoptable determines whether the CRD should be accepted by the adoption reconciler.\nIf set to false, the user will be given an error if they attempt to adopt a resource\nwith this type.",
+	"ResourceConfig.ListOperation":                           "ListOperation contains instructions for the code generator to generate\nGo code that filters the results of a List operation looking for a\nsingular object. Certain AWS services (e.g. S3's ListBuckets API) have\nabsolutely no way to pass a filter to the operation. Instead, the List\noperation always returns ALL objects of that type.\n\nThe ListOperationConfig object enables us to inject some custom code to\nfilter the results of these List operations from within the generated\ncode in sdk.go's sdkFind().",
+	"ResourceConfig.Print":                                   "Print contains instructions for the code generator to generate kubebuilder printcolumns\nmarker comments.",
+	"ResourceConfig.Reconcile":                               "Reconcile describes options for controlling the reconciliation\nlogic for a particular resource.",
+	"ResourceConfig.Renames":                                 "Renames identifies fields in Operations that should be renamed.",
+	"ResourceConfig.ShortNames":                              "ShortNames represent the CRD list of aliases. Short names allow shorter strings to\nmatch a CR on the CLI.\nAll ShortNames must be distinct from any other ShortNames installed into the cluster,\notherwise the CRD will fail to install.",
+	"ResourceConfig.SubResources":                            "SubResources is a map, keyed by the name of a Spec field, of\ninstructions for the code generator about groups of operations managing\na part of the resource's configuration",
+	"ResourceConfig.Synced":                                  "Synced instructs the code generator to set the ACK.ResourceSynced\ncondition of the resource from the values of its Status fields",
+	"ResourceConfig.Tags":                                    "Tags instructs the code generator to normalize the resource's tags and\nto synchronize them with the tagging operations of the API",
+	"ResourceConfig.UnpackAttributesMapConfig":               "UnpackAttributeMapConfig contains instructions for converting a raw\n`map[string]*string` into real fields on a CRD's Spec or Status object",
+	"ResourceConfig.UpdateConditionsCustomMethodName":        "UpdateConditionsCustomMethodName provides the name of the custom method on the\n`resourceManager` struct that will set Conditions on a `resource` struct\ndepending on the status of the resource.",
+	"ResourceConfig.UpdateOperation":                         "UpdateOperation contains instructions for the code generator to generate\nGo code for the update operation for the resource. For some APIs, the\nway that a resource's attributes are updated after creation is, well,\nvery odd. Some APIs have separate API calls for each attribute or set of\nrelated attributes of the resource. For example, the ECR API has\nseparate API calls for PutImageScanningConfiguration,\nPutImageTagMutability, PutLifecyclePolicy and SetRepositoryPolicy. FOr\nthese APIs, we basically need to revert to custom code because there's\nvery little consistency to the APIs that we can use to instruct the code\ngenerator :(",
+	"ResourceConfig.Waiter":                                  "Waiter instructs the code generator on which aws-sdk-go waiter tells\nwhether the resource is ready",
+	"SourceFieldConfig.Operation":                            "Operation refers to the ID of the API Operation where we will\ndetermine the field's Go type.",
+	"SourceFieldConfig.Path":                                 "Path refers to the field path of the member of the Input or Output\nshape in the Operation identified by OperationID that we will take as\nour additional spec/status field's value.",
+	"SubResourceConfig.DeleteOperation":                      "DeleteOperation is the ID of the operation removing the sub-resource.\nWhen empty, the sub-resource isn't removed when the field is unset.",
+	"SubResourceConfig.GetOperation":                         "GetOperation is the ID of the operation reading the sub-resource",
+	"SubResourceConfig.Member":                               "Member is the name of the put operation's input shape member containing\nthe sub-resource",
+	"SubResourceConfig.PutOperation":                         "PutOperation is the ID of the operation setting the sub-resource",
+	"SubResourceConfig.UnsetErrorCodes":                      "UnsetErrorCodes are the codes of the errors the get operation returns\nwhen the sub-resource isn't set, e.g. \"NoSuchBucketPolicy\"",
+	"SyncedConfig.When":                                      "When lists the rules that must all be met for the resource to be synced",
+	"SyncedRule.InProgress":                                  "InProgress lists the values for which the resource is still being\nchanged. When empty, all the values that are neither ready nor terminal\nare considered in progress. Otherwise other values set the\nACK.ResourceSynced condition to Unknown.",
+	"SyncedRule.Path":                                        "Path is the path of the field, e.g. \"Status.DBInstanceStatus\"",
+	"SyncedRule.Ready":                                       "Ready lists the values for which the resource is synced",
+	"SyncedRule.Terminal":                                    "Terminal lists the values for which the resource failed and won't\nrecover without changes",
+	"TagsConfig.KeyMemberName":                               "KeyMemberName is the name of the member containing the key of a tag,\nwhen the tags are a list of structures. Defaults to \"Key\".",
+	"TagsConfig.ListTagsOperation":                           "ListTagsOperation is the name of the operation returning the tags of\nthe resource",
+	"TagsConfig.Path":                                        "Path is the name of the Spec field containing the tags. Defaults to\n\"Tags\".",
+	"TagsConfig.TagOperation":                                "TagOperation is the name of the operation adding or overwriting tags",
+	"TagsConfig.UntagOperation":                              "UntagOperation is the name of the operation removing tags by key",
+	"TagsConfig.ValueMemberName":                             "ValueMemberName is the name of the member containing the value of a\ntag, when the tags are a list of structures. Defaults to \"Value\".",
+	"UnpackAttributesMapConfig.GetAttributesInput":           "GetAttributesInput instructs the code generator how to handle the\nGetAttributes input shape",
+	"UnpackAttributesMapConfig.SetAttributesSingleAttribute": "SetAttributesSingleAttribute indicates that the SetAttributes API call\ndoesn't actually set multiple attributes but rather must be called\nmultiple times, once for each attribute that needs to change. See SNS\nSetTopicAttributes API call, which can be compared to the \"normal\" SNS\nSetPlatformApplicationAttributes API call which accepts multiple\nattributes and replaces the supplied attributes map key/values...",
+	"UpdateOperationConfig.CustomMethodName":                 "CustomMethodName is a string for the method name to replace the\nsdkUpdate() method implementation for this resource",
+	"ValidationError.File":                                   "File is the path of the generator config file",
+	"ValidationError.Line":                                   "Line and Column of the offending key in the generator config file.\nBoth are zero when the position is unknown.",
+	"ValidationError.Message":                                "Message describes the problem",
+	"ValidationError.Path":                                   "Path is the list of keys leading to the offending key, e.g.\n[\"resources\", \"Repository\", \"fields\", \"Name\"]",
+	"ValidationError.Warning":                                "Warning is true for problems that don't prevent the code generator from\nrunning, such as ignore rules that match nothing.",
+	"ValidationFieldConfig.Enum":                             "Enum overrides the values a string field accepts",
+	"ValidationFieldConfig.Ignore":                           "Ignore contains the names of the constraints derived from the API model\nto suppress. See SupportedValidationConstraints.",
+	"ValidationFieldConfig.IsIgnored":                        "IsIgnored suppresses all the constraints derived from the API model.\nThe constraints set in this config are still used.",
+	"ValidationFieldConfig.MaxItems":                         "MaxItems overrides the maximum number of items of a list field",
+	"ValidationFieldConfig.MaxLength":                        "MaxLength overrides the maximum length of a string field",
+	"ValidationFieldConfig.Maximum":                          "Maximum overrides the maximum value of a number field",
+	"ValidationFieldConfig.MinItems":                         "MinItems overrides the minimum number of items of a list field",
+	"ValidationFieldConfig.MinLength":                        "MinLength overrides the minimum length of a string field",
+	"ValidationFieldConfig.Minimum":                          "Minimum overrides the minimum value of a number field",
+	"ValidationFieldConfig.Pattern":                          "Pattern overrides the regular expression a string field must match",
+	"WaiterConfig.IsIgnored":                                 "IsIgnored instructs the code generator not to use any waiter for the\nresource",
+	"WaiterConfig.Name":                                      "Name is the name of the waiter, e.g. \"DBInstanceAvailable\"",
+}
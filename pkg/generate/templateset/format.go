@@ -0,0 +1,313 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package templateset
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	ttpl "text/template"
+	"text/template/parse"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FormatError describes the output of a template that couldn't be formatted,
+// usually because the template produced invalid Go code
+type FormatError struct {
+	// Line is the line of the output at which the error was found
+	Line int
+	// Code is the content of that line of the output
+	Code string
+	// TemplatePath is the path of the template file that produced the line,
+	// if it could be found
+	TemplatePath string
+	// TemplateLine is the line of the template file that produced the line
+	TemplateLine int
+	// Err is the error returned by the formatter
+	Err error
+}
+
+// Error returns the formatter error along with the location of the offending
+// line in the output and, if known, in the template
+func (e *FormatError) Error() string {
+	if e.TemplatePath != "" {
+		return fmt.Sprintf(
+			"%s:%d: %v (output line %d: %q)",
+			e.TemplatePath, e.TemplateLine, e.Err, e.Line, e.Code,
+		)
+	}
+	return fmt.Sprintf("output line %d: %v: %q", e.Line, e.Err, e.Code)
+}
+
+// Unwrap returns the formatter error
+func (e *FormatError) Unwrap() error {
+	return e.Err
+}
+
+// formatOutput formats the output of a template according to the output path
+// extension:
+//
+// - Go files are formatted with go/format and their unused imports removed
+// - YAML files are re-encoded with a two-space indentation, unless they are
+//   Helm chart templates or don't parse
+//
+// Other files are returned as is.
+func formatOutput(
+	outPath string,
+	t *ttpl.Template,
+	b *bytes.Buffer,
+) (*bytes.Buffer, error) {
+	switch filepath.Ext(outPath) {
+	case ".go":
+		formatted, err := formatGo(b.Bytes())
+		if err != nil {
+			return nil, formatErrorFor(t, b.Bytes(), err)
+		}
+		return bytes.NewBuffer(formatted), nil
+	case ".yaml", ".yml":
+		// Helm template actions can parse as YAML flow mappings, which the
+		// encoder would rewrite
+		if bytes.Contains(b.Bytes(), []byte("{{")) {
+			return b, nil
+		}
+		if formatted, ok := formatYAML(b.Bytes()); ok {
+			return bytes.NewBuffer(formatted), nil
+		}
+	}
+	return b, nil
+}
+
+// formatGo removes the unused imports of the supplied Go source and formats
+// it with go/format
+func formatGo(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if !pruneImports(f) {
+		return format.Source(src)
+	}
+	var b bytes.Buffer
+	if err = format.Node(&b, fset, f); err != nil {
+		return nil, err
+	}
+	// Formatting again removes the blank lines left by the pruned imports
+	return format.Source(b.Bytes())
+}
+
+// pruneImports removes the imports of the supplied file whose package isn't
+// referenced by any selector expression, and returns whether any import was
+// removed. Blank and dot imports are always kept.
+func pruneImports(f *ast.File) bool {
+	used := map[string]bool{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+	pruned := false
+	decls := f.Decls[:0]
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			decls = append(decls, decl)
+			continue
+		}
+		specs := gen.Specs[:0]
+		for _, spec := range gen.Specs {
+			imp := spec.(*ast.ImportSpec)
+			if importUsed(imp, used) {
+				specs = append(specs, spec)
+				continue
+			}
+			pruned = true
+		}
+		gen.Specs = specs
+		if len(gen.Specs) > 0 {
+			decls = append(decls, gen)
+		}
+	}
+	f.Decls = decls
+	if pruned {
+		imports := f.Imports[:0]
+		for _, imp := range f.Imports {
+			if importUsed(imp, used) {
+				imports = append(imports, imp)
+			}
+		}
+		f.Imports = imports
+	}
+	return pruned
+}
+
+// importUsed returns whether the package of the supplied import is referenced.
+// Without an explicit name, the package name can only be guessed from the
+// import path, so the import is kept if either the last element of the path
+// or the name that goimports would assume is referenced.
+func importUsed(imp *ast.ImportSpec, used map[string]bool) bool {
+	if imp.Name != nil {
+		return imp.Name.Name == "_" || imp.Name.Name == "." ||
+			used[imp.Name.Name]
+	}
+	importPath, err := strconv.Unquote(imp.Path.Value)
+	if err != nil {
+		return true
+	}
+	base := path.Base(importPath)
+	if used[base] {
+		return true
+	}
+	// "github.com/foo/go-bar/v2" is assumed to be package "bar"
+	assumed := base
+	if strings.HasPrefix(assumed, "v") {
+		if _, err := strconv.Atoi(assumed[1:]); err == nil {
+			assumed = path.Base(path.Dir(importPath))
+		}
+	}
+	assumed = strings.TrimPrefix(assumed, "go-")
+	if i := strings.IndexFunc(assumed, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}); i >= 0 {
+		assumed = assumed[:i]
+	}
+	return used[assumed]
+}
+
+// formatYAML re-encodes every document of the supplied YAML with a two-space
+// indentation, keeping comments and the order of keys. It returns false if
+// the supplied YAML can't be parsed.
+func formatYAML(src []byte) ([]byte, bool) {
+	dec := yaml.NewDecoder(bytes.NewReader(src))
+	var b bytes.Buffer
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(2)
+	for {
+		var doc yaml.Node
+		err := dec.Decode(&doc)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, false
+		}
+		if err = enc.Encode(&doc); err != nil {
+			return nil, false
+		}
+	}
+	if err := enc.Close(); err != nil {
+		return nil, false
+	}
+	return b.Bytes(), true
+}
+
+// formatErrorFor returns a FormatError locating the line of the supplied
+// output at which the formatter failed, and the template line that
+// produced it.
+func formatErrorFor(t *ttpl.Template, src []byte, err error) error {
+	line := 0
+	msg := err
+	if list, ok := err.(scanner.ErrorList); ok && len(list) > 0 {
+		line = list[0].Pos.Line
+		msg = fmt.Errorf("%s", list[0].Msg)
+	}
+	fe := &FormatError{Line: line, Err: msg}
+	lines := strings.Split(string(src), "\n")
+	if line < 1 || line > len(lines) {
+		return fe
+	}
+	fe.Code = strings.TrimSpace(lines[line-1])
+	fe.TemplatePath, fe.TemplateLine = templateLineOf(t, fe.Code)
+	return fe
+}
+
+// templateLineOf returns the template file and line whose text is the
+// supplied line of output, or an empty path if the line wasn't produced by
+// the text of a single template line, e.g. if it comes from a template
+// function.
+func templateLineOf(t *ttpl.Template, code string) (string, int) {
+	if code == "" {
+		return "", 0
+	}
+	for _, tmpl := range t.Templates() {
+		if tmpl.Tree == nil || tmpl.Tree.Root == nil {
+			continue
+		}
+		var found *parse.TextNode
+		var offset int
+		walkTextNodes(tmpl.Tree.Root, func(n *parse.TextNode) bool {
+			for i, l := range strings.Split(string(n.Text), "\n") {
+				if strings.TrimSpace(l) == code {
+					found, offset = n, i
+					return false
+				}
+			}
+			return true
+		})
+		if found == nil {
+			continue
+		}
+		// ErrorContext returns "path:line:col" of the start of the node
+		location, _ := tmpl.Tree.ErrorContext(found)
+		parts := strings.Split(location, ":")
+		if len(parts) < 3 {
+			continue
+		}
+		line, err := strconv.Atoi(parts[len(parts)-2])
+		if err != nil {
+			continue
+		}
+		return strings.Join(parts[:len(parts)-2], ":"), line + offset
+	}
+	return "", 0
+}
+
+// walkTextNodes calls fn for each text node found under the supplied node,
+// until fn returns false. It returns false if the walk was stopped.
+func walkTextNodes(node parse.Node, fn func(*parse.TextNode) bool) bool {
+	switch n := node.(type) {
+	case *parse.TextNode:
+		return fn(n)
+	case *parse.ListNode:
+		if n == nil {
+			return true
+		}
+		for _, child := range n.Nodes {
+			if !walkTextNodes(child, fn) {
+				return false
+			}
+		}
+	case *parse.IfNode:
+		return walkTextNodes(n.List, fn) && walkTextNodes(n.ElseList, fn)
+	case *parse.RangeNode:
+		return walkTextNodes(n.List, fn) && walkTextNodes(n.ElseList, fn)
+	case *parse.WithNode:
+		return walkTextNodes(n.List, fn) && walkTextNodes(n.ElseList, fn)
+	}
+	return true
+}
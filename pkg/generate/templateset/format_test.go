@@ -0,0 +1,165 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package templateset_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	ttpl "text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
+)
+
+// newTestTemplateSet returns a TemplateSet reading the supplied template
+// files, keyed by path, from a temporary directory. Templates whose path
+// starts with "include/" are included in all the others.
+func newTestTemplateSet(
+	t *testing.T,
+	files map[string]string,
+) (*templateset.TemplateSet, string) {
+	dir, err := ioutil.TempDir("", "templateset")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	includePaths := []string{}
+	for path, contents := range files {
+		fullPath := filepath.Join(dir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), os.ModePerm))
+		require.NoError(t, ioutil.WriteFile(fullPath, []byte(contents), 0666))
+		if filepath.Dir(path) == "include" {
+			includePaths = append(includePaths, path)
+		}
+	}
+	return templateset.New([]string{dir}, includePaths, nil, ttpl.FuncMap{}), dir
+}
+
+func TestExecute_FormatsGoOutput(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	ts, _ := newTestTemplateSet(t, map[string]string{
+		"book.go.tpl": `package {{ .Package }}
+
+import (
+	"fmt"
+	"strings"
+)
+
+func   Title(s string) string {
+		return strings.Title(s)
+}
+`,
+	})
+	require.NoError(ts.Add("book.go", "book.go.tpl", map[string]string{"Package": "book"}))
+	require.NoError(ts.Execute())
+
+	expected := `package book
+
+import (
+	"strings"
+)
+
+func Title(s string) string {
+	return strings.Title(s)
+}
+`
+	assert.Equal(expected, ts.Executed()["book.go"].String())
+}
+
+func TestExecute_ReportsInvalidGoOutput(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	ts, dir := newTestTemplateSet(t, map[string]string{
+		"book.go.tpl": `package book
+
+{{ template "title" }}
+
+func Author() string {
+	return "author" +
+}
+`,
+		"include/title.go.tpl": `{{- define "title" -}}
+func Title() string {
+	return "title"
+}
+{{- end -}}
+`,
+		"chapter.go.tpl": `package book
+{{ template "title" }}
+func (c *Chapter) {
+}
+`,
+	})
+	require.NoError(ts.Add("book.go", "book.go.tpl", nil))
+	require.NoError(ts.Add("chapter.go", "chapter.go.tpl", nil))
+	err := ts.Execute()
+	require.Error(err)
+
+	var execErrs templateset.ExecuteErrors
+	require.True(errors.As(err, &execErrs))
+	require.Len(execErrs, 2)
+
+	var formatErr *templateset.FormatError
+	assert.Equal("book.go", execErrs[0].Path)
+	require.True(errors.As(execErrs[0], &formatErr))
+	assert.Equal(filepath.Join(dir, "book.go.tpl"), formatErr.TemplatePath)
+	assert.Equal(7, formatErr.TemplateLine)
+	assert.Equal(9, formatErr.Line)
+	assert.Equal("}", formatErr.Code)
+
+	assert.Equal("chapter.go", execErrs[1].Path)
+	require.True(errors.As(execErrs[1], &formatErr))
+	assert.Equal(filepath.Join(dir, "chapter.go.tpl"), formatErr.TemplatePath)
+	assert.Equal(3, formatErr.TemplateLine)
+	assert.Equal("func (c *Chapter) {", formatErr.Code)
+}
+
+func TestExecute_FormatsYAMLOutput(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	ts, _ := newTestTemplateSet(t, map[string]string{
+		"role.yaml.tpl": `# The role of the controller
+kind:    ClusterRole
+rules:
+- apiGroups:
+    - {{ .Group }}
+---
+kind: ServiceAccount
+`,
+		"values.yaml.tpl": `image: {{"{{"}} .Values.image {{"}}"}}
+`,
+	})
+	require.NoError(ts.Add("role.yaml", "role.yaml.tpl", map[string]string{"Group": "books.services.k8s.aws"}))
+	require.NoError(ts.Add("values.yaml", "values.yaml.tpl", nil))
+	require.NoError(ts.Execute())
+
+	expected := `# The role of the controller
+kind: ClusterRole
+rules:
+  - apiGroups:
+      - books.services.k8s.aws
+---
+kind: ServiceAccount
+`
+	assert.Equal(expected, ts.Executed()["role.yaml"].String())
+	// Helm templates are left as is
+	assert.Equal("image: {{ .Values.image }}\n", ts.Executed()["values.yaml"].String())
+}
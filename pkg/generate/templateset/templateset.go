@@ -19,6 +19,9 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	ttpl "text/template"
 
 	"github.com/pkg/errors"
@@ -40,6 +43,45 @@ func errTemplateNotFound(templatePath string) error {
 	)
 }
 
+// ExecuteError describes a failure to execute the template of an output file
+type ExecuteError struct {
+	// Path is the output path of the template
+	Path string
+	// Err is the error returned by the template execution
+	Err error
+}
+
+// Error returns the template execution error prefixed by the output path
+func (e *ExecuteError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+// Unwrap returns the template execution error
+func (e *ExecuteError) Unwrap() error {
+	return e.Err
+}
+
+// ExecuteErrors is the list of templates that failed to execute, sorted by
+// output path
+type ExecuteErrors []*ExecuteError
+
+// Error returns all the template execution errors, one per line
+func (e ExecuteErrors) Error() string {
+	lines := make([]string, 0, len(e))
+	for _, err := range e {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// parsedTemplates caches the templates parsed by all TemplateSets, so that
+// generating the code of several services in the same process parses each
+// template file only once
+var parsedTemplates = struct {
+	sync.Mutex
+	m map[string]*ttpl.Template
+}{m: map[string]*ttpl.Template{}}
+
 // templateWithVars contains a template and the variables injected during execution
 type templateWithVars struct {
 	t *ttpl.Template
@@ -95,21 +137,65 @@ func (ts *TemplateSet) Add(
 		return errTemplateNotFound(templatePath)
 	}
 
-	tplContents, err := ioutil.ReadFile(foundPath)
+	t, err := ts.parse(foundPath)
 	if err != nil {
 		return err
 	}
-	t := ttpl.New(foundPath)
+	ts.templates[outPath] = templateWithVars{t, vars}
+	return nil
+}
+
+// parse returns the template parsed from the supplied file, joined with all
+// include templates and bound to the TemplateSet's functions. The parsed
+// templates are cached and shared by all TemplateSets.
+func (ts *TemplateSet) parse(path string) (*ttpl.Template, error) {
+	funcNames := make([]string, 0, len(ts.funcMap))
+	for name := range ts.funcMap {
+		funcNames = append(funcNames, name)
+	}
+	sort.Strings(funcNames)
+	// Templates can only be shared by TemplateSets defining the same
+	// functions, since the cached template keeps the functions it was parsed
+	// with until they are replaced.
+	key := strings.Join([]string{
+		path,
+		strings.Join(ts.baseSearchPaths, ","),
+		strings.Join(ts.includePaths, ","),
+		strings.Join(funcNames, ","),
+	}, "\n")
+
+	parsedTemplates.Lock()
+	cached, ok := parsedTemplates.m[key]
+	parsedTemplates.Unlock()
+	if ok {
+		t, err := cached.Clone()
+		if err != nil {
+			return nil, err
+		}
+		return t.Funcs(ts.funcMap), nil
+	}
+
+	tplContents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	t := ttpl.New(path)
 	t = t.Funcs(ts.funcMap)
 	t, err = t.Parse(string(tplContents))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if err = ts.joinIncludes(t); err != nil {
-		return err
+		return nil, err
 	}
-	ts.templates[outPath] = templateWithVars{t, vars}
-	return nil
+	cached, err = t.Clone()
+	if err != nil {
+		return nil, err
+	}
+	parsedTemplates.Lock()
+	parsedTemplates.m[key] = cached
+	parsedTemplates.Unlock()
+	return t, nil
 }
 
 // joinIncludes adds all include templates to the supplied template
@@ -130,16 +216,37 @@ func (ts *TemplateSet) joinIncludes(t *ttpl.Template) error {
 }
 
 // Execute runs all of the template and copy files in our TemplateSet and
-// returns whether any error occurred executing any of the templates. Once
+// returns whether any error occurred executing any of the templates. The
+// output of each template is then formatted according to its extension: Go
+// code is run through go/format and pruned of its unused imports, and YAML
+// manifests are normalized. A failing template, including one producing Go
+// code that doesn't parse, doesn't stop the execution of the others: the
+// errors of all the failing templates are returned as `ExecuteErrors`. Once
 // Execute() is run, `TemplateSet.Executed()` can be used to iterate over a set
 // of byte buffers containing the output of executed templates
 func (ts *TemplateSet) Execute() error {
-	for path, tv := range ts.templates {
+	paths := make([]string, 0, len(ts.templates))
+	for path := range ts.templates {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	errs := ExecuteErrors{}
+	for _, path := range paths {
+		tv := ts.templates[path]
 		var b bytes.Buffer
 		if err := tv.t.Execute(&b, tv.v); err != nil {
-			return err
+			errs = append(errs, &ExecuteError{Path: path, Err: err})
+			continue
 		}
-		ts.executed[path] = &b
+		formatted, err := formatOutput(path, tv.t, &b)
+		if err != nil {
+			errs = append(errs, &ExecuteError{Path: path, Err: err})
+			continue
+		}
+		ts.executed[path] = formatted
+	}
+	if len(errs) > 0 {
+		return errs
 	}
 	for _, basePath := range ts.baseSearchPaths {
 		for _, path := range ts.copyPaths {
@@ -191,7 +298,9 @@ func includeTemplate(t *ttpl.Template, tplPath string) (*ttpl.Template, error) {
 	if err != nil {
 		return nil, err
 	}
-	if t, err = t.Parse(string(tplContents)); err != nil {
+	// Parsing the include file in a template of its own name keeps track of
+	// the file its templates are defined in, for error messages
+	if _, err = t.New(tplPath).Parse(string(tplContents)); err != nil {
 		return nil, err
 	}
 	return t, nil
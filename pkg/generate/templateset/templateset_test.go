@@ -0,0 +1,73 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package templateset_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	ttpl "text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/generate/templateset"
+)
+
+func TestAdd_SharesParsedTemplates(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "templateset")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	require.NoError(ioutil.WriteFile(
+		filepath.Join(dir, "name.txt.tpl"),
+		[]byte(`{{ Name .Service }}`),
+		0666,
+	))
+
+	// TemplateSets defining the same functions share the parsed template but
+	// execute it with their own functions
+	prefixes := []string{"a", "b", "c", "d"}
+	sets := make([]*templateset.TemplateSet, len(prefixes))
+	var wg sync.WaitGroup
+	for i, prefix := range prefixes {
+		prefix := prefix
+		sets[i] = templateset.New([]string{dir}, nil, nil, ttpl.FuncMap{
+			"Name": func(s string) string { return prefix + "-" + s },
+		})
+		wg.Add(1)
+		go func(ts *templateset.TemplateSet) {
+			defer wg.Done()
+			require.NoError(ts.Add("name.txt", "name.txt.tpl", map[string]string{"Service": "ecr"}))
+			require.NoError(ts.Execute())
+		}(sets[i])
+	}
+	wg.Wait()
+	for i, prefix := range prefixes {
+		assert.Equal(prefix+"-ecr", sets[i].Executed()["name.txt"].String())
+	}
+
+	// The template is parsed again for a TemplateSet defining other functions
+	ts := templateset.New([]string{dir}, nil, nil, ttpl.FuncMap{
+		"Name":  func(s string) string { return "e-" + s },
+		"Other": func() string { return "" },
+	})
+	require.NoError(ts.Add("name.txt", "name.txt.tpl", map[string]string{"Service": "ecr"}))
+	require.NoError(ts.Execute())
+	assert.Equal("e-ecr", ts.Executed()["name.txt"].String())
+}
@@ -40,17 +40,16 @@ const (
 	UpdateReasonAPIGeneration UpdateReason = "API generation"
 
 	// UpdateReasonConversionFunctionsGeneration Should be used when
-	// an API package is modified by conversion functions generator.
-	// TODO(hilalymh) ack-generate conversion-functions
+	// an API package is modified by conversion functions generator
+	// (ack-generate conversion-functions).
 	UpdateReasonConversionFunctionsGeneration UpdateReason = "Conversion functions generation"
 )
 
 // GenerationMetadata represents the parameters used to generate/update the
 // API version directory.
 //
-// This type is public because soon it will be used by conversion generators
-// to load APIs generation metadata.
-// TODO(hilalymh) Add functions to load/edit metadata files.
+// This type is public because it is used by the conversion functions
+// generator to load APIs generation metadata.
 type GenerationMetadata struct {
 	// The APIs version e.g v1alpha2
 	APIVersion string `json:"api_version"`
@@ -142,6 +141,24 @@ func CreateGenerationMetadata(
 	return nil
 }
 
+// LoadGenerationMetadata reads the generation metadata file stored in a given
+// API version directory.
+func LoadGenerationMetadata(
+	apisPath string,
+	apiVersion string,
+) (*GenerationMetadata, error) {
+	filePath := filepath.Join(apisPath, apiVersion, outputFileName)
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	generationMetadata := &GenerationMetadata{}
+	if err = yaml.Unmarshal(content, generationMetadata); err != nil {
+		return nil, err
+	}
+	return generationMetadata, nil
+}
+
 // hashDirectoryContent returns the sha1 checksum of a given directory. It will walk
 // the file tree of a directory and combine and the file contents before hashing it.
 func hashDirectoryContent(directory string) (string, error) {
@@ -23,6 +23,12 @@ type Attr struct {
 	Names  names.Names
 	GoType string
 	Shape  *awssdkmodel.Shape
+	// Validation contains the OpenAPI validation constraints derived from the
+	// shape's constraints in the API model
+	Validation *FieldValidation
+	// Default is the value the AWS service gives the attribute when it is
+	// left unset, if configured on a nested field
+	Default *FieldDefault
 }
 
 func NewAttr(
@@ -36,3 +42,18 @@ func NewAttr(
 		Shape:  shape,
 	}
 }
+
+// ValidationMarkers returns the `+kubebuilder:validation` markers of the
+// attribute's OpenAPI validation constraints
+func (a *Attr) ValidationMarkers() []string {
+	return restrictValidationToGoType(a.Validation, a.GoType).Markers()
+}
+
+// DefaultMarker returns the `+kubebuilder:default` marker of the attribute, or
+// an empty string if the attribute has no literal default value
+func (a *Attr) DefaultMarker() string {
+	if a.Default == nil || a.Default.IsComputed() {
+		return ""
+	}
+	return defaultMarker(a.Default.Value)
+}
@@ -0,0 +1,417 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
+)
+
+// ChildField describes a list field of a resource containing the identifiers
+// of children that are added to and removed from the resource with dedicated
+// API operations, e.g. the IAM Roles associated with an RDS DBCluster
+type ChildField struct {
+	// Field is the Spec field containing the desired child identifiers
+	Field *Field
+	// AddOp is the operation adding a child, or a list of children
+	AddOp *ChildOperation
+	// RemoveOp is the operation removing a child, or a list of children
+	RemoveOp *ChildOperation
+	// ListOp is the operation returning the children of the resource, or nil
+	// if the children are read from the Status of the resource
+	ListOp *ChildOperation
+	// Path contains the member names leading to the child identifiers in the
+	// output shape of ListOp or, when ListOp is nil, in the Status of the
+	// resource
+	Path []string
+}
+
+// ParentIsNil returns the Go condition, for the supplied CR variable, that is
+// true when a value identifying the resource in the add or remove operation
+// isn't known yet, or an empty string if these operations have no member
+// identifying the resource
+func (c *ChildField) ParentIsNil(koVarName string) string {
+	return parentIsNil(koVarName, c.AddOp, c.RemoveOp)
+}
+
+// parentIsNil returns the Go condition that is true when any of the values
+// identifying the resource in the supplied operations is nil
+func parentIsNil(koVarName string, ops ...*ChildOperation) string {
+	conds := []string{}
+	for _, op := range ops {
+		for _, member := range op.ParentMembers {
+			cond := member.VarIsNil(koVarName)
+			if !util.InStrings(cond, conds) {
+				conds = append(conds, cond)
+			}
+		}
+	}
+	return strings.Join(conds, " || ")
+}
+
+// ChildOperation describes an operation adding, removing or listing the
+// children of a resource, or an operation of a sub-resource
+type ChildOperation struct {
+	Operation *awssdkmodel.Operation
+	// MemberName is the name of the input shape member containing the child
+	// identifier(s). It is empty for the list operation.
+	MemberName string
+	// IsBatch is true when MemberName contains a list of child identifiers
+	IsBatch bool
+	// ParentMembers are the required input shape members identifying the
+	// resource
+	ParentMembers []*ParentMember
+}
+
+// ParentIsNil returns the Go condition, for the supplied CR variable, that is
+// true when a value identifying the resource isn't known yet, or an empty
+// string if the operation has no member identifying the resource
+func (op *ChildOperation) ParentIsNil(koVarName string) string {
+	return parentIsNil(koVarName, op)
+}
+
+// ParentMember is a member of an operation's input shape identifying a
+// resource
+type ParentMember struct {
+	// MemberName is the name of the input shape member
+	MemberName string
+	// fieldPath is the path of the CR field containing the member's value,
+	// e.g. "Spec.DBClusterIdentifier", or empty if the member contains the
+	// ARN of the resource
+	fieldPath string
+}
+
+// VarPath returns the Go expression of the member's value in the supplied CR
+// variable, e.g. `ko.Spec.DBClusterIdentifier`
+func (m *ParentMember) VarPath(koVarName string) string {
+	return identifierVarPath(koVarName, m.fieldPath)
+}
+
+// VarIsNil returns the Go condition, for the supplied CR variable, that is
+// true when the member's value isn't known yet
+func (m *ParentMember) VarIsNil(koVarName string) string {
+	return identifierVarIsNil(koVarName, m.fieldPath)
+}
+
+// ChildFields returns the fields of the resource reconciled with the
+// operations adding and removing children, sorted by field name
+func (r *CRD) ChildFields() []*ChildField {
+	return r.childFields
+}
+
+// HasChildFields returns true if the resource has fields reconciled with the
+// operations adding and removing children
+func (r *CRD) HasChildFields() bool {
+	return len(r.childFields) > 0
+}
+
+// SyncedFieldPaths returns the paths of the Spec fields that are synchronized
+// with dedicated API operations rather than with the Update operation, e.g.
+// "Spec.Tags"
+func (r *CRD) SyncedFieldPaths() []string {
+	res := []string{}
+	if tagging := r.Tagging(); tagging != nil && tagging.IsNormalized &&
+		(tagging.TagOp != nil || tagging.UntagOp != nil) {
+		res = append(res, "Spec."+tagging.Field.Names.Camel)
+	}
+	for _, child := range r.childFields {
+		res = append(res, "Spec."+child.Field.Names.Camel)
+	}
+	for _, sub := range r.subResources {
+		res = append(res, "Spec."+sub.Field.Names.Camel)
+	}
+	return res
+}
+
+// addChildFields resolves the operations and paths of the fields with a
+// `child` config, adding the fields to the Spec if they aren't members of the
+// Create operation's input shape. It returns the problems found in the
+// `child` configs.
+func (r *CRD) addChildFields() ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
+	fieldNames := []string{}
+	for fieldName, fieldConfig := range r.cfg.ResourceFields(r.Names.Original) {
+		if fieldConfig.Child != nil {
+			fieldNames = append(fieldNames, fieldName)
+		}
+	}
+	sort.Strings(fieldNames)
+	for _, fieldName := range fieldNames {
+		child, err := r.newChildField(fieldName)
+		if err != nil {
+			errs = append(errs, r.newConfigError(
+				[]string{"fields", fieldName, "child"}, "%v", err,
+			))
+			continue
+		}
+		r.childFields = append(r.childFields, child)
+	}
+	return errs
+}
+
+// newChildField returns the description of the child field with the supplied
+// name
+func (r *CRD) newChildField(fieldName string) (*ChildField, error) {
+	cfg := r.cfg.ResourceFields(r.Names.Original)[fieldName].Child
+	addOp, err := r.childOperation(
+		cfg.AddOperation, r.Ops.AddChild, r.Ops.AddChildren, "add",
+	)
+	if err != nil {
+		return nil, err
+	}
+	removeOp, err := r.childOperation(
+		cfg.RemoveOperation, r.Ops.RemoveChild, r.Ops.RemoveChildren, "remove",
+	)
+	if err != nil {
+		return nil, err
+	}
+	res := &ChildField{}
+	if res.AddOp, err = r.newChildOperation(addOp, cfg.MemberName, true); err != nil {
+		return nil, err
+	}
+	if res.RemoveOp, err = r.newChildOperation(removeOp, cfg.MemberName, true); err != nil {
+		return nil, err
+	}
+
+	if _, found := r.StatusFields[fieldName]; found {
+		return nil, fmt.Errorf("a Status field has the same name")
+	}
+	field, found := r.SpecFields[fieldName]
+	if !found {
+		r.AddSpecField(names.New(fieldName), r.childListShapeRef(res.AddOp))
+		field = r.SpecFields[fieldName]
+	}
+	if field.GoType != "[]*string" {
+		return nil, fmt.Errorf(
+			"field has type %s: only list of strings fields can contain children",
+			field.GoType,
+		)
+	}
+	res.Field = field
+
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("missing path")
+	}
+	res.Path = strings.Split(cfg.Path, ".")
+	var shapeRef *awssdkmodel.ShapeRef
+	if cfg.ListOperation != "" {
+		listOp, found := r.sdkAPI.API.Operations[cfg.ListOperation]
+		if !found {
+			return nil, fmt.Errorf("unknown operation %s", cfg.ListOperation)
+		}
+		if res.ListOp, err = r.newChildOperation(listOp, "", false); err != nil {
+			return nil, err
+		}
+		shapeRef, found = listOp.OutputRef.Shape.MemberRefs[res.Path[0]]
+		if !found {
+			return nil, fmt.Errorf(
+				"unknown member %s in the output shape of %s",
+				res.Path[0], listOp.Name,
+			)
+		}
+	} else {
+		statusField, found := r.StatusFields[res.Path[0]]
+		if !found {
+			return nil, fmt.Errorf("unknown Status field %s", res.Path[0])
+		}
+		shapeRef = statusField.ShapeRef
+	}
+	if err := checkChildPath(shapeRef, res.Path); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// childOperation returns the operation with the supplied name or, if the name
+// is empty, the resource's operation adding or removing one or several
+// children
+func (r *CRD) childOperation(
+	name string,
+	singleOp *awssdkmodel.Operation,
+	batchOp *awssdkmodel.Operation,
+	kind string,
+) (*awssdkmodel.Operation, error) {
+	if name != "" {
+		op, found := r.sdkAPI.API.Operations[name]
+		if !found {
+			return nil, fmt.Errorf("unknown operation %s", name)
+		}
+		return op, nil
+	}
+	if singleOp != nil {
+		return singleOp, nil
+	}
+	if batchOp != nil {
+		return batchOp, nil
+	}
+	return nil, fmt.Errorf(
+		"resource has no %s operation, set %s_operation", kind, kind,
+	)
+}
+
+// newChildOperation returns the description of an operation adding, removing
+// or listing children. The members of the operation's input shape identifying
+// the resource are resolved to CR fields. When isChildOp is true, the member
+// containing the child identifier(s) is the supplied member, or the only
+// required member that isn't a CR field.
+func (r *CRD) newChildOperation(
+	op *awssdkmodel.Operation,
+	memberName string,
+	isChildOp bool,
+) (*ChildOperation, error) {
+	inputShape := op.InputRef.Shape
+	if inputShape == nil {
+		return nil, ErrNilShapePointer
+	}
+	res := &ChildOperation{Operation: op, MemberName: memberName}
+	unresolved := []string{}
+	for _, name := range inputShape.MemberNames() {
+		if !inputShape.IsRequired(name) || name == memberName {
+			continue
+		}
+		if path, found := r.memberFieldPath(name, op); found {
+			res.ParentMembers = append(res.ParentMembers, &ParentMember{
+				MemberName: name,
+				fieldPath:  path,
+			})
+			continue
+		}
+		unresolved = append(unresolved, name)
+	}
+	if isChildOp && res.MemberName == "" {
+		if len(unresolved) != 1 {
+			return nil, fmt.Errorf(
+				"cannot infer the member of %s's input shape containing the children, set member_name",
+				op.Name,
+			)
+		}
+		res.MemberName = unresolved[0]
+		unresolved = nil
+	}
+	for _, name := range unresolved {
+		if !strings.Contains(strings.ToLower(name), "arn") {
+			return nil, fmt.Errorf(
+				"required member %s of %s's input shape is not a field of the resource",
+				name, op.Name,
+			)
+		}
+		// Members containing an ARN are assumed to identify the resource
+		res.ParentMembers = append(res.ParentMembers, &ParentMember{
+			MemberName: name,
+		})
+	}
+	if !isChildOp {
+		return res, nil
+	}
+
+	memberRef, found := inputShape.MemberRefs[res.MemberName]
+	if !found {
+		return nil, fmt.Errorf(
+			"unknown member %s in the input shape of %s",
+			res.MemberName, op.Name,
+		)
+	}
+	switch {
+	case memberRef.Shape.Type == "string":
+	case memberRef.Shape.Type == "list" && memberRef.Shape.MemberRef.Shape.Type == "string":
+		res.IsBatch = true
+	default:
+		return nil, fmt.Errorf(
+			"member %s of %s's input shape is neither a string nor a list of strings",
+			res.MemberName, op.Name,
+		)
+	}
+	return res, nil
+}
+
+// memberFieldPath returns the path of the CR field containing the value of
+// the supplied input shape member, e.g. "Spec.DBClusterIdentifier". The
+// renames of the supplied operation are checked, then those of the Create
+// operation.
+func (r *CRD) memberFieldPath(
+	memberName string,
+	op *awssdkmodel.Operation,
+) (string, bool) {
+	for _, renameOp := range []*awssdkmodel.Operation{op, r.Ops.Create} {
+		if renameOp == nil {
+			continue
+		}
+		path, err := r.GetSanitizedMemberPath(memberName, renameOp, "")
+		if err == nil {
+			return strings.TrimPrefix(path, "."), true
+		}
+	}
+	return "", false
+}
+
+// childListShapeRef returns the shape of the Spec field containing the
+// identifiers of the children added by the supplied operation
+func (r *CRD) childListShapeRef(addOp *ChildOperation) *awssdkmodel.ShapeRef {
+	memberRef := addOp.Operation.InputRef.Shape.MemberRefs[addOp.MemberName]
+	if addOp.IsBatch {
+		return memberRef
+	}
+	return &awssdkmodel.ShapeRef{
+		API:       memberRef.API,
+		ShapeName: memberRef.ShapeName + "List",
+		Shape: &awssdkmodel.Shape{
+			API:       memberRef.Shape.API,
+			ShapeName: memberRef.ShapeName + "List",
+			Type:      "list",
+			MemberRef: *memberRef,
+		},
+	}
+}
+
+// checkChildPath returns an error if the supplied path, starting with the
+// supplied member, doesn't lead to strings through structures and lists
+func checkChildPath(shapeRef *awssdkmodel.ShapeRef, path []string) error {
+	for i, memberName := range path {
+		shape := shapeRef.Shape
+		if shape.Type == "list" {
+			shape = shape.MemberRef.Shape
+		}
+		if i == len(path)-1 {
+			if shape.Type != "string" {
+				return fmt.Errorf(
+					"path %s doesn't lead to strings",
+					strings.Join(path, "."),
+				)
+			}
+			return nil
+		}
+		if shape.Type != "structure" {
+			return fmt.Errorf(
+				"member %s of path %s isn't a structure",
+				memberName, strings.Join(path, "."),
+			)
+		}
+		nextRef, found := shape.MemberRefs[path[i+1]]
+		if !found {
+			return fmt.Errorf(
+				"unknown member %s in path %s",
+				path[i+1], strings.Join(path, "."),
+			)
+		}
+		shapeRef = nextRef
+	}
+	return nil
+}
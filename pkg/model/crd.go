@@ -36,6 +36,15 @@ type Ops struct {
 	Delete        *awssdkmodel.Operation
 	GetAttributes *awssdkmodel.Operation
 	SetAttributes *awssdkmodel.Operation
+	// Replace creates or updates the resource, and is used as the Create or
+	// Update operation when the resource has none
+	Replace *awssdkmodel.Operation
+	// AddChild, AddChildren, RemoveChild and RemoveChildren add and remove
+	// children of the resource. See ChildFields.
+	AddChild       *awssdkmodel.Operation
+	AddChildren    *awssdkmodel.Operation
+	RemoveChild    *awssdkmodel.Operation
+	RemoveChildren *awssdkmodel.Operation
 }
 
 // IterOps returns a slice of Operations for a resource
@@ -89,6 +98,15 @@ type CRD struct {
 	// ShortNames represent the CRD list of aliases. Short names allow shorter
 	// strings to match a CR on the CLI.
 	ShortNames []string
+	// childFields are the fields reconciled with the operations adding and
+	// removing children of the resource
+	childFields []*ChildField
+	// subResources are the Spec fields reconciled with the operations of
+	// groups managing a part of the resource's configuration
+	subResources []*SubResource
+	// printerColumnErrs are the problems found in the `print` configs of the
+	// fields added to the CRD
+	printerColumnErrs ackgenconfig.ValidationErrors
 }
 
 // Config returns a pointer to the generator config
@@ -102,6 +120,16 @@ func (r *CRD) SDKAPIPackageName() string {
 	return r.sdkAPI.API.PackageName()
 }
 
+// IsNullableMember returns true if the member of the supplied SDK structure
+// shape is represented with a pointer type in the SDK this resource's API was
+// loaded for
+func (r *CRD) IsNullableMember(
+	shape *awssdkmodel.Shape,
+	memberName string,
+) bool {
+	return r.sdkAPI.IsNullableMember(shape, memberName)
+}
+
 // TypeRenames returns a map of original type name to renamed name (some
 // type definition names conflict with generated names)
 func (r *CRD) TypeRenames() map[string]string {
@@ -204,6 +232,12 @@ func (r *CRD) AddSpecField(
 		r.addSpecPrintableColumn(f)
 	}
 	r.SpecFields[memberNames.Original] = f
+	if tagging := r.Tagging(); tagging != nil && tagging.Field == f && tagging.IsNormalized {
+		// Normalized tags are a map, whatever their shape in the API model
+		f.GoType = "map[string]*string"
+		f.GoTypeElem = "*string"
+		f.GoTypeWithPkgName = "map[string]*string"
+	}
 	r.Fields[fPath] = f
 }
 
@@ -224,6 +258,17 @@ func (r *CRD) AddStatusField(
 	r.Fields[fPath] = f
 }
 
+// newConfigError returns a ValidationError for the key of the resource's
+// generator config found at the supplied path, relative to the resource
+func (r *CRD) newConfigError(
+	path []string,
+	format string,
+	args ...interface{},
+) *ackgenconfig.ValidationError {
+	resPath := append([]string{"resources", r.Names.Original}, path...)
+	return r.cfg.NewValidationError(resPath, format, args...)
+}
+
 // AddTypeImport adds an entry in the CRD's TypeImports map for an import line
 // and optional alias
 func (r *CRD) AddTypeImport(
@@ -246,6 +291,16 @@ func (r *CRD) SpecFieldNames() []string {
 	return res
 }
 
+// StatusFieldNames returns a sorted slice of field names for the Status fields
+func (r *CRD) StatusFieldNames() []string {
+	res := make([]string, 0, len(r.StatusFields))
+	for fieldName := range r.StatusFields {
+		res = append(res, fieldName)
+	}
+	sort.Strings(res)
+	return res
+}
+
 // UnpacksAttributesMap returns true if the underlying API has
 // Get{Resource}Attributes/Set{Resource}Attributes API calls that map real,
 // schema'd fields to a raw `map[string]*string` for this resource (see SNS and
@@ -387,7 +442,10 @@ func (r *CRD) GetPrimaryKeyField() (*Field, error) {
 		var notFound bool
 		primaryField, notFound = r.Fields[fPath]
 		if !notFound {
-			return nil, fmt.Errorf("what the frick")
+			return nil, fmt.Errorf(
+				"field %s marked with is_primary_key is not a field of the resource",
+				fieldName,
+			)
 		}
 	}
 	return primaryField, nil
@@ -658,6 +716,39 @@ func (r *CRD) CustomUpdateMethodName() string {
 	return ""
 }
 
+// checkOperationConfigs returns the problems found in the generator config of
+// the resource's operations that would otherwise only be found while
+// generating code, such as output wrapper field paths that can't be
+// unwrapped
+func (r *CRD) checkOperationConfigs() ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
+	checked := map[*awssdkmodel.Operation]bool{}
+	for _, op := range []*awssdkmodel.Operation{
+		r.Ops.Create, r.Ops.ReadOne, r.Ops.ReadMany, r.Ops.Update,
+	} {
+		wrapperFieldPath := r.GetOutputWrapperFieldPath(op)
+		if wrapperFieldPath == nil || op.OutputRef.Shape == nil || checked[op] {
+			continue
+		}
+		checked[op] = true
+		if _, err := r.GetWrapperOutputShape(
+			op.OutputRef.Shape, *wrapperFieldPath,
+		); err != nil {
+			errs = append(errs, r.cfg.NewValidationError(
+				[]string{"operations", op.Name, "output_wrapper_field_path"},
+				"%v", err,
+			))
+		}
+	}
+	if maxPages := r.ListOpMaxPages(); maxPages < 1 {
+		errs = append(errs, r.newConfigError(
+			[]string{"list_operation", "max_pages"},
+			"must be at least 1, got %d", maxPages,
+		))
+	}
+	return errs
+}
+
 // ListOpMatchFieldNames returns a slice of strings representing the field
 // names in the List operation's Output shape's element Shape that we should
 // check a corresponding value in the target Spec exists.
@@ -665,6 +756,23 @@ func (r *CRD) ListOpMatchFieldNames() []string {
 	return r.cfg.ListOpMatchFieldNames(r.Names.Original)
 }
 
+// IsReadManyPaginated returns true if sdkFind calls the resource's ReadMany
+// operation and that operation returns its results in pages, in which case
+// sdkFind looks for the resource in each page of results, up to
+// ListOpMaxPages pages.
+func (r *CRD) IsReadManyPaginated() bool {
+	if r.Ops.ReadOne != nil || r.Ops.GetAttributes != nil {
+		return false
+	}
+	return r.Ops.ReadMany != nil && r.Ops.ReadMany.Paginator != nil
+}
+
+// ListOpMaxPages returns the maximum number of pages of results the ReadMany
+// operation reads when looking for the resource
+func (r *CRD) ListOpMaxPages() int {
+	return r.cfg.ListOpMaxPages(r.Names.Original)
+}
+
 // GetAllRenames returns all the field renames observed in the generator config
 // for a given OpType.
 func (r *CRD) GetAllRenames(op OpType) (map[string]string, error) {
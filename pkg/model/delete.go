@@ -0,0 +1,264 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+)
+
+// DeletingStatus is the Status field telling whether a resource is being
+// deleted, along with the fields found at its path
+type DeletingStatus struct {
+	*ackgenconfig.DeletingConfig
+	// Fields are the fields found along the path, from the Status field to
+	// the string field whose value is checked
+	Fields []*Field
+}
+
+// PreDeleteUpdate is the value a Spec field must have for the resource to be
+// deleted
+type PreDeleteUpdate struct {
+	Field *Field
+	// Value is the Go expression of the value, e.g. `aws.Bool(false)`
+	Value string
+}
+
+// deleteConfig returns the resource's `delete` config, if any
+func (r *CRD) deleteConfig() *ackgenconfig.DeleteConfig {
+	if r.cfg == nil {
+		return nil
+	}
+	rConfig, found := r.cfg.Resources[r.Names.Original]
+	if !found {
+		return nil
+	}
+	return rConfig.Delete
+}
+
+// DeleteWaitsUntilNotFound returns true if the resource is kept, along with
+// the finalizer of its custom resource, until it is no longer found after its
+// Delete operation is called
+func (r *CRD) DeleteWaitsUntilNotFound() bool {
+	cfg := r.deleteConfig()
+	return cfg != nil && cfg.WaitUntilNotFound && r.Ops.Delete != nil
+}
+
+// DeleteRequeueAfterSeconds returns the number of seconds after which to
+// check again whether a resource being deleted is gone, or 0 if the runtime's
+// default requeue duration should be used
+func (r *CRD) DeleteRequeueAfterSeconds() int {
+	cfg := r.deleteConfig()
+	if cfg == nil || cfg.RequeueAfterSeconds == nil || *cfg.RequeueAfterSeconds < 1 {
+		return 0
+	}
+	return *cfg.RequeueAfterSeconds
+}
+
+// DeletingStatus returns the Status field telling whether the resource is
+// being deleted, or nil if the resource doesn't wait until it is no longer
+// found or has no valid `deleting` config
+func (r *CRD) DeletingStatus() *DeletingStatus {
+	cfg := r.deleteConfig()
+	if !r.DeleteWaitsUntilNotFound() || cfg.Deleting == nil ||
+		len(cfg.Deleting.Values) == 0 {
+		return nil
+	}
+	fields, err := r.statusStringFields(cfg.Deleting.Path)
+	if err != nil {
+		return nil
+	}
+	return &DeletingStatus{cfg.Deleting, fields}
+}
+
+// PreDeleteUpdates returns the values Spec fields of the resource must have
+// for the resource to be deleted. Updates that can't be applied are left out,
+// see checkDeleteConfig.
+func (r *CRD) PreDeleteUpdates() []*PreDeleteUpdate {
+	cfg := r.deleteConfig()
+	if cfg == nil || r.Ops.Delete == nil || !r.hasUpdateOperation() {
+		return nil
+	}
+	updates := []*PreDeleteUpdate{}
+	for i := range cfg.PreDeleteUpdates {
+		update, err := r.preDeleteUpdate(&cfg.PreDeleteUpdates[i])
+		if err != nil {
+			continue
+		}
+		updates = append(updates, update)
+	}
+	return updates
+}
+
+// hasUpdateOperation returns true if the generated sdkUpdate updates the
+// resource
+func (r *CRD) hasUpdateOperation() bool {
+	return r.CustomUpdateMethodName() != "" || r.Ops.Update != nil ||
+		r.Ops.SetAttributes != nil
+}
+
+// awsValueFuncs are the names of the aws-sdk-go functions returning pointers
+// to values of scalar shapes, keyed by shape type
+var awsValueFuncs = map[string]string{
+	"string":  "aws.String",
+	"boolean": "aws.Bool",
+	"integer": "aws.Int64",
+	"long":    "aws.Int64",
+	"float":   "aws.Float64",
+	"double":  "aws.Float64",
+}
+
+// preDeleteUpdate returns the value a Spec field must have for the resource to
+// be deleted
+func (r *CRD) preDeleteUpdate(
+	cfg *ackgenconfig.PreDeleteUpdateConfig,
+) (*PreDeleteUpdate, error) {
+	f, err := r.preDeleteUpdateField(cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+	literal, err := preDeleteUpdateLiteral(f, cfg.Value)
+	if err != nil {
+		return nil, err
+	}
+	shapeType := f.ShapeRef.Shape.Type
+	return &PreDeleteUpdate{
+		Field: f,
+		Value: fmt.Sprintf("%s(%s)", awsValueFuncs[shapeType], literal),
+	}, nil
+}
+
+// preDeleteUpdateField returns the Spec field found at the path of a
+// pre-delete update, e.g. "Spec.DeletionProtection"
+func (r *CRD) preDeleteUpdateField(path string) (*Field, error) {
+	if !strings.HasPrefix(path, "Spec.") {
+		return nil, fmt.Errorf("must be the path of a Spec field, e.g. Spec.%s", path)
+	}
+	fieldName := strings.TrimPrefix(path, "Spec.")
+	if strings.Contains(fieldName, ".") {
+		return nil, fmt.Errorf("only top-level Spec fields are supported")
+	}
+	f, found := r.Fields[fieldName]
+	if !found || !r.isSpecField(f) {
+		return nil, fmt.Errorf(
+			"unknown field %s%s", fieldName,
+			ackgenconfig.DidYouMean(fieldName, r.SpecFieldNames()),
+		)
+	}
+	if f.ShapeRef == nil || f.ShapeRef.Shape == nil || f.TypeOverride() != nil ||
+		!isScalarShapeType(f.ShapeRef.Shape.Type) {
+		return nil, fmt.Errorf(
+			"field has type %s: only string, number and boolean fields are supported",
+			f.GoType,
+		)
+	}
+	return f, nil
+}
+
+// preDeleteUpdateLiteral returns the Go literal of the value of a pre-delete
+// update of the supplied field
+func preDeleteUpdateLiteral(f *Field, value *string) (string, error) {
+	if value == nil {
+		return "", fmt.Errorf("missing value")
+	}
+	return scalarLiteral(f.ShapeRef.Shape.Type, *value)
+}
+
+// isSpecField returns true if the supplied field is a top-level field of the
+// resource's Spec
+func (r *CRD) isSpecField(f *Field) bool {
+	for _, specField := range r.SpecFields {
+		if specField == f {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDeleteConfig returns the problems found in the resource's `delete`
+// config
+func (r *CRD) checkDeleteConfig() ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
+	cfg := r.deleteConfig()
+	if cfg == nil {
+		return errs
+	}
+	if r.Ops.Delete == nil {
+		errs = append(errs, r.newConfigError(
+			[]string{"delete"}, "%s has no Delete operation", r.Names.Original,
+		))
+		return errs
+	}
+	if cfg.RequeueAfterSeconds != nil {
+		if !cfg.WaitUntilNotFound {
+			errs = append(errs, r.newConfigError(
+				[]string{"delete", "requeue_after_seconds"}, "requires wait_until_not_found",
+			))
+		} else if *cfg.RequeueAfterSeconds < 1 {
+			errs = append(errs, r.newConfigError(
+				[]string{"delete", "requeue_after_seconds"},
+				"must be at least 1, got %d", *cfg.RequeueAfterSeconds,
+			))
+		}
+	}
+	if cfg.WaitUntilNotFound && cfg.Deleting == nil {
+		errs = append(errs, r.newConfigError(
+			[]string{"delete", "wait_until_not_found"},
+			"requires deleting, the Delete operation would otherwise be called again until the resource is not found",
+		))
+	}
+	if cfg.Deleting != nil {
+		if !cfg.WaitUntilNotFound {
+			errs = append(errs, r.newConfigError(
+				[]string{"delete", "deleting"}, "requires wait_until_not_found",
+			))
+		}
+		if _, err := r.statusStringFields(cfg.Deleting.Path); err != nil {
+			errs = append(errs, r.newConfigError(
+				[]string{"delete", "deleting", "path"}, "%v", err,
+			))
+		}
+		if len(cfg.Deleting.Values) == 0 {
+			errs = append(errs, r.newConfigError(
+				[]string{"delete", "deleting", "values"}, "at least one value is required",
+			))
+		}
+	}
+	if len(cfg.PreDeleteUpdates) > 0 && !r.hasUpdateOperation() {
+		errs = append(errs, r.newConfigError(
+			[]string{"delete", "pre_delete_updates"},
+			"%s has no Update operation", r.Names.Original,
+		))
+		return errs
+	}
+	for i := range cfg.PreDeleteUpdates {
+		update := &cfg.PreDeleteUpdates[i]
+		updatePath := []string{"delete", "pre_delete_updates", itoa(i)}
+		f, err := r.preDeleteUpdateField(update.Path)
+		if err != nil {
+			errs = append(errs, r.newConfigError(
+				append(updatePath, "path"), "%v", err,
+			))
+			continue
+		}
+		if _, err := preDeleteUpdateLiteral(f, update.Value); err != nil {
+			errs = append(errs, r.newConfigError(
+				append(updatePath, "value"), "%v", err,
+			))
+		}
+	}
+	return errs
+}
@@ -13,6 +13,60 @@
 
 package model
 
+import (
+	"regexp"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
+)
+
+const (
+	// ExceptionOutcomeNotFound reports the resource as not found
+	ExceptionOutcomeNotFound = "not_found"
+	// ExceptionOutcomeTerminal sets a Terminal condition on the resource
+	ExceptionOutcomeTerminal = "terminal"
+	// ExceptionOutcomeRetry requeues the resource with an exponential backoff
+	ExceptionOutcomeRetry = "retry"
+	// ExceptionOutcomeRequeue requeues the resource after a fixed duration
+	ExceptionOutcomeRequeue = "requeue"
+)
+
+// exceptionOutcomes are the valid outcomes of exception rules
+var exceptionOutcomes = []string{
+	ExceptionOutcomeNotFound,
+	ExceptionOutcomeTerminal,
+	ExceptionOutcomeRetry,
+	ExceptionOutcomeRequeue,
+}
+
+// defaultMaxRetryAfterSeconds caps the backoff of retry rules without a
+// max_retry_after_seconds
+const defaultMaxRetryAfterSeconds = 300
+
+// ExceptionRule classifies the errors returned by the API operations of a
+// resource by AWS error code and message
+type ExceptionRule struct {
+	// Index is the position of the rule in the resource's `exceptions.rules`
+	// config
+	Index int
+	// Code is the AWS error code matched by the rule
+	Code string
+	// MessagePattern is the regular expression the error message must match,
+	// or empty if the rule matches any message
+	MessagePattern string
+	// Operations are the names of the API operations whose errors the rule
+	// matches, or empty if it matches the errors of all operations
+	Operations []string
+	// Outcome is one of the ExceptionOutcome constants
+	Outcome string
+	// AfterSeconds is the number of seconds after which a retry or requeue
+	// rule requeues the resource, or 0 for the runtime's default duration
+	AfterSeconds int
+	// MaxAfterSeconds caps the backoff of a retry rule
+	MaxAfterSeconds int
+}
+
 // TerminalExceptionCodes returns terminal exception codes as
 // []string for custom resource, if specified in generator config
 func (r *CRD) TerminalExceptionCodes() []string {
@@ -78,3 +132,219 @@ func (r *CRD) ExceptionCode(httpStatusCode int) string {
 	}
 	return "UNKNOWN"
 }
+
+// exceptionsConfig returns the resource's `exceptions` config, if any
+func (r *CRD) exceptionsConfig() *ackgenconfig.ExceptionsConfig {
+	if r.cfg == nil {
+		return nil
+	}
+	rConfig, found := r.cfg.Resources[r.Names.Original]
+	if !found {
+		return nil
+	}
+	return rConfig.Exceptions
+}
+
+// ExceptionRules returns the rules classifying the errors returned by the API
+// operations of the resource, in the order in which they are matched. Invalid
+// rules are left out, see checkExceptionRules.
+func (r *CRD) ExceptionRules() []*ExceptionRule {
+	cfg := r.exceptionsConfig()
+	if cfg == nil {
+		return nil
+	}
+	rules := []*ExceptionRule{}
+	for i := range cfg.Rules {
+		rule, errs := r.exceptionRule(i, &cfg.Rules[i])
+		if len(errs) > 0 {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// HasExceptionOutcome returns true if one of the resource's exception rules
+// has the supplied outcome
+func (r *CRD) HasExceptionOutcome(outcome string) bool {
+	for _, rule := range r.ExceptionRules() {
+		if rule.Outcome == outcome {
+			return true
+		}
+	}
+	return false
+}
+
+// HasExceptionMessagePatterns returns true if one of the resource's exception
+// rules matches error messages against a regular expression
+func (r *CRD) HasExceptionMessagePatterns() bool {
+	for _, rule := range r.ExceptionRules() {
+		if rule.MessagePattern != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasExceptionRequeueDurations returns true if one of the resource's
+// exception rules requeues the resource after a configured number of seconds
+func (r *CRD) HasExceptionRequeueDurations() bool {
+	for _, rule := range r.ExceptionRules() {
+		if rule.AfterSeconds > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// exceptionRuleOperations returns the names of the API operations whose
+// errors are classified by exception rules, along with the names of those
+// that can report the resource as not found
+func (r *CRD) exceptionRuleOperations() (all []string, notFound []string) {
+	if op := r.findOperation(); op != nil {
+		all = append(all, op.ExportedName)
+		notFound = append(notFound, op.ExportedName)
+	}
+	if r.Ops.Create != nil {
+		all = append(all, r.Ops.Create.ExportedName)
+	}
+	if r.CustomUpdateMethodName() == "" {
+		if r.Ops.Update != nil {
+			all = append(all, r.Ops.Update.ExportedName)
+		} else if r.Ops.SetAttributes != nil {
+			all = append(all, r.Ops.SetAttributes.ExportedName)
+		}
+	}
+	if r.Ops.Delete != nil {
+		all = append(all, r.Ops.Delete.ExportedName)
+		notFound = append(notFound, r.Ops.Delete.ExportedName)
+	}
+	return all, notFound
+}
+
+// exceptionRule returns the exception rule for the supplied config, or the
+// errors found in the config
+func (r *CRD) exceptionRule(
+	index int,
+	cfg *ackgenconfig.ExceptionRuleConfig,
+) (*ExceptionRule, ackgenconfig.ValidationErrors) {
+	errs := ackgenconfig.ValidationErrors{}
+	path := []string{"exceptions", "rules", itoa(index)}
+	rule := &ExceptionRule{
+		Index:      index,
+		Code:       cfg.Code,
+		Operations: cfg.Operations,
+		Outcome:    cfg.Outcome,
+	}
+	if cfg.Code == "" {
+		errs = append(errs, r.newConfigError(append(path, "code"), "missing code"))
+	}
+	if cfg.MessagePattern != nil {
+		if _, err := regexp.Compile(*cfg.MessagePattern); err != nil {
+			errs = append(errs, r.newConfigError(
+				append(path, "message_pattern"), "%v", err,
+			))
+		}
+		rule.MessagePattern = *cfg.MessagePattern
+	}
+	allOps, notFoundOps := r.exceptionRuleOperations()
+	for i, opName := range cfg.Operations {
+		opPath := append(path, "operations", itoa(i))
+		if !util.InStrings(opName, allOps) {
+			errs = append(errs, r.newConfigError(
+				opPath, "unknown operation %s%s",
+				opName, ackgenconfig.DidYouMean(opName, allOps),
+			))
+		} else if cfg.Outcome == ExceptionOutcomeNotFound &&
+			!util.InStrings(opName, notFoundOps) {
+			errs = append(errs, r.newConfigError(
+				opPath, "a not_found outcome only applies to %s",
+				strings.Join(notFoundOps, " and "),
+			))
+		}
+	}
+	if cfg.Outcome == ExceptionOutcomeNotFound && len(cfg.Operations) == 0 {
+		rule.Operations = notFoundOps
+	}
+	if !util.InStrings(cfg.Outcome, exceptionOutcomes) {
+		errs = append(errs, r.newConfigError(
+			append(path, "outcome"), "unknown outcome %q, must be one of %s",
+			cfg.Outcome, strings.Join(exceptionOutcomes, ", "),
+		))
+	}
+	errs = append(errs, r.checkExceptionRuleSeconds(path, cfg)...)
+	switch cfg.Outcome {
+	case ExceptionOutcomeRetry:
+		if cfg.RetryAfterSeconds != nil {
+			rule.AfterSeconds = *cfg.RetryAfterSeconds
+		}
+		rule.MaxAfterSeconds = defaultMaxRetryAfterSeconds
+		if cfg.MaxRetryAfterSeconds != nil {
+			rule.MaxAfterSeconds = *cfg.MaxRetryAfterSeconds
+		}
+		if rule.AfterSeconds > rule.MaxAfterSeconds {
+			errs = append(errs, r.newConfigError(
+				append(path, "max_retry_after_seconds"),
+				"must be at least retry_after_seconds (%d), got %d",
+				rule.AfterSeconds, rule.MaxAfterSeconds,
+			))
+		}
+	case ExceptionOutcomeRequeue:
+		if cfg.RequeueAfterSeconds != nil {
+			rule.AfterSeconds = *cfg.RequeueAfterSeconds
+		}
+	}
+	return rule, errs
+}
+
+// checkExceptionRuleSeconds checks the durations of an exception rule are
+// set for the rule's outcome and positive
+func (r *CRD) checkExceptionRuleSeconds(
+	path []string,
+	cfg *ackgenconfig.ExceptionRuleConfig,
+) ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
+	if cfg.Outcome == ExceptionOutcomeRetry && cfg.RetryAfterSeconds == nil {
+		errs = append(errs, r.newConfigError(
+			append(path, "retry_after_seconds"), "required for a retry outcome",
+		))
+	}
+	for _, seconds := range []struct {
+		name    string
+		value   *int
+		outcome string
+	}{
+		{"retry_after_seconds", cfg.RetryAfterSeconds, ExceptionOutcomeRetry},
+		{"max_retry_after_seconds", cfg.MaxRetryAfterSeconds, ExceptionOutcomeRetry},
+		{"requeue_after_seconds", cfg.RequeueAfterSeconds, ExceptionOutcomeRequeue},
+	} {
+		if seconds.value == nil {
+			continue
+		}
+		secondsPath := append(path, seconds.name)
+		if cfg.Outcome != seconds.outcome {
+			errs = append(errs, r.newConfigError(
+				secondsPath, "only applies to a %s outcome", seconds.outcome,
+			))
+		} else if *seconds.value < 1 {
+			errs = append(errs, r.newConfigError(
+				secondsPath, "must be at least 1, got %d", *seconds.value,
+			))
+		}
+	}
+	return errs
+}
+
+// checkExceptionRules checks the resource's `exceptions.rules` config
+func (r *CRD) checkExceptionRules() ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
+	cfg := r.exceptionsConfig()
+	if cfg == nil {
+		return errs
+	}
+	for i := range cfg.Rules {
+		_, ruleErrs := r.exceptionRule(i, &cfg.Rules[i])
+		errs = append(errs, ruleErrs...)
+	}
+	return errs
+}
@@ -55,14 +55,44 @@ type Field struct {
 // If there is no required override present for this field in FieldConfig,
 // IsRequired will return if the shape is marked as required in AWS SDK Private
 // model We use this to append kubebuilder:validation:Required markers to
-// validate using the CRD validation schema
+// validate using the CRD validation schema. Fields referring to other
+// resources or having a default value are never required unless configured
+// so.
 func (f *Field) IsRequired() bool {
 	if f.FieldConfig != nil && f.FieldConfig.IsRequired != nil {
 		return *f.FieldConfig.IsRequired
 	}
+	if f.FieldConfig != nil && f.FieldConfig.References != nil {
+		// The field can be filled in from the referenced resource instead
+		return false
+	}
+	if f.Default() != nil {
+		// The field is set by the Kubernetes API server or the AWS service
+		return false
+	}
 	return util.InStrings(f.Names.ModelOriginal, f.CRD.Ops.Create.InputRef.Shape.Required)
 }
 
+// Validation returns the OpenAPI validation constraints of the field, derived
+// from the constraints of its shape in the API model and overridden by the
+// field's Validation config. Returns nil if the field has no constraint.
+func (f *Field) Validation() *FieldValidation {
+	var v *FieldValidation
+	if f.ShapeRef != nil && f.CRD != nil && f.CRD.sdkAPI != nil {
+		v = f.CRD.sdkAPI.ShapeValidation(f.ShapeRef.Shape)
+	}
+	if f.FieldConfig != nil {
+		v = applyValidationConfig(v, f.FieldConfig.Validation)
+	}
+	return restrictValidationToGoType(v, f.GoType)
+}
+
+// ValidationMarkers returns the `+kubebuilder:validation` markers of the
+// field's OpenAPI validation constraints
+func (f *Field) ValidationMarkers() []string {
+	return f.Validation().Markers()
+}
+
 // ParentFieldPath takes a field path and returns the field path of the
 // containing "parent" field. For example, if the field path
 // `Users..Credentials.Login` is passed in, this function returns
@@ -0,0 +1,77 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"sort"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+)
+
+// checkCompareConfigs returns the problems with the `compare` configs of the
+// fields of the CRD, including nested fields, declaring lists as sets or keyed
+// lists
+func (r *CRD) checkCompareConfigs() ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
+	paths := make([]string, 0, len(r.Fields))
+	for path := range r.Fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		field := r.Fields[path]
+		if field.FieldConfig == nil || field.FieldConfig.Compare == nil ||
+			field.ShapeRef == nil || field.ShapeRef.Shape == nil {
+			continue
+		}
+		cfg := field.FieldConfig.Compare
+		shape := field.ShapeRef.Shape
+		if cfg.IsSet && shape.Type != "list" {
+			errs = append(errs, r.newConfigError(
+				[]string{"fields", path, "compare", "is_set"},
+				"field has type %s: only list fields can be compared as sets",
+				shape.Type,
+			))
+		}
+		if cfg.Key == "" {
+			continue
+		}
+		keyPath := []string{"fields", path, "compare", "key"}
+		if shape.Type != "list" || shape.MemberRef.Shape.Type != "structure" {
+			errs = append(errs, r.newConfigError(
+				keyPath,
+				"only lists of structs can be matched by key",
+			))
+			continue
+		}
+		elemShape := shape.MemberRef.Shape
+		keyRef, found := elemShape.MemberRefs[cfg.Key]
+		if !found {
+			errs = append(errs, r.newConfigError(
+				keyPath,
+				"unknown member %s of %s%s", cfg.Key, elemShape.ShapeName,
+				ackgenconfig.DidYouMean(cfg.Key, elemShape.MemberNames()),
+			))
+			continue
+		}
+		if keyRef.Shape.Type != "string" {
+			errs = append(errs, r.newConfigError(
+				keyPath,
+				"member %s has type %s: only string members can be keys",
+				cfg.Key, keyRef.Shape.Type,
+			))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,162 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+)
+
+// FieldDefault describes the value the AWS service gives a field that is left
+// unset
+type FieldDefault struct {
+	// Value is the Go literal of the default value, e.g. `"MUTABLE"` or `7`.
+	// It is empty when the default value is computed by the AWS service.
+	Value string
+}
+
+// IsComputed returns true if the default value is computed by the AWS service
+// and can't be known by the code generator
+func (d *FieldDefault) IsComputed() bool {
+	return d.Value == ""
+}
+
+// Default returns the value the AWS service gives the field when it is left
+// unset, or nil if the field has no `default` config or if the config can't
+// be applied to the field's shape
+func (f *Field) Default() *FieldDefault {
+	d, err := f.fieldDefault()
+	if err != nil {
+		return nil
+	}
+	return d
+}
+
+// DefaultMarker returns the `+kubebuilder:default` marker of the field, or an
+// empty string if the field has no literal default value
+func (f *Field) DefaultMarker() string {
+	d := f.Default()
+	if d == nil || d.IsComputed() {
+		return ""
+	}
+	return defaultMarker(d.Value)
+}
+
+// fieldDefault returns the value the AWS service gives the field when it is
+// left unset, or an error if its `default` config can't be applied to the
+// field's shape
+func (f *Field) fieldDefault() (*FieldDefault, error) {
+	if f.FieldConfig == nil || f.FieldConfig.Default == nil {
+		return nil, nil
+	}
+	cfg := f.FieldConfig.Default
+	if cfg.IsComputed {
+		if cfg.Value != nil {
+			return nil, fmt.Errorf("a computed default can't have a value")
+		}
+		return &FieldDefault{}, nil
+	}
+	if cfg.Value == nil {
+		return nil, fmt.Errorf("missing value")
+	}
+	if f.ShapeRef == nil || f.ShapeRef.Shape == nil {
+		return nil, fmt.Errorf("fields without a shape can't have a default value")
+	}
+	if f.TypeOverride() != nil {
+		return nil, fmt.Errorf("fields with a type override can't have a default value")
+	}
+	shapeType := f.ShapeRef.Shape.Type
+	if !isScalarShapeType(shapeType) {
+		return nil, fmt.Errorf("only string, number and boolean fields can have a default value, not %s", shapeType)
+	}
+	literal, err := scalarLiteral(shapeType, *cfg.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &FieldDefault{Value: literal}, nil
+}
+
+// isScalarShapeType returns true if the values of shapes of the supplied type
+// can be given as literals in the generator config
+func isScalarShapeType(shapeType string) bool {
+	switch shapeType {
+	case "string", "boolean", "integer", "long", "float", "double":
+		return true
+	}
+	return false
+}
+
+// scalarLiteral returns the Go literal of a value given in the generator
+// config for a shape of the supplied scalar type, e.g. `"MUTABLE"`, `7` or
+// `true`
+func scalarLiteral(shapeType string, value string) (string, error) {
+	switch shapeType {
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return "", fmt.Errorf("invalid boolean value %q", value)
+		}
+		return strings.ToLower(value), nil
+	case "integer", "long":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return "", fmt.Errorf("invalid integer value %q", value)
+		}
+		return value, nil
+	case "float", "double":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "", fmt.Errorf("invalid number value %q", value)
+		}
+		return value, nil
+	}
+	return strconv.Quote(value), nil
+}
+
+// HasNestedDefaults returns true if a field nested in the field at the
+// supplied path, e.g. a member of the elements of a list field, has a
+// `default` config
+func (r *CRD) HasNestedDefaults(fieldPath string) bool {
+	for path, field := range r.Fields {
+		if strings.HasPrefix(path, fieldPath+".") && field.Default() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDefaults returns the fields of the CRD, including nested fields, whose
+// `default` config can't be applied
+func (r *CRD) checkDefaults() ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
+	paths := make([]string, 0, len(r.Fields))
+	for path := range r.Fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if _, err := r.Fields[path].fieldDefault(); err != nil {
+			errs = append(errs, r.newConfigError(
+				[]string{"fields", path, "default"}, "%v", err,
+			))
+		}
+	}
+	return errs
+}
+
+// defaultMarker returns a `+kubebuilder:default` marker
+func defaultMarker(value string) string {
+	return "+kubebuilder:default=" + value
+}
@@ -0,0 +1,99 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// IsImmutable returns true if the field can't be changed once set, as
+// instructed by its `is_immutable` config, and if changes to the field can be
+// validated by the Kubernetes API server. Changes to fields nested in the
+// elements of lists or maps can't be validated because the old and new
+// elements can't be matched.
+func (f *Field) IsImmutable() bool {
+	if f.FieldConfig == nil || !f.FieldConfig.IsImmutable {
+		return false
+	}
+	return !strings.Contains(f.Path, "..")
+}
+
+// ImmutableFields returns the fields of the CRD, including nested fields,
+// that can't be changed once set, sorted by path
+func (r *CRD) ImmutableFields() []*Field {
+	fields := []*Field{}
+	for _, field := range r.Fields {
+		if field.IsImmutable() {
+			fields = append(fields, field)
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Path < fields[j].Path
+	})
+	return fields
+}
+
+// ImmutableMarkers returns the `+kubebuilder:validation:XValidation` markers
+// of the Spec struct of the CRD, one per immutable Spec field. The rules are
+// set on the Spec struct rather than on the fields, so that:
+//
+// * the rules of nested fields don't apply to the other fields sharing the
+//   type containing them
+// * the rules also run when a field is removed, which transition rules set
+//   on the field itself don't
+//
+// They reject the same changes as the validating webhook: once set, a field
+// can't be changed nor cleared. For example, for the nested field
+// `EncryptionConfiguration.KMSKey`:
+//
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.encryptionConfiguration) || !has(oldSelf.encryptionConfiguration.kmsKey) || (has(self.encryptionConfiguration) && has(self.encryptionConfiguration.kmsKey) && self.encryptionConfiguration.kmsKey == oldSelf.encryptionConfiguration.kmsKey)",message="encryptionConfiguration.kmsKey is immutable once set"
+func (r *CRD) ImmutableMarkers() []string {
+	markers := []string{}
+	for _, field := range r.ImmutableFields() {
+		parts := strings.Split(field.Path, ".")
+		if _, found := r.SpecFields[parts[0]]; !found {
+			// Status fields aren't changed by users
+			continue
+		}
+		// The JSON selectors of the field and of the fields containing it,
+		// e.g. "encryptionConfiguration" and "encryptionConfiguration.kmsKey"
+		selectors := []string{}
+		selector := ""
+		for i := range parts {
+			parent := r.Fields[strings.Join(parts[:i+1], ".")]
+			if selector != "" {
+				selector += "."
+			}
+			selector += parent.Names.CamelLower
+			selectors = append(selectors, selector)
+		}
+		oldUnset := []string{}
+		newSet := []string{}
+		for _, s := range selectors {
+			oldUnset = append(oldUnset, fmt.Sprintf("!has(oldSelf.%s)", s))
+			newSet = append(newSet, fmt.Sprintf("has(self.%s)", s))
+		}
+		newSet = append(newSet, fmt.Sprintf("self.%s == oldSelf.%s", selector, selector))
+		rule := fmt.Sprintf(
+			"%s || (%s)", strings.Join(oldUnset, " || "), strings.Join(newSet, " && "),
+		)
+		markers = append(markers, fmt.Sprintf(
+			"+kubebuilder:validation:XValidation:rule=%q,message=%q",
+			rule, selector+" is immutable once set",
+		))
+	}
+	return markers
+}
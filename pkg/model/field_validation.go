@@ -0,0 +1,286 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
+)
+
+// shapeConstraints contains the constraints of an API model shape, as found in
+// the `api-2.json` model files
+type shapeConstraints struct {
+	Min     *float64 `json:"min,omitempty"`
+	Max     *float64 `json:"max,omitempty"`
+	Pattern string   `json:"pattern,omitempty"`
+}
+
+// loadShapeConstraints returns a map, keyed by shape name, of the constraints
+// of the shapes in the supplied `api-2.json` document. Shapes without any
+// constraint are omitted.
+func loadShapeConstraints(content []byte) (map[string]*shapeConstraints, error) {
+	doc := struct {
+		Shapes map[string]*shapeConstraints `json:"shapes"`
+	}{}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+	res := map[string]*shapeConstraints{}
+	for shapeName, c := range doc.Shapes {
+		if c == nil || (c.Min == nil && c.Max == nil && c.Pattern == "") {
+			continue
+		}
+		res[shapeName] = c
+	}
+	return res, nil
+}
+
+// FieldValidation contains the OpenAPI validation constraints of a field. Nil
+// pointers and empty values indicate the absence of the constraint.
+type FieldValidation struct {
+	MinLength *int64
+	MaxLength *int64
+	Minimum   *float64
+	Maximum   *float64
+	MinItems  *int64
+	MaxItems  *int64
+	Pattern   string
+	Enum      []string
+}
+
+// IsEmpty returns true if the validation contains no constraint
+func (v *FieldValidation) IsEmpty() bool {
+	return v == nil || len(v.Markers()) == 0
+}
+
+// Markers returns the `+kubebuilder:validation` markers of the constraints,
+// e.g. `+kubebuilder:validation:MaxLength=256`
+func (v *FieldValidation) Markers() []string {
+	if v == nil {
+		return nil
+	}
+	res := []string{}
+	addInt := func(name string, value *int64) {
+		if value != nil {
+			res = append(res, validationMarker(name, strconv.FormatInt(*value, 10)))
+		}
+	}
+	addFloat := func(name string, value *float64) {
+		if value != nil {
+			res = append(res, validationMarker(name, strconv.FormatFloat(*value, 'f', -1, 64)))
+		}
+	}
+	addInt("MinLength", v.MinLength)
+	addInt("MaxLength", v.MaxLength)
+	addFloat("Minimum", v.Minimum)
+	addFloat("Maximum", v.Maximum)
+	addInt("MinItems", v.MinItems)
+	addInt("MaxItems", v.MaxItems)
+	if v.Pattern != "" {
+		res = append(res, validationMarker("Pattern", markerString(v.Pattern)))
+	}
+	if len(v.Enum) > 0 {
+		values := make([]string, 0, len(v.Enum))
+		for _, value := range v.Enum {
+			values = append(values, markerString(value))
+		}
+		res = append(res, validationMarker("Enum", strings.Join(values, ";")))
+	}
+	return res
+}
+
+// validationMarker returns a `+kubebuilder:validation` marker
+func validationMarker(name string, value string) string {
+	return "+kubebuilder:validation:" + name + "=" + value
+}
+
+// markerIdentifierRegexp matches the string values that controller-gen
+// doesn't need to be quoted in markers
+var markerIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_\-]*$`)
+
+// markerString returns a string value of a marker, quoted when controller-gen
+// would otherwise fail to parse it or guess another type for it
+func markerString(value string) string {
+	if markerIdentifierRegexp.MatchString(value) {
+		return value
+	}
+	if !strings.Contains(value, "`") {
+		return "`" + value + "`"
+	}
+	return strconv.Quote(value)
+}
+
+// ShapeValidation returns the OpenAPI validation constraints of the supplied
+// shape, derived from its API model constraints, or nil if it has none.
+//
+// Minimum lengths and numbers of items that are zero are omitted since they
+// constrain nothing, as are the patterns that aren't valid RE2 regular
+// expressions (the API server rejects them).
+func (a *SDKAPI) ShapeValidation(shape *awssdkmodel.Shape) *FieldValidation {
+	if shape == nil {
+		return nil
+	}
+	res := &FieldValidation{}
+	var c *shapeConstraints
+	// The aws-sdk-go API model loader renames the operations' input and
+	// output shapes.
+	for _, shapeName := range []string{shape.ShapeName, shape.OrigShapeName} {
+		if c = a.shapeConstraints[shapeName]; c != nil {
+			break
+		}
+	}
+	if c == nil {
+		c = &shapeConstraints{}
+	}
+	switch shape.Type {
+	case "string":
+		res.MinLength = positiveInt64(c.Min)
+		res.MaxLength = toInt64(c.Max)
+		if _, err := regexp.Compile(c.Pattern); err == nil {
+			res.Pattern = c.Pattern
+		}
+		res.Enum = shape.Enum
+	case "list":
+		res.MinItems = positiveInt64(c.Min)
+		res.MaxItems = toInt64(c.Max)
+	case "integer", "long", "float", "double":
+		res.Minimum = c.Min
+		res.Maximum = c.Max
+	}
+	if res.IsEmpty() {
+		return nil
+	}
+	return res
+}
+
+// toInt64 converts a constraint to an int64
+func toInt64(value *float64) *int64 {
+	if value == nil {
+		return nil
+	}
+	res := int64(*value)
+	return &res
+}
+
+// positiveInt64 converts a constraint to an int64, returning nil unless it's
+// positive
+func positiveInt64(value *float64) *int64 {
+	if value == nil || *value <= 0 {
+		return nil
+	}
+	return toInt64(value)
+}
+
+// applyValidationConfig returns the validation constraints of a field,
+// starting from the supplied constraints derived from the API model and
+// applying the supplied config
+func applyValidationConfig(
+	v *FieldValidation,
+	cfg *ackgenconfig.ValidationFieldConfig,
+) *FieldValidation {
+	if cfg == nil {
+		return v
+	}
+	res := &FieldValidation{}
+	if v != nil && !cfg.IsIgnored {
+		*res = *v
+	}
+	ignore := func(name string) bool {
+		return util.InStrings(name, cfg.Ignore)
+	}
+	if ignore("min_length") {
+		res.MinLength = nil
+	}
+	if ignore("max_length") {
+		res.MaxLength = nil
+	}
+	if ignore("minimum") {
+		res.Minimum = nil
+	}
+	if ignore("maximum") {
+		res.Maximum = nil
+	}
+	if ignore("min_items") {
+		res.MinItems = nil
+	}
+	if ignore("max_items") {
+		res.MaxItems = nil
+	}
+	if ignore("pattern") {
+		res.Pattern = ""
+	}
+	if ignore("enum") {
+		res.Enum = nil
+	}
+	if cfg.MinLength != nil {
+		res.MinLength = cfg.MinLength
+	}
+	if cfg.MaxLength != nil {
+		res.MaxLength = cfg.MaxLength
+	}
+	if cfg.Minimum != nil {
+		res.Minimum = cfg.Minimum
+	}
+	if cfg.Maximum != nil {
+		res.Maximum = cfg.Maximum
+	}
+	if cfg.MinItems != nil {
+		res.MinItems = cfg.MinItems
+	}
+	if cfg.MaxItems != nil {
+		res.MaxItems = cfg.MaxItems
+	}
+	if cfg.Pattern != nil {
+		res.Pattern = *cfg.Pattern
+	}
+	if cfg.Enum != nil {
+		res.Enum = cfg.Enum
+	}
+	if res.IsEmpty() {
+		return nil
+	}
+	return res
+}
+
+// restrictValidationToGoType drops the constraints that don't apply to the
+// supplied Go type, e.g. the length of a string field whose type was replaced
+// by a SecretKeyReference
+func restrictValidationToGoType(v *FieldValidation, goType string) *FieldValidation {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	if goType != "*string" && goType != "string" {
+		res.MinLength, res.MaxLength, res.Pattern, res.Enum = nil, nil, "", nil
+	}
+	switch strings.TrimPrefix(goType, "*") {
+	case "int64", "int32", "float64", "float32":
+	default:
+		res.Minimum, res.Maximum = nil, nil
+	}
+	if !strings.HasPrefix(goType, "[]") {
+		res.MinItems, res.MaxItems = nil, nil
+	}
+	if res.IsEmpty() {
+		return nil
+	}
+	return &res
+}
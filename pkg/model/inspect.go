@@ -0,0 +1,273 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
+)
+
+// Inspection describes how the code generator mapped the operations and
+// shapes of an AWS service API to custom resources. It helps understanding
+// why a CRD is missing or has unexpected fields.
+type Inspection struct {
+	// Operations contains every API operation, sorted by name
+	Operations []*OperationInspection `json:"operations"`
+	// CRDs contains every custom resource, sorted by kind
+	CRDs []*CRDInspection `json:"crds"`
+}
+
+// OperationInspection describes how an API operation was mapped to a custom
+// resource
+type OperationInspection struct {
+	Name string `json:"name"`
+	// InferredOpType is the operation type guessed from the operation name
+	InferredOpType string `json:"inferred_op_type"`
+	// InferredResourceName is the resource name guessed from the operation
+	// name
+	InferredResourceName string `json:"inferred_resource_name"`
+	// OpTypes contains the operation types once the generator config
+	// overrides are applied
+	OpTypes []string `json:"op_types"`
+	// ResourceName is the resource name once the generator config overrides
+	// are applied
+	ResourceName string `json:"resource_name"`
+	// Overrides contains the generator config settings that changed the
+	// inferred operation type or resource name
+	Overrides []string `json:"overrides,omitempty"`
+	// Ignored contains the generator config setting that ignores the
+	// operation or its resource, if any
+	Ignored string `json:"ignored,omitempty"`
+	// CRDs contains the kinds of the custom resources using the operation,
+	// along with the operation type they use it for. e.g. "Repository
+	// (Create)"
+	CRDs []string `json:"crds,omitempty"`
+}
+
+// CRDInspection describes how a custom resource was built from the API
+type CRDInspection struct {
+	Kind string `json:"kind"`
+	// Operations contains the name of the operations of the custom resource,
+	// keyed by operation type
+	Operations   map[string]string  `json:"operations"`
+	SpecFields   []*FieldInspection `json:"spec_fields"`
+	StatusFields []*FieldInspection `json:"status_fields"`
+	// IgnoredFields contains the members of the Create operation's input and
+	// output shapes removed by an ignore rule
+	IgnoredFields []*FieldInspection `json:"ignored_fields,omitempty"`
+}
+
+// FieldInspection describes where a custom resource field comes from
+type FieldInspection struct {
+	Name   string `json:"name"`
+	GoType string `json:"go_type,omitempty"`
+	// SourceOperation is the name of the operation the field is taken from
+	SourceOperation string `json:"source_operation,omitempty"`
+	// SourcePath is the path of the field in the SourceOperation's input or
+	// output shape
+	SourcePath string `json:"source_path,omitempty"`
+	// RenamedFrom is the original member name of a renamed field
+	RenamedFrom string `json:"renamed_from,omitempty"`
+	// Rule is the generator config setting that added or removed the field,
+	// if any
+	Rule string `json:"rule,omitempty"`
+}
+
+// Inspect returns an Inspection of the model
+func (m *Model) Inspect() (*Inspection, error) {
+	crds, err := m.GetCRDs()
+	if err != nil {
+		return nil, err
+	}
+	crdOps := map[string][]string{}
+	res := &Inspection{}
+	for _, crd := range crds {
+		crdInspection := m.inspectCRD(crd)
+		for opType, opName := range crdInspection.Operations {
+			crdOps[opName] = append(crdOps[opName], fmt.Sprintf("%s (%s)", crd.Kind, opType))
+		}
+		res.CRDs = append(res.CRDs, crdInspection)
+	}
+
+	opNames := make([]string, 0, len(m.SDKAPI.API.Operations))
+	for opName := range m.SDKAPI.API.Operations {
+		opNames = append(opNames, opName)
+	}
+	sort.Strings(opNames)
+	for _, opName := range opNames {
+		opInspection := m.inspectOperation(opName)
+		opInspection.CRDs = crdOps[opName]
+		sort.Strings(opInspection.CRDs)
+		res.Operations = append(res.Operations, opInspection)
+	}
+	return res, nil
+}
+
+// inspectOperation returns how the supplied operation is mapped to a custom
+// resource by `getOpTypeAndResourceName`
+func (m *Model) inspectOperation(opName string) *OperationInspection {
+	inferredOpType, inferredResName := GetOpTypeAndResourceNameFromOpID(opName)
+	opTypes, resName := getOpTypeAndResourceName(opName, m.cfg)
+	res := &OperationInspection{
+		Name:                 opName,
+		InferredOpType:       inferredOpType.String(),
+		InferredResourceName: inferredResName,
+		ResourceName:         resName,
+	}
+	for _, opType := range opTypes {
+		res.OpTypes = append(res.OpTypes, opType.String())
+	}
+	if opConfig, found := m.cfg.Operations[opName]; found {
+		if opConfig.ResourceName != "" {
+			res.Overrides = append(res.Overrides, fmt.Sprintf(
+				"operations.%s.resource_name: %s", opName, opConfig.ResourceName,
+			))
+		}
+		if len(opConfig.OperationType) > 0 {
+			res.Overrides = append(res.Overrides, fmt.Sprintf(
+				"operations.%s.operation_type: %s",
+				opName, strings.Join(opConfig.OperationType, ", "),
+			))
+		}
+	}
+	if util.InStrings(opName, m.cfg.Ignore.Operations) {
+		res.Ignored = "ignore.operations"
+	} else if m.cfg.IsIgnoredResource(resName) {
+		res.Ignored = "ignore.resource_names"
+	}
+	return res
+}
+
+// inspectCRD returns where the fields of the supplied custom resource come
+// from. It mirrors the way `GetCRDs` builds the Spec and Status fields.
+func (m *Model) inspectCRD(crd *CRD) *CRDInspection {
+	res := &CRDInspection{
+		Kind:       crd.Kind,
+		Operations: map[string]string{},
+	}
+	for opType, op := range map[OpType]*awssdkmodel.Operation{
+		OpTypeCreate:        crd.Ops.Create,
+		OpTypeGet:           crd.Ops.ReadOne,
+		OpTypeList:          crd.Ops.ReadMany,
+		OpTypeUpdate:        crd.Ops.Update,
+		OpTypeDelete:        crd.Ops.Delete,
+		OpTypeGetAttributes: crd.Ops.GetAttributes,
+		OpTypeSetAttributes: crd.Ops.SetAttributes,
+	} {
+		if op != nil {
+			res.Operations[opType.String()] = op.Name
+		}
+	}
+
+	createOp := crd.Ops.Create
+	inputShape := createOp.InputRef.Shape
+	outputShape := createOp.OutputRef.Shape
+	outputPathPrefix := ""
+	if outputShape.UsedAsOutput && len(outputShape.MemberRefs) == 1 {
+		for memberName, memberRef := range outputShape.MemberRefs {
+			if memberRef.Shape.Type == "structure" {
+				outputPathPrefix = memberName + "."
+				outputShape = memberRef.Shape
+			}
+		}
+	}
+
+	for _, fieldName := range crd.SpecFieldNames() {
+		field := crd.SpecFields[fieldName]
+		res.SpecFields = append(res.SpecFields, m.inspectField(
+			crd, fieldName, field, createOp, inputShape, "",
+		))
+	}
+	for _, fieldName := range crd.StatusFieldNames() {
+		field := crd.StatusFields[fieldName]
+		res.StatusFields = append(res.StatusFields, m.inspectField(
+			crd, fieldName, field, createOp, outputShape, outputPathPrefix,
+		))
+	}
+
+	for _, shape := range []*awssdkmodel.Shape{inputShape, outputShape} {
+		ignored := m.ignoredMembers[shape.ShapeName]
+		memberNames := make([]string, 0, len(ignored))
+		for memberName := range ignored {
+			memberNames = append(memberNames, memberName)
+		}
+		sort.Strings(memberNames)
+		for _, memberName := range memberNames {
+			res.IgnoredFields = append(res.IgnoredFields, &FieldInspection{
+				Name:            memberName,
+				SourceOperation: createOp.Name,
+				SourcePath:      shape.ShapeName + "." + memberName,
+				Rule:            ignored[memberName],
+			})
+		}
+	}
+	return res
+}
+
+// inspectField returns where a top-level field of a custom resource comes
+// from: a member of the supplied Create operation shape, a `from` field
+// config or the attributes map
+func (m *Model) inspectField(
+	crd *CRD,
+	fieldName string,
+	field *Field,
+	createOp *awssdkmodel.Operation,
+	shape *awssdkmodel.Shape,
+	pathPrefix string,
+) *FieldInspection {
+	res := &FieldInspection{
+		Name:   field.Names.Camel,
+		GoType: field.GoType,
+	}
+	fieldConfig := field.FieldConfig
+	if fieldConfig != nil && fieldConfig.From != nil {
+		res.SourceOperation = fieldConfig.From.Operation
+		res.SourcePath = fieldConfig.From.Path
+		res.Rule = fmt.Sprintf("resources.%s.fields.%s.from", crd.Names.Original, fieldName)
+		return res
+	}
+	if fieldConfig != nil && fieldConfig.IsAttribute {
+		attrOp := crd.Ops.GetAttributes
+		if !fieldConfig.IsReadOnly && crd.Ops.SetAttributes != nil {
+			attrOp = crd.Ops.SetAttributes
+		}
+		if attrOp != nil {
+			res.SourceOperation = attrOp.Name
+		}
+		res.SourcePath = "Attributes." + fieldName
+		res.Rule = fmt.Sprintf("resources.%s.fields.%s.is_attribute", crd.Names.Original, fieldName)
+		return res
+	}
+	for _, memberName := range shape.MemberNames() {
+		renamedName, _ := crd.InputFieldRename(createOp.Name, memberName)
+		if renamedName != fieldName {
+			continue
+		}
+		res.SourceOperation = createOp.Name
+		res.SourcePath = pathPrefix + memberName
+		if renamedName != memberName {
+			res.RenamedFrom = memberName
+			res.Rule = fmt.Sprintf(
+				"resources.%s.renames.operations.%s", crd.Names.Original, createOp.Name,
+			)
+		}
+		break
+	}
+	return res
+}
@@ -0,0 +1,149 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestInspect_ECR(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-inspect.yaml",
+	})
+
+	inspection, err := g.Inspect()
+	require.Nil(err)
+
+	ops := map[string]*model.OperationInspection{}
+	for _, op := range inspection.Operations {
+		ops[op.Name] = op
+	}
+
+	assert.Equal(
+		&model.OperationInspection{
+			Name:                 "CreateRepository",
+			InferredOpType:       "Create",
+			InferredResourceName: "Repository",
+			OpTypes:              []string{"Create"},
+			ResourceName:         "Repository",
+			CRDs:                 []string{"Repository (Create)"},
+		},
+		ops["CreateRepository"],
+	)
+	assert.Equal(
+		&model.OperationInspection{
+			Name:                 "PutImageScanningConfiguration",
+			InferredOpType:       "Unknown",
+			InferredResourceName: "PutImageScanningConfiguration",
+			OpTypes:              []string{"Unknown", "Update"},
+			ResourceName:         "Repository",
+			Overrides: []string{
+				"operations.PutImageScanningConfiguration.resource_name: Repository",
+				"operations.PutImageScanningConfiguration.operation_type: Update",
+			},
+			CRDs: []string{"Repository (Update)"},
+		},
+		ops["PutImageScanningConfiguration"],
+	)
+	assert.Equal("ignore.operations", ops["DescribeImageScanFindings"].Ignored)
+	assert.Empty(ops["DescribeImageScanFindings"].CRDs)
+
+	require.Len(inspection.CRDs, 1)
+	crd := inspection.CRDs[0]
+	assert.Equal("Repository", crd.Kind)
+	assert.Equal(
+		map[string]string{
+			"Create": "CreateRepository",
+			"Delete": "DeleteRepository",
+			"List":   "DescribeRepositories",
+			"Update": "PutImageScanningConfiguration",
+		},
+		crd.Operations,
+	)
+	assert.Equal(
+		[]*model.FieldInspection{
+			{
+				Name:            "ImageScanningConfiguration",
+				GoType:          "*ImageScanningConfiguration",
+				SourceOperation: "CreateRepository",
+				SourcePath:      "ImageScanningConfiguration",
+			},
+			{
+				Name:            "ImageTagMutability",
+				GoType:          "*string",
+				SourceOperation: "CreateRepository",
+				SourcePath:      "ImageTagMutability",
+			},
+			{
+				Name:            "LifecyclePolicyText",
+				GoType:          "*string",
+				SourceOperation: "PutLifecyclePolicy",
+				SourcePath:      "LifecyclePolicyText",
+				Rule:            "resources.Repository.fields.LifecyclePolicyText.from",
+			},
+			{
+				Name:            "Name",
+				GoType:          "*string",
+				SourceOperation: "CreateRepository",
+				SourcePath:      "RepositoryName",
+				RenamedFrom:     "RepositoryName",
+				Rule:            "resources.Repository.renames.operations.CreateRepository",
+			},
+		},
+		crd.SpecFields,
+	)
+	assert.Equal(
+		[]*model.FieldInspection{
+			{
+				Name:            "CreatedAt",
+				GoType:          "*metav1.Time",
+				SourceOperation: "CreateRepository",
+				SourcePath:      "Repository.CreatedAt",
+			},
+			{
+				Name:            "RegistryID",
+				GoType:          "*string",
+				SourceOperation: "CreateRepository",
+				SourcePath:      "Repository.RegistryId",
+			},
+			{
+				Name:            "RepositoryURI",
+				GoType:          "*string",
+				SourceOperation: "CreateRepository",
+				SourcePath:      "Repository.RepositoryUri",
+			},
+		},
+		crd.StatusFields,
+	)
+	assert.Equal(
+		[]*model.FieldInspection{
+			{
+				Name:            "Tags",
+				SourceOperation: "CreateRepository",
+				SourcePath:      "CreateRepositoryInput.Tags",
+				Rule:            "ignore.field_paths: CreateRepositoryInput.Tags",
+			},
+		},
+		crd.IgnoredFields,
+	)
+}
@@ -41,6 +41,11 @@ type Model struct {
 	typeDefs     []*TypeDef
 	typeImports  map[string]string
 	typeRenames  map[string]string
+	// Problems found in the generator config while building the CRDs
+	crdErrs ackgenconfig.ValidationErrors
+	// Members removed by the ignore rules, keyed by shape name and member
+	// name. The values are the generator config settings that removed them.
+	ignoredMembers map[string]map[string]string
 	// Instructions to the code generator how to handle the API and its
 	// resources
 	cfg *ackgenconfig.Config
@@ -73,12 +78,20 @@ func (m *Model) crdNames() []string {
 }
 
 // GetCRDs returns a slice of `CRD` structs that describe the
-// top-level resources discovered by the code generator for an AWS service API
+// top-level resources discovered by the code generator for an AWS service API.
+//
+// Problems with the generator config of a resource, such as a `from` field
+// config naming an unknown operation, don't stop the processing of the other
+// resources: all of them are returned as `ackgenconfig.ValidationErrors`.
 func (m *Model) GetCRDs() ([]*CRD, error) {
+	if len(m.crdErrs) > 0 {
+		return nil, m.crdErrs
+	}
 	if m.crds != nil {
 		return m.crds, nil
 	}
 	crds := []*CRD{}
+	errs := ackgenconfig.ValidationErrors{}
 
 	opMap := m.SDKAPI.GetOperationMap(m.cfg)
 
@@ -89,20 +102,44 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 	deleteOps := (*opMap)[OpTypeDelete]
 	getAttributesOps := (*opMap)[OpTypeGetAttributes]
 	setAttributesOps := (*opMap)[OpTypeSetAttributes]
-
+	replaceOps := (*opMap)[OpTypeReplace]
+	addChildOps := (*opMap)[OpTypeAddChild]
+	addChildrenOps := (*opMap)[OpTypeAddChildren]
+	removeChildOps := (*opMap)[OpTypeRemoveChild]
+	removeChildrenOps := (*opMap)[OpTypeRemoveChildren]
+
+	// Resources without a Create operation are created with their Replace
+	// operation, if any
+	crdCreateOps := map[string]*awssdkmodel.Operation{}
+	for crdName, replaceOp := range replaceOps {
+		crdCreateOps[crdName] = replaceOp
+	}
 	for crdName, createOp := range createOps {
+		crdCreateOps[crdName] = createOp
+	}
+
+	for crdName, createOp := range crdCreateOps {
 		if m.cfg.IsIgnoredResource(crdName) {
 			continue
 		}
+		resPath := []string{"resources", crdName}
 		crdNames := names.New(crdName)
 		ops := Ops{
-			Create:        createOps[crdName],
-			ReadOne:       readOneOps[crdName],
-			ReadMany:      readManyOps[crdName],
-			Update:        updateOps[crdName],
-			Delete:        deleteOps[crdName],
-			GetAttributes: getAttributesOps[crdName],
-			SetAttributes: setAttributesOps[crdName],
+			Create:         createOp,
+			ReadOne:        readOneOps[crdName],
+			ReadMany:       readManyOps[crdName],
+			Update:         updateOps[crdName],
+			Delete:         deleteOps[crdName],
+			GetAttributes:  getAttributesOps[crdName],
+			SetAttributes:  setAttributesOps[crdName],
+			Replace:        replaceOps[crdName],
+			AddChild:       addChildOps[crdName],
+			AddChildren:    addChildrenOps[crdName],
+			RemoveChild:    removeChildOps[crdName],
+			RemoveChildren: removeChildrenOps[crdName],
+		}
+		if ops.Update == nil {
+			ops.Update = ops.Replace
 		}
 		m.RemoveIgnoredOperations(&ops)
 		crd := NewCRD(m.SDKAPI, m.cfg, crdNames, ops)
@@ -112,11 +149,19 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 		// Shape.
 		inputShape := createOp.InputRef.Shape
 		if inputShape == nil {
-			return nil, ErrNilShapePointer
+			errs = append(errs, m.cfg.NewValidationError(
+				resPath, "%v: input shape of operation %s",
+				ErrNilShapePointer, createOp.Name,
+			))
+			continue
 		}
 		for memberName, memberShapeRef := range inputShape.MemberRefs {
 			if memberShapeRef.Shape == nil {
-				return nil, ErrNilShapePointer
+				errs = append(errs, m.cfg.NewValidationError(
+					resPath, "%v: member %s of shape %s",
+					ErrNilShapePointer, memberName, inputShape.ShapeName,
+				))
+				continue
 			}
 			renamedName, _ := crd.InputFieldRename(
 				createOp.Name, memberName,
@@ -149,12 +194,11 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 				memberNames := names.New(targetFieldName)
 				crd.AddSpecField(memberNames, memberShapeRef)
 			} else {
-				// This is a compile-time failure, just bomb out...
-				msg := fmt.Sprintf(
-					"unknown additional Spec field with Op: %s and Path: %s",
+				errs = append(errs, m.cfg.NewValidationError(
+					append(resPath, "fields", targetFieldName, "from"),
+					"unknown additional Spec field with operation %s and path %s",
 					from.Operation, from.Path,
-				)
-				panic(msg)
+				))
 			}
 		}
 
@@ -174,7 +218,11 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 		}
 		for memberName, memberShapeRef := range outputShape.MemberRefs {
 			if memberShapeRef.Shape == nil {
-				return nil, ErrNilShapePointer
+				errs = append(errs, m.cfg.NewValidationError(
+					resPath, "%v: member %s of shape %s",
+					ErrNilShapePointer, memberName, outputShape.ShapeName,
+				))
+				continue
 			}
 			// Check that the field in the output shape isn't the same as
 			// fields in the input shape (where the input shape has potentially
@@ -218,15 +266,21 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 				memberNames := names.New(targetFieldName)
 				crd.AddStatusField(memberNames, memberShapeRef)
 			} else {
-				// This is a compile-time failure, just bomb out...
-				msg := fmt.Sprintf(
-					"unknown additional Status field with Op: %s and Path: %s",
+				errs = append(errs, m.cfg.NewValidationError(
+					append(resPath, "fields", targetFieldName, "from"),
+					"unknown additional Status field with operation %s and path %s",
 					from.Operation, from.Path,
-				)
-				panic(msg)
+				))
 			}
 		}
 
+		errs = append(errs, crd.checkReferenceFields()...)
+		errs = append(errs, crd.addChildFields()...)
+		errs = append(errs, crd.addSubResources()...)
+		errs = append(errs, crd.checkTypeOverrides()...)
+		errs = append(errs, crd.checkPrinterColumns()...)
+		errs = append(errs, crd.checkOperationConfigs()...)
+
 		crds = append(crds, crd)
 	}
 	sort.Slice(crds, func(i, j int) bool {
@@ -236,6 +290,19 @@ func (m *Model) GetCRDs() ([]*CRD, error) {
 	// `pkg/model.Field` objects that represent the non-top-level Spec and
 	// Status fields.
 	m.processNestedFields(crds)
+	for _, crd := range crds {
+		errs = append(errs, crd.checkDefaults()...)
+		errs = append(errs, crd.checkCompareConfigs()...)
+		errs = append(errs, crd.checkSyncedRules()...)
+		errs = append(errs, crd.checkWaiterConfig()...)
+		errs = append(errs, crd.checkDeleteConfig()...)
+		errs = append(errs, crd.checkExceptionRules()...)
+	}
+	if len(errs) > 0 {
+		errs.Sort()
+		m.crdErrs = errs
+		return nil, errs
+	}
 	m.crds = crds
 	return crds, nil
 }
@@ -265,6 +332,21 @@ func (m *Model) RemoveIgnoredOperations(ops *Ops) {
 	if m.cfg.IsIgnoredOperation(ops.SetAttributes) {
 		ops.SetAttributes = nil
 	}
+	if m.cfg.IsIgnoredOperation(ops.Replace) {
+		ops.Replace = nil
+	}
+	if m.cfg.IsIgnoredOperation(ops.AddChild) {
+		ops.AddChild = nil
+	}
+	if m.cfg.IsIgnoredOperation(ops.AddChildren) {
+		ops.AddChildren = nil
+	}
+	if m.cfg.IsIgnoredOperation(ops.RemoveChild) {
+		ops.RemoveChild = nil
+	}
+	if m.cfg.IsIgnoredOperation(ops.RemoveChildren) {
+		ops.RemoveChildren = nil
+	}
 }
 
 // IsShapeUsedInCRDs returns true if the supplied shape name is a member of amy
@@ -284,6 +366,10 @@ func (m *Model) GetTypeDefs() ([]*TypeDef, error) {
 	if m.typeDefs != nil {
 		return m.typeDefs, nil
 	}
+	// The type defs depend on the shapes used by the CRDs
+	if _, err := m.GetCRDs(); err != nil {
+		return nil, err
+	}
 
 	tdefs := []*TypeDef{}
 	// Map, keyed by original Shape GoTypeElem(), with the values being a
@@ -291,6 +377,13 @@ func (m *Model) GetTypeDefs() ([]*TypeDef, error) {
 	trenames := map[string]string{}
 
 	payloads := m.SDKAPI.GetPayloads()
+	specShapeNames := map[string]bool{}
+	statusShapeNames := map[string]bool{}
+	crds, _ := m.GetCRDs()
+	for _, crd := range crds {
+		collectFieldShapeNames(crd.SpecFields, specShapeNames)
+		collectFieldShapeNames(crd.StatusFields, statusShapeNames)
+	}
 
 	for shapeName, shape := range m.SDKAPI.API.Shapes {
 		if util.InStrings(shapeName, payloads) && !m.IsShapeUsedInCRDs(shapeName) {
@@ -319,6 +412,13 @@ func (m *Model) GetTypeDefs() ([]*TypeDef, error) {
 			}
 			gt := m.getShapeCleanGoType(memberShape)
 			attrs[memberName] = NewAttr(memberNames, gt, memberShape)
+			// The observed state of a resource is written as returned by the
+			// AWS service and must never be rejected by the Kubernetes API
+			// server, so only the types used in Spec fields alone have
+			// validation constraints
+			if specShapeNames[shapeName] && !statusShapeNames[shapeName] {
+				attrs[memberName].Validation = m.SDKAPI.ShapeValidation(memberShape)
+			}
 		}
 		if len(attrs) == 0 {
 			// Just ignore these...
@@ -333,12 +433,47 @@ func (m *Model) GetTypeDefs() ([]*TypeDef, error) {
 	sort.Slice(tdefs, func(i, j int) bool {
 		return tdefs[i].Names.Camel < tdefs[j].Names.Camel
 	})
-	m.processNestedFieldTypeDefs(tdefs)
+	if errs := m.processNestedFieldTypeDefs(tdefs); len(errs) > 0 {
+		return nil, errs
+	}
 	m.typeDefs = tdefs
 	m.typeRenames = trenames
 	return tdefs, nil
 }
 
+// collectFieldShapeNames adds the names of the structure shapes used by the
+// supplied fields, directly or nested in other shapes, to a set of shape names
+func collectFieldShapeNames(
+	fields map[string]*Field,
+	shapeNames map[string]bool,
+) {
+	var collect func(shape *awssdkmodel.Shape)
+	collect = func(shape *awssdkmodel.Shape) {
+		if shape == nil {
+			return
+		}
+		switch shape.Type {
+		case "structure":
+			if shapeNames[shape.ShapeName] {
+				return
+			}
+			shapeNames[shape.ShapeName] = true
+			for _, memberRef := range shape.MemberRefs {
+				collect(memberRef.Shape)
+			}
+		case "list":
+			collect(shape.MemberRef.Shape)
+		case "map":
+			collect(shape.ValueRef.Shape)
+		}
+	}
+	for _, field := range fields {
+		if field.ShapeRef != nil {
+			collect(field.ShapeRef.Shape)
+		}
+	}
+}
+
 // getShapeCleanGoType returns a cleaned-up and Camel-cased GoType name for a given shape.
 func (m *Model) getShapeCleanGoType(shape *awssdkmodel.Shape) string {
 	switch shape.Type {
@@ -372,10 +507,12 @@ func (m *Model) getShapeCleanGoType(shape *awssdkmodel.Shape) string {
 
 // processNestedFieldTypeDefs updates the supplied TypeDef structs' if a nested
 // field has been configured with a type overriding FieldConfig -- such as
-// FieldConfig.IsSecret.
+// FieldConfig.IsSecret -- or with a default value, and returns the nested
+// fields whose TypeDef can't be updated.
 func (m *Model) processNestedFieldTypeDefs(
 	tdefs []*TypeDef,
-) {
+) ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
 	crds, _ := m.GetCRDs()
 	for _, crd := range crds {
 		for fieldPath, field := range crd.Fields {
@@ -399,36 +536,67 @@ func (m *Model) processNestedFieldTypeDefs(
 				// path `Users..Password`, we'd want to find the TypeDef that
 				// was created for the `Users` field's element type (which is a
 				// struct)
-				replaceSecretAttrGoType(crd, field, tdefs)
+				if err := replaceSecretAttrGoType(crd, field, tdefs); err != nil {
+					errs = append(errs, crd.newConfigError(
+						[]string{"fields", fieldPath, "is_secret"}, "%v", err,
+					))
+				}
+			}
+			if fieldDefault := field.Default(); fieldDefault != nil && !fieldDefault.IsComputed() {
+				attr, err := findNestedFieldAttr(crd, field, tdefs)
+				if err != nil {
+					errs = append(errs, crd.newConfigError(
+						[]string{"fields", fieldPath, "default"}, "%v", err,
+					))
+					continue
+				}
+				attr.Default = fieldDefault
 			}
 		}
 	}
+	errs.Sort()
+	return errs
 }
 
 // replaceSecretAttrGoType replaces a nested field Attr's GoType with
-// `*ackv1alpha1.SecretKeyReference`.
+// `*ackv1alpha1.SecretKeyReference`, or returns an error if the Attr can't be
+// found.
 func replaceSecretAttrGoType(
 	crd *CRD,
 	field *Field,
 	tdefs []*TypeDef,
-) {
+) error {
+	attr, err := findNestedFieldAttr(crd, field, tdefs)
+	if err != nil {
+		return err
+	}
+	attr.GoType = "*ackv1alpha1.SecretKeyReference"
+	return nil
+}
+
+// findNestedFieldAttr returns the Attr of a nested field in the TypeDef
+// created for the field's *containing* struct, or an error if it can't be
+// found
+func findNestedFieldAttr(
+	crd *CRD,
+	field *Field,
+	tdefs []*TypeDef,
+) (*Attr, error) {
 	fieldPath := field.Path
 	parentFieldPath := ParentFieldPath(field.Path)
 	parentField, ok := crd.Fields[parentFieldPath]
 	if !ok {
-		msg := fmt.Sprintf(
-			"Cannot find parent field at parent path %s for %s",
+		return nil, fmt.Errorf(
+			"cannot find parent field at parent path %s for %s",
 			parentFieldPath,
 			fieldPath,
 		)
-		panic(msg)
 	}
 	if parentField.ShapeRef == nil {
-		msg := fmt.Sprintf(
-			"parent field at parent path %s has a nil ShapeRef!",
+		return nil, fmt.Errorf(
+			"parent field at parent path %s has a nil ShapeRef",
 			parentFieldPath,
 		)
-		panic(msg)
 	}
 	parentFieldShape := parentField.ShapeRef.Shape
 	parentFieldShapeName := parentField.ShapeRef.ShapeName
@@ -437,22 +605,20 @@ func replaceSecretAttrGoType(
 	// type, since that's the type def we need to modify.
 	if parentFieldShapeType == "list" {
 		if parentFieldShape.MemberRef.Shape.Type != "structure" {
-			msg := fmt.Sprintf(
-				"parent field at parent path %s is a list type with a non-structure element member shape %s!",
+			return nil, fmt.Errorf(
+				"parent field at parent path %s is a list type with a non-structure element member shape %s",
 				parentFieldPath,
 				parentFieldShape.MemberRef.Shape.Type,
 			)
-			panic(msg)
 		}
 		parentFieldShapeName = parentField.ShapeRef.Shape.MemberRef.ShapeName
 	} else if parentFieldShapeType == "map" {
 		if parentFieldShape.ValueRef.Shape.Type != "structure" {
-			msg := fmt.Sprintf(
-				"parent field at parent path %s is a map type with a non-structure value member shape %s!",
+			return nil, fmt.Errorf(
+				"parent field at parent path %s is a map type with a non-structure value member shape %s",
 				parentFieldPath,
 				parentFieldShape.ValueRef.Shape.Type,
 			)
-			panic(msg)
 		}
 		parentFieldShapeName = parentField.ShapeRef.Shape.ValueRef.ShapeName
 	}
@@ -463,27 +629,23 @@ func replaceSecretAttrGoType(
 		}
 	}
 	if parentTypeDef == nil {
-		msg := fmt.Sprintf(
+		return nil, fmt.Errorf(
 			"unable to find associated TypeDef for parent field "+
-				"at parent path %s!",
+				"at parent path %s",
 			parentFieldPath,
 		)
-		panic(msg)
 	}
-	// Now we modify the parent type def's Attr that corresponds to
-	// the secret field...
 	attr, found := parentTypeDef.Attrs[field.Names.Camel]
 	if !found {
-		msg := fmt.Sprintf(
+		return nil, fmt.Errorf(
 			"unable to find attr %s in parent TypeDef %s "+
-				"at parent path %s!",
+				"at parent path %s",
 			field.Names.Camel,
 			parentTypeDef.Names.Original,
 			parentFieldPath,
 		)
-		panic(msg)
 	}
-	attr.GoType = "*ackv1alpha1.SecretKeyReference"
+	return attr, nil
 }
 
 // processNestedFields is responsible for walking all of the CRDs' Spec and
@@ -665,6 +827,9 @@ func (m *Model) ApplyShapeIgnoreRules() {
 			if shape.ShapeName != sn {
 				continue
 			}
+			if _, found := shape.MemberRefs[fn]; found {
+				m.addIgnoredMember(sn, fn, "ignore.field_paths: "+fieldpath)
+			}
 			delete(shape.MemberRefs, fn)
 		}
 		for _, sn := range m.cfg.Ignore.ShapeNames {
@@ -675,6 +840,7 @@ func (m *Model) ApplyShapeIgnoreRules() {
 			// NOTE(muvaf): We need to remove the usage of the shape as well.
 			for sdkMemberID, memberRef := range shape.MemberRefs {
 				if memberRef.ShapeName == sn {
+					m.addIgnoredMember(shape.ShapeName, sdkMemberID, "ignore.shape_names: "+sn)
 					delete(shape.MemberRefs, sdkMemberID)
 				}
 			}
@@ -682,6 +848,17 @@ func (m *Model) ApplyShapeIgnoreRules() {
 	}
 }
 
+// addIgnoredMember records that a shape member was removed by an ignore rule
+func (m *Model) addIgnoredMember(shapeName string, memberName string, rule string) {
+	if m.ignoredMembers == nil {
+		m.ignoredMembers = map[string]map[string]string{}
+	}
+	if _, found := m.ignoredMembers[shapeName]; !found {
+		m.ignoredMembers[shapeName] = map[string]string{}
+	}
+	m.ignoredMembers[shapeName][memberName] = rule
+}
+
 // GetConfig returns the configuration option used to define the current
 // generator.
 func (m *Model) GetConfig() *ackgenconfig.Config {
@@ -709,6 +886,11 @@ func New(
 		apiVersion:   apiVersion,
 		cfg:          &cfg,
 	}
+	// The generator config must be validated against the API before the
+	// ignore rules remove shapes and members from it.
+	if errs := ValidateConfig(SDKAPI, &cfg).Errors(); len(errs) > 0 {
+		return nil, errs
+	}
 	m.ApplyShapeIgnoreRules()
 	return m, nil
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
 
@@ -175,3 +176,107 @@ func TestAPIGatewayV2_Route(t *testing.T) {
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
 }
+
+func TestAPIGatewayV2_TypeOverrides(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "apigatewayv2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-type-overrides.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Model", crds)
+	require.NotNil(crd)
+
+	schema := crd.SpecFields["Schema"]
+	require.NotNil(schema)
+	assert.Equal("*apiextensionsv1.JSON", schema.GoType)
+	require.NotNil(schema.TypeOverride())
+	assert.Equal(model.TypeOverrideJSON, schema.TypeOverride().Kind)
+	assert.True(crd.HasTypeOverride(model.TypeOverrideJSON))
+	assert.False(crd.HasTypeOverride(model.TypeOverrideCustom))
+	assert.Equal(
+		map[string]string{
+			"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1": "apiextensionsv1",
+		},
+		crd.TypeImports,
+	)
+
+	crd = getCRDByName("Api", crds)
+	require.NotNil(crd)
+
+	// Types declared in the API package are marshalled as JSON documents
+	body := crd.SpecFields["Body"]
+	require.NotNil(body)
+	assert.Equal("*OpenAPIDocument", body.GoType)
+	require.NotNil(body.TypeOverride())
+	assert.Equal(model.TypeOverrideCustom, body.TypeOverride().Kind)
+	assert.Empty(crd.TypeImports)
+}
+
+func TestAPIGatewayV2_Defaults(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "apigatewayv2", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-defaults.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Api", crds)
+	require.NotNil(crd)
+
+	// Literal defaults are rendered as Go literals and kubebuilder markers
+	apiKeySelection := crd.SpecFields["ApiKeySelectionExpression"]
+	require.NotNil(apiKeySelection)
+	require.NotNil(apiKeySelection.Default())
+	assert.False(apiKeySelection.Default().IsComputed())
+	assert.Equal(`"$request.header.x-api-key"`, apiKeySelection.Default().Value)
+	assert.Equal(
+		`+kubebuilder:default="$request.header.x-api-key"`,
+		apiKeySelection.DefaultMarker(),
+	)
+
+	maxAge := crd.Fields["CORSConfiguration.MaxAge"]
+	require.NotNil(maxAge)
+	require.NotNil(maxAge.Default())
+	assert.Equal("0", maxAge.Default().Value)
+
+	// Computed defaults don't have a marker
+	version := crd.SpecFields["Version"]
+	require.NotNil(version)
+	require.NotNil(version.Default())
+	assert.True(version.Default().IsComputed())
+	assert.Empty(version.DefaultMarker())
+
+	assert.True(crd.HasNestedDefaults("CORSConfiguration"))
+	assert.False(crd.HasNestedDefaults("Tags"))
+
+	// Nested defaults are set on the attributes of the type definitions
+	tdefs, err := g.GetTypeDefs()
+	require.Nil(err)
+	var cors *model.TypeDef
+	for _, tdef := range tdefs {
+		if tdef.Names.Original == "Cors" {
+			cors = tdef
+		}
+	}
+	require.NotNil(cors)
+	require.NotNil(cors.Attrs["MaxAge"])
+	assert.Equal("+kubebuilder:default=0", cors.Attrs["MaxAge"].DefaultMarker())
+	assert.Empty(cors.Attrs["AllowCredentials"].DefaultMarker())
+
+	crd = getCRDByName("Route", crds)
+	require.NotNil(crd)
+
+	// Fields with a default value aren't required
+	routeKey := crd.SpecFields["RouteKey"]
+	require.NotNil(routeKey)
+	assert.False(routeKey.IsRequired())
+	assert.True(crd.HasNestedDefaults("RequestParameters"))
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
 
@@ -102,3 +103,42 @@ func TestDynamoDB_Table(t *testing.T) {
 	}
 	assert.Equal(expSpecFieldCamel, attrCamelNames(specFields))
 }
+
+func TestDynamoDB_Table_ReadinessWaiter(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "dynamodb")
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Table", crds)
+	require.NotNil(crd)
+
+	// The TableExists waiter's acceptor matching the ResourceNotFoundException
+	// error is left out
+	w := crd.ReadinessWaiter()
+	require.NotNil(w)
+	assert.Equal("TableExists", w.Name)
+	require.Len(w.PathAcceptors, 1)
+	assert.Equal("success", w.PathAcceptors[0].State)
+	assert.Equal("path", w.PathAcceptors[0].Matcher)
+	assert.Equal("Table.TableStatus", w.PathAcceptors[0].Argument)
+	assert.Equal("ACTIVE", w.PathAcceptors[0].Expected)
+
+	// The TableNotExists waiter only matches errors
+	g = testutil.NewModelForServiceWithOptions(t, "dynamodb", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-invalid-waiter.yaml",
+	})
+	_, err = g.GetCRDs()
+	require.NotNil(err)
+	errs, ok := err.(ackgenconfig.ValidationErrors)
+	require.True(ok)
+	require.Len(errs, 1)
+	assert.Equal([]string{"resources", "Table", "waiter", "name"}, errs[0].Path)
+	assert.Equal(
+		"waiter TableNotExists has no acceptor matching the output of DescribeTable in the success state",
+		errs[0].Message,
+	)
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
 
@@ -130,3 +132,283 @@ func TestECRRepository(t *testing.T) {
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
 }
+
+func TestECRRepository_ValidationMarkers(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "ecr")
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Repository", crds)
+	require.NotNil(crd)
+
+	specFields := crd.SpecFields
+
+	// The RepositoryName shape looks like this:
+	//
+	//    "RepositoryName":{
+	//      "type":"string",
+	//      "max":256,
+	//      "min":2,
+	//      "pattern":"(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*"
+	//    },
+	assert.Equal(
+		[]string{
+			"+kubebuilder:validation:MinLength=2",
+			"+kubebuilder:validation:MaxLength=256",
+			"+kubebuilder:validation:Pattern=`(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*`",
+		},
+		specFields["RepositoryName"].ValidationMarkers(),
+	)
+	assert.Equal(
+		[]string{"+kubebuilder:validation:Enum=MUTABLE;IMMUTABLE"},
+		specFields["ImageTagMutability"].ValidationMarkers(),
+	)
+	assert.Empty(specFields["ImageScanningConfiguration"].ValidationMarkers())
+
+	// Status fields have no constraints in the API model
+	assert.Empty(crd.StatusFields["CreatedAt"].ValidationMarkers())
+}
+
+func TestECRRepository_ValidationMarkers_WithConfig(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-validation.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Repository", crds)
+	require.NotNil(crd)
+
+	specFields := crd.SpecFields
+	assert.Equal(
+		[]string{
+			"+kubebuilder:validation:MinLength=2",
+			"+kubebuilder:validation:MaxLength=64",
+		},
+		specFields["RepositoryName"].ValidationMarkers(),
+	)
+	assert.Empty(specFields["ImageTagMutability"].ValidationMarkers())
+}
+
+func TestECRRepository_Tagging(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "ecr")
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Repository", crds)
+	require.NotNil(crd)
+
+	tagging := crd.Tagging()
+	require.NotNil(tagging)
+	assert.Equal(crd.SpecFields["Tags"], tagging.Field)
+	assert.Equal(model.TagsFormatList, tagging.Format)
+	assert.Equal("Tag", tagging.TagShapeName)
+	assert.False(tagging.IsNormalized)
+	assert.False(crd.HasNormalizedTags())
+
+	// Without a tags config, the Spec field keeps its API model type
+	assert.Equal("[]*Tag", crd.SpecFields["Tags"].GoType)
+
+	require.NotNil(tagging.TagOp)
+	assert.Equal("TagResource", tagging.TagOp.Operation.Name)
+	assert.Equal("ResourceArn", tagging.TagOp.ResourceMemberName)
+	assert.Equal("Tags", tagging.TagOp.TagsMemberName)
+	assert.Equal(
+		"(*string)(ko.Status.ACKResourceMetadata.ARN)",
+		tagging.TagOp.ResourceVarPath("ko"),
+	)
+
+	require.NotNil(tagging.UntagOp)
+	assert.Equal("UntagResource", tagging.UntagOp.Operation.Name)
+	assert.Equal("TagKeys", tagging.UntagOp.TagsMemberName)
+
+	require.NotNil(tagging.ListTagsOp)
+	assert.Equal("ListTagsForResource", tagging.ListTagsOp.Operation.Name)
+	assert.Equal("Tags", tagging.ListTagsOp.TagsMemberName)
+	assert.Equal(model.TagsFormatList, tagging.ListTagsOp.Format)
+}
+
+func TestECRRepository_Tagging_Normalized(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-tags.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Repository", crds)
+	require.NotNil(crd)
+
+	assert.True(crd.HasNormalizedTags())
+	tagsField := crd.SpecFields["Tags"]
+	assert.Equal("map[string]*string", tagsField.GoType)
+	assert.Equal("*string", tagsField.GoTypeElem)
+	// The shape stays the API model's list of Tag structures
+	assert.Equal("list", tagsField.ShapeRef.Shape.Type)
+}
+
+func TestECRRepository_ConfigErrors(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-crd-errors.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.NotNil(err)
+	assert.Nil(crds)
+
+	// All the problems are reported at once, with their position in the
+	// generator config file
+	errs, ok := err.(ackgenconfig.ValidationErrors)
+	require.True(ok)
+	require.Len(errs, 7)
+	assert.Equal(
+		[]string{"resources", "Repository", "fields", "ImageScanningConfiguration", "type"},
+		errs[0].Path,
+	)
+	assert.Equal(12, errs[0].Line)
+	assert.Equal("type metav1.Duration can't replace a structure shape", errs[0].Message)
+	assert.Equal(
+		[]string{"resources", "Repository", "fields", "ImageScanningConfiguration", "print"},
+		errs[1].Path,
+	)
+	assert.Equal(13, errs[1].Line)
+	assert.Equal(
+		[]string{"resources", "Repository", "fields", "Tags", "references"},
+		errs[2].Path,
+	)
+	assert.Equal(16, errs[2].Line)
+	assert.Contains(errs[2].Message, "only string and list of strings fields can have references")
+	assert.Equal(
+		[]string{"resources", "Repository", "fields", "ImageTagMutability", "default"},
+		errs[3].Path,
+	)
+	assert.Equal(20, errs[3].Line)
+	assert.Equal("a computed default can't have a value", errs[3].Message)
+	assert.Equal(
+		[]string{"resources", "Repository", "fields", "ImageTagMutability", "references", "service_name"},
+		errs[4].Path,
+	)
+	assert.Equal(25, errs[4].Line)
+	assert.Equal("api_version is required to refer to resources of service other", errs[4].Message)
+	assert.Equal(
+		[]string{"resources", "Repository", "fields", "RepositoryName", "compare", "is_set"},
+		errs[5].Path,
+	)
+	assert.Equal(29, errs[5].Line)
+	assert.Equal("field has type string: only list fields can be compared as sets", errs[5].Message)
+	assert.Equal(
+		[]string{"resources", "Repository", "fields", "RepositoryName", "compare", "key"},
+		errs[6].Path,
+	)
+	assert.Equal(30, errs[6].Line)
+	assert.Equal("only lists of structs can be matched by key", errs[6].Message)
+
+	// The errors are returned again instead of incomplete CRDs
+	_, err = g.GetCRDs()
+	assert.Equal(errs, err)
+}
+
+func TestECRRepository_ImmutableFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-immutable-fields.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Repository", crds)
+	require.NotNil(crd)
+
+	// Changes to fields nested in list elements can't be validated
+	immutablePaths := []string{}
+	for _, field := range crd.ImmutableFields() {
+		immutablePaths = append(immutablePaths, field.Path)
+	}
+	assert.Equal(
+		[]string{"ImageScanningConfiguration.ScanOnPush", "RepositoryName"},
+		immutablePaths,
+	)
+	assert.False(crd.Fields["Tags..Key"].IsImmutable())
+
+	// The rules are set on the Spec struct, not on the types of the fields
+	// that other fields can share, and reject clearing the fields too
+	assert.Equal(
+		[]string{
+			`+kubebuilder:validation:XValidation:rule="!has(oldSelf.imageScanningConfiguration) || !has(oldSelf.imageScanningConfiguration.scanOnPush) || (has(self.imageScanningConfiguration) && has(self.imageScanningConfiguration.scanOnPush) && self.imageScanningConfiguration.scanOnPush == oldSelf.imageScanningConfiguration.scanOnPush)",message="imageScanningConfiguration.scanOnPush is immutable once set"`,
+			`+kubebuilder:validation:XValidation:rule="!has(oldSelf.repositoryName) || (has(self.repositoryName) && self.repositoryName == oldSelf.repositoryName)",message="repositoryName is immutable once set"`,
+		},
+		crd.ImmutableMarkers(),
+	)
+
+	// Immutable fields nested in list elements are reported
+	errs := testutil.ValidateConfigForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-immutable-fields.yaml",
+	})
+	require.Len(errs, 1)
+	assert.True(errs[0].Warning)
+	assert.Equal(
+		[]string{"resources", "Repository", "fields", "Tags..Key", "is_immutable"},
+		errs[0].Path,
+	)
+}
+
+func TestECRRepository_Pagination(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "ecr")
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Repository", crds)
+	require.NotNil(crd)
+
+	// DescribeRepositories is paginated in paginators-1.json
+	assert.True(crd.IsReadManyPaginated())
+	assert.Equal(ackgenconfig.DefaultListOpMaxPages, crd.ListOpMaxPages())
+
+	g = testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-max-pages.yaml",
+	})
+	crds, err = g.GetCRDs()
+	require.Nil(err)
+	crd = getCRDByName("Repository", crds)
+	require.NotNil(crd)
+	assert.Equal(5, crd.ListOpMaxPages())
+
+	g = testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-invalid-max-pages.yaml",
+	})
+	_, err = g.GetCRDs()
+	require.NotNil(err)
+	errs, ok := err.(ackgenconfig.ValidationErrors)
+	require.True(ok)
+	require.Len(errs, 1)
+	assert.Equal(
+		[]string{"resources", "Repository", "list_operation", "max_pages"},
+		errs[0].Path,
+	)
+	assert.Equal("must be at least 1, got 0", errs[0].Message)
+}
@@ -103,3 +103,57 @@ func TestLambda_Function(t *testing.T) {
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
 }
+
+func TestLambda_Function_References(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "lambda", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-references.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Function", crds)
+	require.NotNil(crd)
+
+	refFields := crd.ReferenceFields()
+	require.Len(refFields, 2)
+
+	kmsKeyARN := refFields[0]
+	assert.Equal("KMSKeyARN", kmsKeyARN.Names.Camel)
+	ref := kmsKeyARN.Reference()
+	require.NotNil(ref)
+	assert.Equal("Key", ref.Kind)
+	assert.Equal("kms.services.k8s.aws", ref.APIGroup)
+	assert.Equal("v1alpha1", ref.APIVersion)
+	assert.Equal("keys", ref.Resource)
+	assert.Equal([]string{"status", "ackResourceMetadata", "arn"}, ref.JSONPath)
+	assert.False(ref.IsList)
+	assert.Equal("KMSKeyRef", ref.RefNames.Camel)
+	assert.Equal("kmsKeyRef", ref.RefNames.CamelLower)
+	assert.Equal("*ResourceReference", ref.RefGoType())
+	assert.Equal("KMSKeySelector", ref.SelectorNames.Camel)
+
+	layers := refFields[1]
+	assert.Equal("Layers", layers.Names.Camel)
+	ref = layers.Reference()
+	require.NotNil(ref)
+	assert.Equal("lambda.services.k8s.aws", ref.APIGroup)
+	// Resources of the same service use the API version being generated
+	assert.Equal("", ref.APIVersion)
+	assert.Equal("layerversions", ref.Resource)
+	assert.Equal([]string{"status", "layerVersionARN"}, ref.JSONPath)
+	assert.True(ref.IsList)
+	assert.Equal("LayerRefs", ref.RefNames.Camel)
+	assert.Equal("[]*ResourceReference", ref.RefGoType())
+	assert.Equal("LayerSelector", ref.SelectorNames.Camel)
+
+	// Fields that can be resolved from a reference aren't required
+	esm := getCRDByName("EventSourceMapping", crds)
+	require.NotNil(esm)
+	assert.True(esm.HasReferenceFields())
+	assert.False(esm.SpecFields["FunctionName"].IsRequired())
+	assert.False(getCRDByName("CodeSigningConfig", crds).HasReferenceFields())
+}
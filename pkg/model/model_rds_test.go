@@ -14,11 +14,14 @@
 package model_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
 )
 
@@ -174,3 +177,378 @@ func TestRDS_DBInstance(t *testing.T) {
 	}
 	assert.Equal(expStatusFieldCamel, attrCamelNames(statusFields))
 }
+
+func TestRDS_TypeDefs_ValidationMarkers(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForService(t, "rds")
+
+	tdefs, err := g.GetTypeDefs()
+	require.Nil(err)
+
+	// UserAuthConfig is only used in the Spec of DBProxy
+	tdef := getTypeDefByName("UserAuthConfig", tdefs)
+	require.NotNil(tdef)
+	assert.Equal(
+		[]string{"+kubebuilder:validation:Enum=DISABLED;REQUIRED"},
+		tdef.Attrs["IAMAuth"].ValidationMarkers(),
+	)
+
+	// GlobalClusterMember is used in the Status of GlobalCluster, whose
+	// values are set by the AWS service and must never be rejected
+	tdef = getTypeDefByName("GlobalClusterMember", tdefs)
+	require.NotNil(tdef)
+	assert.Empty(tdef.Attrs["GlobalWriteForwardingStatus"].ValidationMarkers())
+}
+
+func TestRDS_DBCluster_ChildFields(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-children.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("DBCluster", crds)
+	require.NotNil(crd)
+
+	require.True(crd.HasChildFields())
+	children := crd.ChildFields()
+	require.Len(children, 1)
+
+	child := children[0]
+	assert.Equal("Roles", child.Field.Names.Camel)
+	assert.Equal("[]*string", child.Field.GoType)
+	assert.Equal([]string{"AssociatedRoles", "RoleArn"}, child.Path)
+	assert.Nil(child.ListOp)
+
+	require.NotNil(child.AddOp)
+	assert.Equal("AddRoleToDBCluster", child.AddOp.Operation.Name)
+	assert.Equal("RoleArn", child.AddOp.MemberName)
+	assert.False(child.AddOp.IsBatch)
+
+	require.NotNil(child.RemoveOp)
+	assert.Equal("RemoveRoleFromDBCluster", child.RemoveOp.Operation.Name)
+	assert.Equal("RoleArn", child.RemoveOp.MemberName)
+	assert.False(child.RemoveOp.IsBatch)
+
+	assert.Equal("r.ko.Spec.DBClusterIdentifier == nil", child.ParentIsNil("r.ko"))
+	assert.Equal([]string{"Spec.Roles"}, crd.SyncedFieldPaths())
+}
+
+func TestRDS_DBInstance_Synced(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-synced.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("DBInstance", crds)
+	require.NotNil(crd)
+
+	rules := crd.SyncedRules()
+	require.Len(rules, 2)
+
+	assert.Equal("Status.DBInstanceStatus", rules[0].Path)
+	require.Len(rules[0].Fields, 1)
+	assert.Equal("DBInstanceStatus", rules[0].Fields[0].Names.Camel)
+	assert.Equal([]string{"available"}, rules[0].Ready)
+	assert.Equal([]string{"creating", "modifying", "backing-up"}, rules[0].InProgress)
+	assert.Equal([]string{"failed", "incompatible-parameters"}, rules[0].Terminal)
+
+	assert.Equal("Status.DBSubnetGroup.SubnetGroupStatus", rules[1].Path)
+	require.Len(rules[1].Fields, 2)
+	assert.Equal("DBSubnetGroup", rules[1].Fields[0].Names.Camel)
+	assert.Equal("SubnetGroupStatus", rules[1].Fields[1].Names.Camel)
+
+	// Resources without a synced config have no rules
+	crd = getCRDByName("DBSubnetGroup", crds)
+	require.NotNil(crd)
+	assert.Empty(crd.SyncedRules())
+}
+
+func TestRDS_DBInstance_InvalidSynced(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-invalid-synced.yaml",
+	})
+
+	_, err := g.GetCRDs()
+	require.NotNil(err)
+	errs, ok := err.(ackgenconfig.ValidationErrors)
+	require.True(ok)
+
+	type expError struct {
+		path    string
+		message string
+	}
+	got := []expError{}
+	for _, e := range errs {
+		got = append(got, expError{strings.Join(e.Path, "."), e.Message})
+	}
+	prefix := "resources.DBInstance.synced.when."
+	assert.Equal([]expError{
+		{prefix + "0.path", "must be the path of a Status field, e.g. Status.Spec.DBInstanceClass"},
+		{prefix + "1.path", "unknown field DBInstanceStatuss (did you mean \"DBInstanceStatus\"?)"},
+		{prefix + "2.path", "field has type *Endpoint: only string fields are supported"},
+		{prefix + "3.ready", "at least one ready value is required"},
+		{prefix + "4.terminal", `value "available" is already listed in ready`},
+	}, got)
+}
+
+func TestRDS_ReadinessWaiters(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-waiters.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("DBInstance", crds)
+	require.NotNil(crd)
+	w := crd.ReadinessWaiter()
+	require.NotNil(w)
+	assert.Equal("DBInstanceAvailable", w.Name)
+	assert.Equal("DescribeDBInstances", w.OperationName)
+	require.Len(w.PathAcceptors, 6)
+	assert.Equal("pathAll", w.PathAcceptors[0].Matcher)
+	assert.Equal("DBInstances[].DBInstanceStatus", w.PathAcceptors[0].Argument)
+
+	// Waiters are found by name by default
+	crd = getCRDByName("DBClusterSnapshot", crds)
+	require.NotNil(crd)
+	w = crd.ReadinessWaiter()
+	require.NotNil(w)
+	assert.Equal("DBClusterSnapshotAvailable", w.Name)
+
+	crd = getCRDByName("DBSnapshot", crds)
+	require.NotNil(crd)
+	assert.Nil(crd.ReadinessWaiter())
+
+	// There is no DBSubnetGroup waiter
+	crd = getCRDByName("DBSubnetGroup", crds)
+	require.NotNil(crd)
+	assert.Nil(crd.ReadinessWaiter())
+
+	// The synced config takes precedence over waiters
+	g = testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-synced.yaml",
+	})
+	crds, err = g.GetCRDs()
+	require.Nil(err)
+	crd = getCRDByName("DBInstance", crds)
+	require.NotNil(crd)
+	assert.Nil(crd.ReadinessWaiter())
+}
+
+func TestRDS_InvalidReadinessWaiters(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-invalid-waiters.yaml",
+	})
+
+	_, err := g.GetCRDs()
+	require.NotNil(err)
+	errs, ok := err.(ackgenconfig.ValidationErrors)
+	require.True(ok)
+
+	type expError struct {
+		path    string
+		message string
+	}
+	got := []expError{}
+	for _, e := range errs {
+		got = append(got, expError{strings.Join(e.Path, "."), e.Message})
+	}
+	assert.Equal([]expError{
+		{
+			"resources.DBInstance.waiter.name",
+			"unknown waiter DBInstanceAvailble (did you mean \"DBInstanceAvailable\"?)",
+		},
+		{
+			"resources.DBSnapshot.waiter.name",
+			"waiter DBInstanceAvailable uses the DescribeDBInstances operation, but DBSnapshot is read with the DescribeDBSnapshots operation",
+		},
+		{
+			"resources.DBClusterSnapshot.waiter",
+			"cannot be used with synced, which sets the ACK.ResourceSynced condition as well",
+		},
+	}, got)
+}
+
+func TestRDS_Delete(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-delete.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("DBInstance", crds)
+	require.NotNil(crd)
+	assert.True(crd.DeleteWaitsUntilNotFound())
+	assert.Equal(60, crd.DeleteRequeueAfterSeconds())
+
+	deleting := crd.DeletingStatus()
+	require.NotNil(deleting)
+	require.Len(deleting.Fields, 1)
+	assert.Equal("DBInstanceStatus", deleting.Fields[0].Names.Camel)
+	assert.Equal([]string{"deleting"}, deleting.Values)
+
+	updates := crd.PreDeleteUpdates()
+	require.Len(updates, 1)
+	assert.Equal("DeletionProtection", updates[0].Field.Names.Camel)
+	assert.Equal("aws.Bool(false)", updates[0].Value)
+
+	crd = getCRDByName("DBSubnetGroup", crds)
+	require.NotNil(crd)
+	assert.False(crd.DeleteWaitsUntilNotFound())
+	assert.Equal(0, crd.DeleteRequeueAfterSeconds())
+	assert.Nil(crd.DeletingStatus())
+	assert.Empty(crd.PreDeleteUpdates())
+
+	crd = getCRDByName("DBSnapshot", crds)
+	require.NotNil(crd)
+	assert.False(crd.DeleteWaitsUntilNotFound())
+}
+
+func TestRDS_InvalidDelete(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-invalid-delete.yaml",
+	})
+
+	_, err := g.GetCRDs()
+	require.NotNil(err)
+	errs, ok := err.(ackgenconfig.ValidationErrors)
+	require.True(ok)
+
+	type expError struct {
+		path    string
+		message string
+	}
+	got := []expError{}
+	for _, e := range errs {
+		got = append(got, expError{strings.Join(e.Path, "."), e.Message})
+	}
+	prefix := "resources.DBInstance.delete."
+	assert.Equal([]expError{
+		{prefix + "deleting.values", "at least one value is required"},
+		{prefix + "deleting.path", "unknown field DBInstanceStatuss (did you mean \"DBInstanceStatus\"?)"},
+		{prefix + "requeue_after_seconds", "must be at least 1, got 0"},
+		{prefix + "pre_delete_updates.0.path", "must be the path of a Spec field, e.g. Spec.DeletionProtection"},
+		{prefix + "pre_delete_updates.1.path", "unknown field DeletionProtecton (did you mean \"DeletionProtection\"?)"},
+		{prefix + "pre_delete_updates.2.path", "field has type []*Tag: only string, number and boolean fields are supported"},
+		{prefix + "pre_delete_updates.3.value", `invalid integer value "lots"`},
+		{prefix + "pre_delete_updates.4.value", "missing value"},
+		{"resources.DBSnapshot.delete.requeue_after_seconds", "requires wait_until_not_found"},
+		{"resources.DBSnapshot.delete.deleting", "requires wait_until_not_found"},
+		{"resources.DBSubnetGroup.delete.wait_until_not_found", "requires deleting, the Delete operation would otherwise be called again until the resource is not found"},
+	}, got)
+}
+
+func TestRDS_DBInstance_ExceptionRules(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-exceptions.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("DBInstance", crds)
+	require.NotNil(crd)
+
+	rules := crd.ExceptionRules()
+	require.Len(rules, 5)
+
+	// not_found rules default to the read and Delete operations
+	assert.Equal("DBInstanceNotFound", rules[0].Code)
+	assert.Equal(model.ExceptionOutcomeNotFound, rules[0].Outcome)
+	assert.Equal([]string{"DescribeDBInstances", "DeleteDBInstance"}, rules[0].Operations)
+
+	assert.Equal(1, rules[1].Index)
+	assert.Equal("^Cannot .* storage", rules[1].MessagePattern)
+	assert.Equal([]string{"ModifyDBInstance"}, rules[1].Operations)
+	assert.Equal(model.ExceptionOutcomeTerminal, rules[1].Outcome)
+
+	// Other rules match the errors of all operations by default
+	assert.Empty(rules[2].Operations)
+	assert.Equal(model.ExceptionOutcomeRetry, rules[2].Outcome)
+	assert.Equal(5, rules[2].AfterSeconds)
+	assert.Equal(300, rules[2].MaxAfterSeconds)
+
+	assert.Equal(model.ExceptionOutcomeRequeue, rules[3].Outcome)
+	assert.Equal(60, rules[3].AfterSeconds)
+
+	// The runtime's default requeue duration is used
+	assert.Equal(0, rules[4].AfterSeconds)
+
+	assert.True(crd.HasExceptionOutcome(model.ExceptionOutcomeNotFound))
+	assert.True(crd.HasExceptionOutcome(model.ExceptionOutcomeRetry))
+	assert.True(crd.HasExceptionMessagePatterns())
+	assert.True(crd.HasExceptionRequeueDurations())
+
+	crd = getCRDByName("DBSubnetGroup", crds)
+	require.NotNil(crd)
+	assert.Empty(crd.ExceptionRules())
+	assert.False(crd.HasExceptionOutcome(model.ExceptionOutcomeTerminal))
+}
+
+func TestRDS_DBInstance_InvalidExceptionRules(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "rds", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-invalid-exceptions.yaml",
+	})
+
+	_, err := g.GetCRDs()
+	require.NotNil(err)
+	errs, ok := err.(ackgenconfig.ValidationErrors)
+	require.True(ok)
+
+	type expError struct {
+		path    string
+		message string
+	}
+	got := []expError{}
+	for _, e := range errs {
+		got = append(got, expError{strings.Join(e.Path, "."), e.Message})
+	}
+	prefix := "resources.DBInstance.exceptions.rules."
+	assert.Equal([]expError{
+		{prefix + "0.code", "missing code"},
+		{prefix + "0.message_pattern", "error parsing regexp: missing closing ): `(unclosed`"},
+		{prefix + "1.operations.0", "unknown operation DescribeDBInstance (did you mean \"DescribeDBInstances\"?)"},
+		{prefix + "1.operations.1", "a not_found outcome only applies to DescribeDBInstances and DeleteDBInstance"},
+		{prefix + "2.outcome", "unknown outcome \"backoff\", must be one of not_found, terminal, retry, requeue"},
+		{prefix + "3.retry_after_seconds", "required for a retry outcome"},
+		{prefix + "3.max_retry_after_seconds", "must be at least 1, got 0"},
+		{prefix + "3.requeue_after_seconds", "only applies to a requeue outcome"},
+		{prefix + "4.max_retry_after_seconds", "must be at least retry_after_seconds (60), got 30"},
+		{prefix + "5.requeue_after_seconds", "must be at least 1, got 0"},
+	}, got)
+}
@@ -99,3 +99,49 @@ func TestS3_Bucket(t *testing.T) {
 		assert.NotNil(testutil.GetTypeDefByName(t, g, typeDef))
 	}
 }
+
+func TestS3_Bucket_SubResources(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "s3", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-with-sub-resources.yaml",
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+
+	crd := getCRDByName("Bucket", crds)
+	require.NotNil(crd)
+
+	require.True(crd.HasSubResources())
+	subs := crd.SubResources()
+	require.Len(subs, 2)
+
+	policy := subs[0]
+	assert.Equal("Policy", policy.Field.Names.Camel)
+	assert.Equal("*string", policy.Field.GoType)
+	assert.Equal("Policy", policy.MemberName)
+	assert.Equal("PutBucketPolicy", policy.PutOp.Operation.Name)
+	assert.Equal("GetBucketPolicy", policy.GetOp.Operation.Name)
+	require.NotNil(policy.DeleteOp)
+	assert.Equal("DeleteBucketPolicy", policy.DeleteOp.Operation.Name)
+	assert.Equal([]string{"NoSuchBucketPolicy"}, policy.UnsetErrorCodes)
+	assert.Equal("r.ko.Spec.Name == nil", policy.ParentIsNil("r.ko"))
+
+	versioning := subs[1]
+	assert.Equal("Versioning", versioning.Field.Names.Camel)
+	assert.Equal("*VersioningConfiguration", versioning.Field.GoType)
+	assert.Equal("PutBucketVersioning", versioning.PutOp.Operation.Name)
+	assert.Nil(versioning.DeleteOp)
+	assert.Empty(versioning.UnsetErrorCodes)
+
+	// Sub-resources are synced by their own operations, not by an Update
+	// of the resource
+	assert.Equal(
+		[]string{"Spec.Policy", "Spec.Versioning"},
+		crd.SyncedFieldPaths(),
+	)
+	_, found := crd.SpecFields["Versioning"]
+	assert.True(found)
+}
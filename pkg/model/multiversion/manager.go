@@ -18,12 +18,9 @@ import (
 	"fmt"
 	"sort"
 
-	"gopkg.in/src-d/go-git.v4"
-
 	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
 	ackmetadata "github.com/aws-controllers-k8s/code-generator/pkg/metadata"
 	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
-	"github.com/aws-controllers-k8s/code-generator/pkg/util"
 )
 
 var (
@@ -36,7 +33,6 @@ var (
 // of each non-deprecated version with their correspending ackmodel.Model
 // and APIInfos.
 type APIVersionManager struct {
-	gitRepo  *git.Repository
 	metadata *ackmetadata.ServiceMetadata
 
 	hubVersion    string
@@ -46,9 +42,13 @@ type APIVersionManager struct {
 	models   map[string]*ackmodel.Model
 }
 
-// NewAPIVersionManager initialises and returns a new APIVersionManager.
+// NewAPIVersionManager initialises and returns a new APIVersionManager. The
+// given SDKHelper is switched to the aws-sdk-go version of each API version
+// before loading its model, see `ackmodel.SDKHelper.WithSDKVersion`, unless
+// it reads the model files from a fixed models path, which then provides the
+// models of every API version.
 func NewAPIVersionManager(
-	sdkCacheDir string,
+	SDKAPIHelper *ackmodel.SDKHelper,
 	metadataPath string,
 	serviceAlias string,
 	hubVersion string,
@@ -66,13 +66,6 @@ func NewAPIVersionManager(
 
 	spokeVersions := []string{}
 
-	gitRepo, err := util.LoadRepository(sdkCacheDir)
-	if err != nil {
-		return nil, fmt.Errorf("cannot read sdk git repository: %v", err)
-	}
-
-	SDKAPIHelper := ackmodel.NewSDKHelper(sdkCacheDir)
-
 	// create model for each non-deprecated api version
 	models := map[string]*ackmodel.Model{}
 	for _, version := range metadata.APIVersions {
@@ -89,9 +82,11 @@ func NewAPIVersionManager(
 			return nil, fmt.Errorf("could not find API info for API version %s", version.APIVersion)
 		}
 
-		err = SDKAPIHelper.WithSDKVersion(apiInfo.AWSSDKVersion)
-		if err != nil {
-			return nil, err
+		if !SDKAPIHelper.HasFixedModelsPath() {
+			err = SDKAPIHelper.WithSDKVersion(apiInfo.AWSSDKVersion)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		SDKAPI, err := SDKAPIHelper.API(serviceAlias)
@@ -113,7 +108,6 @@ func NewAPIVersionManager(
 
 	sort.Strings(spokeVersions)
 	model := &APIVersionManager{
-		gitRepo:       gitRepo,
 		metadata:      metadata,
 		hubVersion:    hubVersion,
 		spokeVersions: spokeVersions,
@@ -201,8 +195,19 @@ func (m *APIVersionManager) CompareAPIVersions(srcAPIVersion, dstAPIVersion stri
 		// TODO(a-hilaly) handle added/removed CRDs
 		return nil, fmt.Errorf("source and destination API versions don't have the same number of CRDs")
 	}
-	for i, crd := range dstCRDs {
-		crdDelta, err := ComputeCRDFieldDeltas(srcCRDs[i], dstCRDs[i])
+	srcCRDsByName := make(map[string]*ackmodel.CRD, len(srcCRDs))
+	for _, crd := range srcCRDs {
+		srcCRDsByName[crd.Names.Camel] = crd
+	}
+	for _, crd := range dstCRDs {
+		srcCRD, found := srcCRDsByName[crd.Names.Camel]
+		if !found {
+			// A renamed CRD can't be told apart from a removed one
+			return nil, fmt.Errorf(
+				"cannot find CRD %s in API version %s", crd.Names.Camel, srcAPIVersion,
+			)
+		}
+		crdDelta, err := ComputeCRDFieldDeltas(srcCRD, crd)
 		if err != nil {
 			return nil, fmt.Errorf("cannot compute crd field deltas: %v", err)
 		}
@@ -0,0 +1,82 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package multiversion_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	ackmetadata "github.com/aws-controllers-k8s/code-generator/pkg/metadata"
+	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/model/multiversion"
+)
+
+func TestNewAPIVersionManager_ModelsPath(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	modelsPath, err := filepath.Abs(filepath.Join("..", "..", "testdata", "models"))
+	require.Nil(err)
+	configPath := filepath.Join(modelsPath, "apis", "ecr", "0000-00-00")
+
+	metadataPath := filepath.Join(t.TempDir(), "metadata.yaml")
+	require.Nil(os.WriteFile(metadataPath, []byte(`service:
+  full_name: Amazon Elastic Container Registry
+  short_name: ECR
+api_versions:
+- api_version: v1alpha1
+  status: available
+- api_version: v1alpha2
+  status: available
+`), 0644))
+
+	// The aws-sdk-go versions can't be switched, the models path provides
+	// the models of both API versions
+	sdkHelper := ackmodel.NewSDKHelperFromModelsPath(modelsPath)
+	assert.True(sdkHelper.HasFixedModelsPath())
+	mgr, err := multiversion.NewAPIVersionManager(
+		sdkHelper,
+		metadataPath,
+		"ecr",
+		"v1alpha2",
+		map[string]ackmetadata.APIInfo{
+			"v1alpha1": {
+				APIVersion:          "v1alpha1",
+				AWSSDKVersion:       "v1.35.5",
+				GeneratorConfigPath: filepath.Join(configPath, "generator-v1alpha1.yaml"),
+			},
+			"v1alpha2": {
+				APIVersion:          "v1alpha2",
+				AWSSDKVersion:       "v1.37.10",
+				GeneratorConfigPath: filepath.Join(configPath, "generator-v1alpha2.yaml"),
+			},
+		},
+		ackgenconfig.Config{},
+	)
+	require.Nil(err)
+	assert.Equal("v1alpha2", mgr.GetHubVersion())
+	assert.Equal([]string{"v1alpha1"}, mgr.GetSpokeVersions())
+
+	model, err := mgr.GetModel("v1alpha1")
+	require.Nil(err)
+	crds, err := model.GetCRDs()
+	require.Nil(err)
+	require.Len(crds, 1)
+	assert.Equal("Repository", crds[0].Names.Camel)
+}
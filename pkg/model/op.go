@@ -65,6 +65,16 @@ func GetOpTypeAndResourceNameFromOpID(opID string) (OpType, string) {
 			return OpTypeCreateBatch, pluralize.Singular(resName)
 		}
 		return OpTypeCreate, resName
+	} else if child, resName := splitChildOpID(opID, "Add", "To"); child != "" {
+		if pluralize.IsPlural(child) {
+			return OpTypeAddChildren, resName
+		}
+		return OpTypeAddChild, resName
+	} else if child, resName := splitChildOpID(opID, "Remove", "From"); child != "" {
+		if pluralize.IsPlural(child) {
+			return OpTypeRemoveChildren, resName
+		}
+		return OpTypeRemoveChild, resName
 	} else if strings.HasPrefix(opID, "Modify") {
 		return OpTypeUpdate, strings.TrimPrefix(opID, "Modify")
 	} else if strings.HasPrefix(opID, "Update") {
@@ -101,6 +111,63 @@ func GetOpTypeAndResourceNameFromOpID(opID string) (OpType, string) {
 	return OpTypeUnknown, opID
 }
 
+// splitChildOpID returns the child and parent resource names of operation IDs
+// like "{prefix}{Child}{separator}{Resource}", e.g. "AddRoleToDBCluster", or
+// empty strings if the operation ID doesn't follow this pattern
+func splitChildOpID(opID string, prefix string, separator string) (string, string) {
+	if !strings.HasPrefix(opID, prefix) {
+		return "", ""
+	}
+	rest := strings.TrimPrefix(opID, prefix)
+	// The separator must start a new word after a non-empty child name, and
+	// be followed by a non-empty resource name
+	for i := 1; i+len(separator) < len(rest); i++ {
+		if !strings.HasPrefix(rest[i:], separator) {
+			continue
+		}
+		resName := rest[i+len(separator):]
+		if resName[0] < 'A' || resName[0] > 'Z' {
+			continue
+		}
+		return rest[:i], resName
+	}
+	return "", ""
+}
+
+// String returns the name of the operation type, as accepted by
+// OpTypeFromString
+func (ot OpType) String() string {
+	switch ot {
+	case OpTypeCreate:
+		return "Create"
+	case OpTypeCreateBatch:
+		return "CreateBatch"
+	case OpTypeDelete:
+		return "Delete"
+	case OpTypeReplace:
+		return "Replace"
+	case OpTypeUpdate:
+		return "Update"
+	case OpTypeAddChild:
+		return "AddChild"
+	case OpTypeAddChildren:
+		return "AddChildren"
+	case OpTypeRemoveChild:
+		return "RemoveChild"
+	case OpTypeRemoveChildren:
+		return "RemoveChildren"
+	case OpTypeGet:
+		return "Get"
+	case OpTypeList:
+		return "List"
+	case OpTypeGetAttributes:
+		return "GetAttributes"
+	case OpTypeSetAttributes:
+		return "SetAttributes"
+	}
+	return "Unknown"
+}
+
 func OpTypeFromString(s string) OpType {
 	switch s {
 	case "Create":
@@ -103,6 +103,31 @@ func TestGetOpTypeAndResourceNameFromOpID(t *testing.T) {
 			model.OpTypeUnknown,
 			"PauseEC2Instance",
 		},
+		{
+			"AddRoleToDBCluster",
+			model.OpTypeAddChild,
+			"DBCluster",
+		},
+		{
+			"AddTagsToResource",
+			model.OpTypeAddChildren,
+			"Resource",
+		},
+		{
+			"RemoveSourceIdentifierFromSubscription",
+			model.OpTypeRemoveChild,
+			"Subscription",
+		},
+		{
+			"RemoveFromGlobalCluster",
+			model.OpTypeUnknown,
+			"RemoveFromGlobalCluster",
+		},
+		{
+			"AddPermission",
+			model.OpTypeUnknown,
+			"AddPermission",
+		},
 	}
 	for _, test := range tests {
 		ot, resName := model.GetOpTypeAndResourceNameFromOpID(test.opID)
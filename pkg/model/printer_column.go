@@ -17,6 +17,8 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
 )
 
 // PrinterColumn represents a single field in the CRD's Spec or Status objects
@@ -62,7 +64,8 @@ func (pcs printerColumnSorter) Less(i, j int) bool {
 	return pcs.by(pcs.cols[i], pcs.cols[j])
 }
 
-// sortFunction returns a Go function used the sort the printer columns.
+// sortFunction returns a Go function used the sort the printer columns, or nil
+// if the supplied field can't be used to sort them.
 func sortFunction(sortByField string) func(a, b *PrinterColumn) bool {
 	switch strings.ToLower(sortByField) {
 	case "name":
@@ -82,9 +85,7 @@ func sortFunction(sortByField string) func(a, b *PrinterColumn) bool {
 			return a.Index < b.Index
 		}
 	default:
-		msg := fmt.Sprintf("unknown sort-by field: '%s'. must be one of 'Name',"+
-			" 'Type', 'JSONPath' and 'Index'", sortByField)
-		panic(msg)
+		return nil
 	}
 }
 
@@ -92,11 +93,27 @@ func sortFunction(sortByField string) func(a, b *PrinterColumn) bool {
 // the resource
 func (r *CRD) AdditionalPrinterColumns() []*PrinterColumn {
 	orderByFieldName := r.GetResourcePrintOrderByName()
-	sortFn := sortFunction(orderByFieldName)
-	By(sortFn).Sort(r.additionalPrinterColumns)
+	if sortFn := sortFunction(orderByFieldName); sortFn != nil {
+		By(sortFn).Sort(r.additionalPrinterColumns)
+	}
 	return r.additionalPrinterColumns
 }
 
+// checkPrinterColumns returns the problems found in the printer column
+// configs of the resource and its fields
+func (r *CRD) checkPrinterColumns() ackgenconfig.ValidationErrors {
+	errs := append(ackgenconfig.ValidationErrors{}, r.printerColumnErrs...)
+	orderByFieldName := r.GetResourcePrintOrderByName()
+	if sortFunction(orderByFieldName) == nil {
+		errs = append(errs, r.newConfigError(
+			[]string{"print", "order_by"},
+			"unknown sort-by field %q: must be one of 'Name', 'Type', "+
+				"'JSONPath' and 'Index'", orderByFieldName,
+		))
+	}
+	return errs
+}
+
 // addPrintableColumn adds an entry to the list of additional printer columns
 // using the given path and field types.
 func (r *CRD) addPrintableColumn(
@@ -130,11 +147,12 @@ func (r *CRD) addPrintableColumn(
 	printColumnType, exists := acceptableColumnMaps[fieldColumnType]
 
 	if !exists {
-		msg := fmt.Sprintf(
-			"GENERATION FAILURE! Unable to generate a printer column for the field %s that has type %s.",
-			field.Names.Camel, fieldColumnType,
-		)
-		panic(msg)
+		r.printerColumnErrs = append(r.printerColumnErrs, r.newConfigError(
+			[]string{"fields", field.Names.Camel, "print"},
+			"unable to generate a printer column for a field of type %s",
+			fieldColumnType,
+		))
+		return
 	}
 
 	name := field.Names.Camel
@@ -0,0 +1,198 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gertd/go-pluralize"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+// referenceFieldSuffixes are the suffixes of the identifier field names that
+// are dropped to name the reference fields, e.g. `SubnetIDs` -> `SubnetRefs`
+var referenceFieldSuffixes = []string{"IDs", "ARNs", "Names", "ID", "ARN", "Name"}
+
+// FieldReference describes the custom resource whose identifier a field
+// contains, as well as the fields the code generator adds to the Spec to
+// refer to it
+type FieldReference struct {
+	// Kind is the Kind of the referenced custom resource, e.g. "Subnet"
+	Kind string
+	// APIGroup is the API group of the referenced custom resource, e.g.
+	// "ec2.services.k8s.aws"
+	APIGroup string
+	// APIVersion is the API version of the referenced custom resource. It can
+	// only be empty when the referenced resource belongs to the same service,
+	// in which case the API version being generated is used.
+	APIVersion string
+	// Resource is the plural, lowercased name of the referenced custom
+	// resource, e.g. "subnets"
+	Resource string
+	// JSONPath contains the JSON field names leading to the identifier in the
+	// referenced custom resource, e.g. ["status", "subnetID"]
+	JSONPath []string
+	// IsList is true when the field contains a list of identifiers
+	IsList bool
+	// RefNames are the names of the Spec field referring to the custom
+	// resource(s) by name, e.g. `SubnetRefs`
+	RefNames names.Names
+	// SelectorNames are the names of the Spec field selecting the custom
+	// resource(s) by labels, e.g. `SubnetSelector`
+	SelectorNames names.Names
+}
+
+// RefGoType returns the Go type of the Spec field referring to the custom
+// resource(s) by name
+func (ref *FieldReference) RefGoType() string {
+	if ref.IsList {
+		return "[]*ResourceReference"
+	}
+	return "*ResourceReference"
+}
+
+// Reference returns the custom resource the field refers to, or nil if the
+// field has no `references` config. Only string and list of strings fields can
+// have references.
+func (f *Field) Reference() *FieldReference {
+	if f.FieldConfig == nil || f.FieldConfig.References == nil {
+		return nil
+	}
+	cfg := f.FieldConfig.References
+	isList := false
+	switch f.GoType {
+	case "*string":
+	case "[]*string":
+		isList = true
+	default:
+		return nil
+	}
+
+	apiGroup := f.CRD.sdkAPI.APIGroup()
+	if f.IsCrossServiceReference() {
+		apiGroup = cfg.ServiceName + strings.TrimPrefix(apiGroup, f.CRD.sdkAPI.ServiceIDClean())
+	}
+
+	jsonPath := []string{}
+	for _, part := range strings.Split(cfg.Path, ".") {
+		jsonPath = append(jsonPath, jsonFieldName(part))
+	}
+
+	base := f.Names.Camel
+	for _, suffix := range referenceFieldSuffixes {
+		if len(base) > len(suffix) && strings.HasSuffix(base, suffix) {
+			base = strings.TrimSuffix(base, suffix)
+			break
+		}
+	}
+	if isList && base == f.Names.Camel && strings.HasSuffix(base, "s") {
+		// e.g. `Layers` -> `LayerRefs`
+		base = strings.TrimSuffix(base, "s")
+	}
+	refName := base + "Ref"
+	if isList {
+		refName += "s"
+	}
+
+	return &FieldReference{
+		Kind:          cfg.Resource,
+		APIGroup:      apiGroup,
+		APIVersion:    cfg.APIVersion,
+		Resource:      strings.ToLower(pluralize.NewClient().Plural(cfg.Resource)),
+		JSONPath:      jsonPath,
+		IsList:        isList,
+		RefNames:      names.New(refName),
+		SelectorNames: names.New(base + "Selector"),
+	}
+}
+
+// IsCrossServiceReference returns true if the field refers to a custom
+// resource managed by the controller of another service
+func (f *Field) IsCrossServiceReference() bool {
+	if f.FieldConfig == nil || f.FieldConfig.References == nil {
+		return false
+	}
+	serviceName := f.FieldConfig.References.ServiceName
+	return serviceName != "" && serviceName != f.CRD.sdkAPI.ServiceIDClean()
+}
+
+// jsonFieldName returns the JSON name of a field of a custom resource
+func jsonFieldName(fieldName string) string {
+	switch fieldName {
+	case "ACKResourceMetadata":
+		// The common status field isn't named after the Names conventions
+		return "ackResourceMetadata"
+	case "Spec", "Status", "Metadata":
+		return strings.ToLower(fieldName)
+	}
+	return names.New(fieldName).CamelLower
+}
+
+// ReferenceFields returns the Spec fields referring to other custom
+// resources, sorted by name
+func (r *CRD) ReferenceFields() []*Field {
+	res := []*Field{}
+	for _, field := range r.SpecFields {
+		if field.Reference() != nil {
+			res = append(res, field)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Names.Camel < res[j].Names.Camel
+	})
+	return res
+}
+
+// HasReferenceFields returns true if any Spec field refers to another custom
+// resource
+func (r *CRD) HasReferenceFields() bool {
+	return len(r.ReferenceFields()) > 0
+}
+
+// checkReferenceFields returns the Spec fields of the CRD whose `references`
+// config can't be applied
+func (r *CRD) checkReferenceFields() ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
+	fieldNames := make([]string, 0, len(r.SpecFields))
+	for fieldName := range r.SpecFields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+	for _, fieldName := range fieldNames {
+		field := r.SpecFields[fieldName]
+		if field.FieldConfig == nil || field.FieldConfig.References == nil {
+			continue
+		}
+		if field.Reference() == nil {
+			errs = append(errs, r.newConfigError(
+				[]string{"fields", field.Names.Camel, "references"},
+				"field has type %s: only string and list of strings fields can have references",
+				field.GoType,
+			))
+			continue
+		}
+		if field.IsCrossServiceReference() && field.FieldConfig.References.APIVersion == "" {
+			// The API versions of other service controllers are unknown
+			errs = append(errs, r.newConfigError(
+				[]string{"fields", field.Names.Camel, "references", "service_name"},
+				"api_version is required to refer to resources of service %s",
+				field.FieldConfig.References.ServiceName,
+			))
+		}
+	}
+	return errs
+}
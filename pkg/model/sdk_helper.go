@@ -46,12 +46,52 @@ var (
 	)
 )
 
+// sdkModulePath is the Go module path of the aws-sdk-go.
+const sdkModulePath = "github.com/aws/aws-sdk-go"
+
+// ModelFormat is the format of the AWS service API model files
+type ModelFormat string
+
+const (
+	// ModelFormatAPI2 is the format of the aws-sdk-go `api-2.json` model
+	// files
+	ModelFormatAPI2 ModelFormat = "api-2"
+	// ModelFormatSmithy is the format of the Smithy JSON AST model files, as
+	// used by the aws-sdk-go-v2
+	ModelFormatSmithy ModelFormat = "smithy"
+)
+
 // SDKHelper is a helper struct that helps work with the aws-sdk-go models and
 // API model loader
+//
+// The aws-sdk-go model files can be read from three different sources:
+//
+// - a git clone'd aws-sdk-go repository (see `NewSDKHelper`). Calling
+//   `WithSDKVersion` checks out the given tag.
+// - the Go module cache (see `NewSDKHelperFromGoModCache`). Calling
+//   `WithSDKVersion` reads the models of the given module version, which needs
+//   to be already downloaded (e.g. with `go mod download`).
+// - a plain models directory (see `NewSDKHelperFromModelsPath`). The aws-sdk-go
+//   version cannot be changed.
+// - a directory of Smithy JSON AST model files, such as the aws-sdk-go-v2's
+//   `codegen/sdk-codegen/aws-models` directory (see
+//   `NewSDKHelperFromSmithyModelsPath`).
+//
+// The last three sources require neither git nor network access.
 type SDKHelper struct {
 	gitRepository *git.Repository
-	basePath      string
-	loader        *awssdkmodel.Loader
+	// Path to the aws-sdk-go source tree. Empty when the models are read
+	// from a plain models directory.
+	basePath string
+	// Path to the aws-sdk-go models directory, containing the `apis/`
+	// directory.
+	modelsPath string
+	// Path to the Go module cache. Only set when the models are read from
+	// the Go module cache.
+	goModCachePath string
+	// Format of the model files. Defaults to ModelFormatAPI2.
+	modelFormat ModelFormat
+	loader      *awssdkmodel.Loader
 	// Default is set by `FirstAPIVersion`
 	apiVersion string
 	// Default is "services.k8s.aws"
@@ -61,7 +101,8 @@ type SDKHelper struct {
 // NewSDKHelper returns a new SDKHelper object
 func NewSDKHelper(basePath string) *SDKHelper {
 	return &SDKHelper{
-		basePath: basePath,
+		basePath:   basePath,
+		modelsPath: filepath.Join(basePath, "models"),
 		loader: &awssdkmodel.Loader{
 			BaseImport:            basePath,
 			IgnoreUnsupportedAPIs: true,
@@ -69,9 +110,95 @@ func NewSDKHelper(basePath string) *SDKHelper {
 	}
 }
 
-// WithSDKVersion checks out the sdk git repository to the provided version. To use
-// this function h.basePath should point to a git repository.
+// NewSDKHelperFromModelsPath returns a new SDKHelper object reading the model
+// files from an aws-sdk-go models directory (the directory containing
+// `apis/`).
+func NewSDKHelperFromModelsPath(modelsPath string) *SDKHelper {
+	return &SDKHelper{
+		modelsPath: modelsPath,
+		loader: &awssdkmodel.Loader{
+			BaseImport:            filepath.Dir(modelsPath),
+			IgnoreUnsupportedAPIs: true,
+		},
+	}
+}
+
+// NewSDKHelperFromGoModCache returns a new SDKHelper object reading the model
+// files of the given aws-sdk-go version from the Go module cache.
+func NewSDKHelperFromGoModCache(goModCachePath string, version string) *SDKHelper {
+	h := NewSDKHelper(SDKModulePath(goModCachePath, version))
+	h.goModCachePath = goModCachePath
+	return h
+}
+
+// NewSDKHelperFromSmithyModelsPath returns a new SDKHelper object reading the
+// Smithy JSON AST model files found in a directory, like the aws-sdk-go-v2's
+// `codegen/sdk-codegen/aws-models` directory. The model file of a service is
+// named after its service alias, e.g. `ecr.json` or `ecr.2015-09-21.json`.
+func NewSDKHelperFromSmithyModelsPath(modelsPath string) *SDKHelper {
+	return &SDKHelper{
+		modelsPath:  modelsPath,
+		modelFormat: ModelFormatSmithy,
+	}
+}
+
+// SDKModulePath returns the path of a given aws-sdk-go version in the Go
+// module cache. e.g $GOMODCACHE/github.com/aws/aws-sdk-go@v1.37.10
+func SDKModulePath(goModCachePath string, version string) string {
+	return filepath.Join(
+		goModCachePath, filepath.FromSlash(sdkModulePath)+"@"+version,
+	)
+}
+
+// ModelsPath returns the path of the aws-sdk-go models directory.
+func (h *SDKHelper) ModelsPath() string {
+	return h.modelsPath
+}
+
+// ModelFormat returns the format of the model files
+func (h *SDKHelper) ModelFormat() ModelFormat {
+	if h.modelFormat == "" {
+		return ModelFormatAPI2
+	}
+	return h.modelFormat
+}
+
+// HasFixedModelsPath returns true if the model files are read from a models
+// directory whose aws-sdk-go version cannot be changed, see
+// `NewSDKHelperFromModelsPath` and `NewSDKHelperFromSmithyModelsPath`.
+func (h *SDKHelper) HasFixedModelsPath() bool {
+	return h.basePath == "" && h.goModCachePath == ""
+}
+
+// WithSDKVersion changes the aws-sdk-go version the model files are read
+// from. When h.basePath points to a git repository, the repository is checked
+// out to the provided version. When the models are read from the Go module
+// cache, the module directory of the provided version is used instead.
 func (h *SDKHelper) WithSDKVersion(version string) error {
+	if h.goModCachePath != "" {
+		basePath := SDKModulePath(h.goModCachePath, version)
+		if _, err := os.Stat(basePath); err != nil {
+			return fmt.Errorf(
+				"cannot find aws-sdk-go %s in the Go module cache: %v", version, err,
+			)
+		}
+		h.basePath = basePath
+		h.modelsPath = filepath.Join(basePath, "models")
+		h.loader.BaseImport = basePath
+		return nil
+	}
+	if h.basePath == "" {
+		if h.ModelFormat() == ModelFormatSmithy {
+			return fmt.Errorf(
+				"cannot use aws-sdk-go version %s: Smithy models are read from %s",
+				version, h.modelsPath,
+			)
+		}
+		return fmt.Errorf(
+			"cannot use aws-sdk-go version %s: models are read from %s",
+			version, h.modelsPath,
+		)
+	}
 	if h.gitRepository == nil {
 		gitRepository, err := util.LoadRepository(h.basePath)
 		if err != nil {
@@ -87,6 +214,27 @@ func (h *SDKHelper) WithSDKVersion(version string) error {
 	return nil
 }
 
+// Copy returns a new SDKHelper reading the model files from the same source.
+// The copy has its own model loader and API version, so that the models of
+// different services can be loaded concurrently, one SDKHelper per service.
+func (h *SDKHelper) Copy() *SDKHelper {
+	c := &SDKHelper{
+		gitRepository:  h.gitRepository,
+		basePath:       h.basePath,
+		modelsPath:     h.modelsPath,
+		goModCachePath: h.goModCachePath,
+		modelFormat:    h.modelFormat,
+		APIGroupSuffix: h.APIGroupSuffix,
+	}
+	if h.loader != nil {
+		c.loader = &awssdkmodel.Loader{
+			BaseImport:            h.loader.BaseImport,
+			IgnoreUnsupportedAPIs: h.loader.IgnoreUnsupportedAPIs,
+		}
+	}
+	return c
+}
+
 // WithAPIVersion sets the `apiVersion` field.
 func (h *SDKHelper) WithAPIVersion(apiVersion string) {
 	h.apiVersion = apiVersion
@@ -94,6 +242,9 @@ func (h *SDKHelper) WithAPIVersion(apiVersion string) {
 
 // API returns the aws-sdk-go API model for a supplied service alias
 func (h *SDKHelper) API(serviceAlias string) (*SDKAPI, error) {
+	if h.ModelFormat() == ModelFormatSmithy {
+		return h.smithyAPI(serviceAlias)
+	}
 	modelPath, _, err := h.ModelAndDocsPath(serviceAlias)
 	if err != nil {
 		return nil, err
@@ -102,6 +253,16 @@ func (h *SDKHelper) API(serviceAlias string) (*SDKAPI, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The aws-sdk-go API model loader drops most of the shape constraints, so
+	// they're read separately.
+	content, err := ioutil.ReadFile(modelPath)
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := loadShapeConstraints(content)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode %s: %v", modelPath, err)
+	}
 	// apis is a map, keyed by the service alias, of pointers to aws-sdk-go
 	// model API objects
 	for _, api := range apis {
@@ -112,11 +273,62 @@ func (h *SDKHelper) API(serviceAlias string) (*SDKAPI, error) {
 		// Calling API.ServicePackageDoc() ends up resetting the API.imports
 		// unexported map variable...
 		_ = api.ServicePackageDoc()
-		return &SDKAPI{api, nil, nil, h.APIGroupSuffix}, nil
+		return &SDKAPI{
+			API:              api,
+			apiGroupSuffix:   h.APIGroupSuffix,
+			shapeConstraints: constraints,
+		}, nil
 	}
 	return nil, ErrServiceNotFound
 }
 
+// smithyAPI returns the API model for a supplied service alias, read from a
+// Smithy JSON AST model file
+func (h *SDKHelper) smithyAPI(serviceAlias string) (*SDKAPI, error) {
+	modelPath, err := h.SmithyModelPath(serviceAlias)
+	if err != nil {
+		return nil, err
+	}
+	api, nonNullableMembers, constraints, err := loadSmithyAPI(modelPath, filepath.Dir(h.modelsPath))
+	if err != nil {
+		return nil, err
+	}
+	_ = api.ServicePackageDoc()
+	return &SDKAPI{
+		API:                api,
+		apiGroupSuffix:     h.APIGroupSuffix,
+		modelFormat:        ModelFormatSmithy,
+		nonNullableMembers: nonNullableMembers,
+		shapeConstraints:   constraints,
+	}, nil
+}
+
+// SmithyModelPath returns the path of the Smithy JSON AST model file of the
+// supplied service alias. When several versions of the model are found (e.g.
+// `ecr.2015-09-21.json`), the one matching the `apiVersion` field or the last
+// one is returned.
+func (h *SDKHelper) SmithyModelPath(serviceAlias string) (string, error) {
+	modelPath := filepath.Join(h.modelsPath, serviceAlias+".json")
+	if _, err := os.Stat(modelPath); err == nil {
+		return modelPath, nil
+	}
+	if h.apiVersion != "" {
+		modelPath = filepath.Join(h.modelsPath, serviceAlias+"."+h.apiVersion+".json")
+		if _, err := os.Stat(modelPath); err == nil {
+			return modelPath, nil
+		}
+	}
+	modelPaths, err := filepath.Glob(filepath.Join(h.modelsPath, serviceAlias+".*.json"))
+	if err != nil {
+		return "", err
+	}
+	if len(modelPaths) == 0 {
+		return "", fmt.Errorf("%s: %v", serviceAlias, ErrServiceNotFound)
+	}
+	sort.Strings(modelPaths)
+	return modelPaths[len(modelPaths)-1], nil
+}
+
 // ModelAndDocsPath returns two string paths to the supplied service alias'
 // model and doc JSON files
 func (h *SDKHelper) ModelAndDocsPath(
@@ -130,7 +342,7 @@ func (h *SDKHelper) ModelAndDocsPath(
 		h.apiVersion = apiVersion
 	}
 	versionPath := filepath.Join(
-		h.basePath, "models", "apis", serviceAlias, h.apiVersion,
+		h.modelsPath, "apis", serviceAlias, h.apiVersion,
 	)
 	modelPath := filepath.Join(versionPath, "api-2.json")
 	docsPath := filepath.Join(versionPath, "docs-2.json")
@@ -150,7 +362,7 @@ func (h *SDKHelper) FirstAPIVersion(serviceAlias string) (string, error) {
 
 // GetAPIVersions returns the list of API Versions found in a service directory.
 func (h *SDKHelper) GetAPIVersions(serviceAlias string) ([]string, error) {
-	apiPath := filepath.Join(h.basePath, "models", "apis", serviceAlias)
+	apiPath := filepath.Join(h.modelsPath, "apis", serviceAlias)
 	versionDirs, err := ioutil.ReadDir(apiPath)
 	if err != nil {
 		return nil, err
@@ -185,6 +397,43 @@ type SDKAPI struct {
 	typeRenames map[string]string
 	// Default is "services.k8s.aws"
 	apiGroupSuffix string
+	// Format of the model file the API was read from
+	modelFormat ModelFormat
+	// Set of the boolean and number members that aren't nullable, keyed by
+	// `nullableMemberKey`. Only Smithy models have non-nullable members.
+	nonNullableMembers map[string]bool
+	// Map, keyed by shape name, of the shape constraints that the aws-sdk-go
+	// API model loader drops
+	shapeConstraints map[string]*shapeConstraints
+}
+
+// ModelFormat returns the format of the model file the API was read from
+func (a *SDKAPI) ModelFormat() ModelFormat {
+	if a.modelFormat == "" {
+		return ModelFormatAPI2
+	}
+	return a.modelFormat
+}
+
+// IsNullableMember returns true if the member of the supplied structure shape
+// can be null. The aws-sdk-go represents every scalar member with a pointer
+// type, while the aws-sdk-go-v2 uses value types for the boolean and number
+// members that have a default value.
+func (a *SDKAPI) IsNullableMember(
+	shape *awssdkmodel.Shape,
+	memberName string,
+) bool {
+	if len(a.nonNullableMembers) == 0 || shape == nil {
+		return true
+	}
+	// The aws-sdk-go API model loader renames the operations' input and
+	// output shapes.
+	for _, shapeName := range []string{shape.ShapeName, shape.OrigShapeName} {
+		if a.nonNullableMembers[nullableMemberKey(shapeName, memberName)] {
+			return false
+		}
+	}
+	return true
 }
 
 // GetPayloads returns a slice of strings of Shape names representing input and
@@ -14,6 +14,8 @@
 package model_test
 
 import (
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -173,3 +175,77 @@ func TestGetOutputShapeRef(t *testing.T) {
 		}
 	}
 }
+
+func TestSDKHelper_ModelsPath(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	basePath, err := filepath.Abs("../testdata")
+	require.Nil(err)
+
+	expected, err := model.NewSDKHelper(basePath).API("lambda")
+	require.Nil(err)
+
+	sdkHelper := model.NewSDKHelperFromModelsPath(filepath.Join(basePath, "models"))
+	got, err := sdkHelper.API("lambda")
+	require.Nil(err)
+	assert.Equal(expected.API.ShapeNames(), got.API.ShapeNames())
+	assert.Equal(expected.API.OperationNames(), got.API.OperationNames())
+
+	// The aws-sdk-go version cannot be changed when reading a plain models
+	// directory
+	assert.NotNil(sdkHelper.WithSDKVersion("v1.0.0"))
+}
+
+func TestSDKHelper_GoModCache(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	basePath, err := filepath.Abs("../testdata")
+	require.Nil(err)
+
+	expected, err := model.NewSDKHelper(basePath).API("lambda")
+	require.Nil(err)
+
+	// Fake a Go module cache containing a single aws-sdk-go version
+	goModCachePath, err := ioutil.TempDir("", "gomodcache")
+	require.Nil(err)
+	defer os.RemoveAll(goModCachePath)
+	modulePath := model.SDKModulePath(goModCachePath, "v1.0.0")
+	require.Equal(
+		filepath.Join(goModCachePath, "github.com", "aws", "aws-sdk-go@v1.0.0"),
+		modulePath,
+	)
+	require.Nil(os.MkdirAll(filepath.Dir(modulePath), os.ModePerm))
+	require.Nil(os.Symlink(basePath, modulePath))
+
+	sdkHelper := model.NewSDKHelperFromGoModCache(goModCachePath, "v1.0.0")
+	got, err := sdkHelper.API("lambda")
+	require.Nil(err)
+	assert.Equal(expected.API.ShapeNames(), got.API.ShapeNames())
+	assert.Equal(expected.API.OperationNames(), got.API.OperationNames())
+
+	assert.Nil(sdkHelper.WithSDKVersion("v1.0.0"))
+	assert.NotNil(sdkHelper.WithSDKVersion("v2.0.0"))
+}
+
+func TestSDKHelper_Copy(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	basePath, err := filepath.Abs("../testdata")
+	require.Nil(err)
+
+	sdkHelper := model.NewSDKHelper(basePath)
+	lambda, err := sdkHelper.API("lambda")
+	require.Nil(err)
+
+	// The API version of the first service loaded must not leak into the
+	// copies
+	ecr, err := sdkHelper.Copy().API("ecr")
+	require.Nil(err)
+	assert.Equal("ecr", ecr.API.PackageName())
+	got, err := sdkHelper.Copy().API("lambda")
+	require.Nil(err)
+	assert.Equal(lambda.API.ShapeNames(), got.API.ShapeNames())
+}
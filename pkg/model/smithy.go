@@ -0,0 +1,737 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+)
+
+// Smithy JSON AST models, as used by the aws-sdk-go-v2, are translated into
+// the aws-sdk-go api-2.json representation and loaded with the aws-sdk-go API
+// model loader. That way the rest of the code generator works on the same
+// `awssdkmodel.API` shapes, whatever the model source.
+//
+// See https://awslabs.github.io/smithy/1.0/spec/core/json-ast.html
+
+const (
+	smithyPreludeNamespace = "smithy.api"
+	smithyUnit             = "smithy.api#Unit"
+)
+
+// smithyModel is a Smithy JSON AST model
+type smithyModel struct {
+	Version string                  `json:"smithy"`
+	Shapes  map[string]*smithyShape `json:"shapes"`
+}
+
+// smithyShape is a shape of a Smithy JSON AST model. Only the properties
+// needed to build an api-2.json model are decoded.
+type smithyShape struct {
+	Type    string                   `json:"type"`
+	Version string                   `json:"version"`
+	Members map[string]*smithyMember `json:"members"`
+	Member  *smithyMember            `json:"member"`
+	Key     *smithyMember            `json:"key"`
+	Value   *smithyMember            `json:"value"`
+	Input   *smithyMember            `json:"input"`
+	Output  *smithyMember            `json:"output"`
+	Errors  []*smithyMember          `json:"errors"`
+	// Service and resource bindings
+	Operations           []*smithyMember `json:"operations"`
+	CollectionOperations []*smithyMember `json:"collectionOperations"`
+	Resources            []*smithyMember `json:"resources"`
+	Create               *smithyMember   `json:"create"`
+	Put                  *smithyMember   `json:"put"`
+	Read                 *smithyMember   `json:"read"`
+	Update               *smithyMember   `json:"update"`
+	Delete               *smithyMember   `json:"delete"`
+	List                 *smithyMember   `json:"list"`
+	Traits               smithyTraits    `json:"traits"`
+}
+
+// smithyMember is a shape member or a reference to a shape
+type smithyMember struct {
+	Target string       `json:"target"`
+	Traits smithyTraits `json:"traits"`
+}
+
+// smithyTraits contains the traits applied to a shape or member, keyed by
+// their absolute shape ID
+type smithyTraits map[string]json.RawMessage
+
+// has returns true if the trait is applied
+func (t smithyTraits) has(traitID string) bool {
+	_, found := t[traitID]
+	return found
+}
+
+// decode decodes the value of a trait. It returns false if the trait isn't
+// applied.
+func (t smithyTraits) decode(traitID string, value interface{}) bool {
+	raw, found := t[traitID]
+	if !found {
+		return false
+	}
+	return json.Unmarshal(raw, value) == nil
+}
+
+// string returns the value of a string trait
+func (t smithyTraits) string(traitID string) string {
+	var value string
+	t.decode(traitID, &value)
+	return value
+}
+
+// smithyPreludeTypes maps the simple shapes of the Smithy prelude to their
+// api-2.json type
+var smithyPreludeTypes = map[string]string{
+	"String":           "string",
+	"Blob":             "blob",
+	"Boolean":          "boolean",
+	"PrimitiveBoolean": "boolean",
+	"Byte":             "integer",
+	"PrimitiveByte":    "integer",
+	"Short":            "integer",
+	"PrimitiveShort":   "integer",
+	"Integer":          "integer",
+	"PrimitiveInteger": "integer",
+	"Long":             "long",
+	"PrimitiveLong":    "long",
+	"Float":            "float",
+	"PrimitiveFloat":   "float",
+	"Double":           "double",
+	"PrimitiveDouble":  "double",
+	"BigInteger":       "long",
+	"BigDecimal":       "double",
+	"Timestamp":        "timestamp",
+	"Document":         "structure",
+}
+
+// smithyBoxedPreludeShapes contains the prelude shapes that are boxed in
+// Smithy 1.0 models, meaning they can be null
+var smithyBoxedPreludeShapes = []string{
+	"Boolean", "Byte", "Short", "Integer", "Long", "Float", "Double",
+	"BigInteger", "BigDecimal",
+}
+
+// smithyTypes maps the Smithy simple and aggregate shape types to their
+// api-2.json type
+var smithyTypes = map[string]string{
+	"string":     "string",
+	"enum":       "string",
+	"blob":       "blob",
+	"boolean":    "boolean",
+	"byte":       "integer",
+	"short":      "integer",
+	"integer":    "integer",
+	"intEnum":    "integer",
+	"long":       "long",
+	"float":      "float",
+	"double":     "double",
+	"bigInteger": "long",
+	"bigDecimal": "double",
+	"timestamp":  "timestamp",
+	"document":   "structure",
+	"structure":  "structure",
+	"union":      "structure",
+	"list":       "list",
+	"set":        "list",
+	"map":        "map",
+}
+
+// smithyProtocol is a Smithy AWS protocol trait and its api-2.json protocol
+// and JSON version
+type smithyProtocol struct {
+	traitID     string
+	protocol    string
+	jsonVersion string
+}
+
+// smithyProtocols lists the Smithy AWS protocol traits, in order of
+// preference when a service supports more than one protocol
+var smithyProtocols = []smithyProtocol{
+	{"aws.protocols#awsJson1_0", "json", "1.0"},
+	{"aws.protocols#awsJson1_1", "json", "1.1"},
+	{"aws.protocols#restJson1", "rest-json", ""},
+	{"aws.protocols#restXml", "rest-xml", ""},
+	{"aws.protocols#awsQuery", "query", ""},
+	{"aws.protocols#ec2Query", "ec2", ""},
+}
+
+// smithyShapeName returns the name of a shape in the api-2.json model: the
+// shape ID without its namespace
+func smithyShapeName(shapeID string) string {
+	return shapeID[strings.Index(shapeID, "#")+1:]
+}
+
+// loadSmithyAPI loads a Smithy JSON AST model file into an aws-sdk-go API
+// model. It also returns the members of the model that aren't nullable, keyed
+// by `nullableMemberKey`.
+func loadSmithyAPI(
+	modelPath string,
+	baseImport string,
+) (*awssdkmodel.API, map[string]bool, map[string]*shapeConstraints, error) {
+	content, err := ioutil.ReadFile(modelPath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	model := &smithyModel{}
+	if err = json.Unmarshal(content, model); err != nil {
+		return nil, nil, nil, fmt.Errorf("cannot decode Smithy model %s: %v", modelPath, err)
+	}
+	t := &smithyTranslator{
+		model:       model,
+		shapes:      map[string]map[string]interface{}{},
+		nonNullable: map[string]bool{},
+		paginators:  map[string]*awssdkmodel.Paginator{},
+	}
+	doc, err := t.api2Document()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("cannot translate Smithy model %s: %v", modelPath, err)
+	}
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	constraints, err := loadShapeConstraints(b)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	api := &awssdkmodel.API{
+		BaseImportPath:        baseImport,
+		BaseCrosslinkURL:      "https://docs.aws.amazon.com",
+		IgnoreUnsupportedAPIs: true,
+	}
+	if err = api.AttachString(string(b)); err != nil {
+		return nil, nil, nil, err
+	}
+	for opName, paginator := range t.paginators {
+		if op, found := api.Operations[opName]; found {
+			op.Paginator = paginator
+		}
+	}
+	return api, t.nonNullable, constraints, nil
+}
+
+// nullableMemberKey returns the key of a member in the set of non-nullable
+// members. Member names are lowercased because the aws-sdk-go API model
+// loader renames members to make them exportable.
+func nullableMemberKey(shapeName string, memberName string) string {
+	return shapeName + "." + strings.ToLower(memberName)
+}
+
+// smithyTranslator translates a Smithy JSON AST model into an api-2.json
+// document
+type smithyTranslator struct {
+	model *smithyModel
+	// shapes contains the api-2.json shapes, keyed by shape name
+	shapes map[string]map[string]interface{}
+	// shapeIDs contains the Smithy shape ID of each api-2.json shape name
+	shapeIDs map[string]string
+	// nonNullable contains the members of boolean and number types that
+	// aren't nullable, keyed by `nullableMemberKey`
+	nonNullable map[string]bool
+	// servicePagination contains the default pagination tokens of the
+	// service's paginated operations
+	servicePagination smithyPagination
+	// paginators contains the paginators of the paginated operations, keyed
+	// by operation name, like the aws-sdk-go paginators-1.json file
+	paginators map[string]*awssdkmodel.Paginator
+}
+
+// smithyPagination is the value of the smithy.api#paginated trait
+type smithyPagination struct {
+	InputToken  string `json:"inputToken"`
+	OutputToken string `json:"outputToken"`
+	PageSize    string `json:"pageSize"`
+}
+
+// api2Document returns the api-2.json document of the Smithy model service
+func (t *smithyTranslator) api2Document() (map[string]interface{}, error) {
+	serviceID := ""
+	for shapeID, shape := range t.model.Shapes {
+		if shape.Type != "service" {
+			continue
+		}
+		if serviceID != "" {
+			return nil, fmt.Errorf("found more than one service: %s, %s", serviceID, shapeID)
+		}
+		serviceID = shapeID
+	}
+	if serviceID == "" {
+		return nil, fmt.Errorf("cannot find a service shape")
+	}
+	service := t.model.Shapes[serviceID]
+	service.Traits.decode("smithy.api#paginated", &t.servicePagination)
+
+	t.shapeIDs = map[string]string{}
+	for shapeID, shape := range t.model.Shapes {
+		switch shape.Type {
+		case "service", "resource", "operation", "apply":
+			continue
+		}
+		name := smithyShapeName(shapeID)
+		if otherID, found := t.shapeIDs[name]; found {
+			return nil, fmt.Errorf("shapes %s and %s have the same name", shapeID, otherID)
+		}
+		t.shapeIDs[name] = shapeID
+	}
+
+	operations := map[string]interface{}{}
+	for _, opID := range t.serviceOperations(service) {
+		opShape, found := t.model.Shapes[opID]
+		if !found {
+			return nil, fmt.Errorf("cannot find operation %s", opID)
+		}
+		op, err := t.operation(opID, opShape)
+		if err != nil {
+			return nil, err
+		}
+		operations[smithyShapeName(opID)] = op
+	}
+
+	shapes := map[string]interface{}{}
+	for name, shape := range t.shapes {
+		shapes[name] = shape
+	}
+	return map[string]interface{}{
+		"version":       "2.0",
+		"metadata":      t.metadata(serviceID, service),
+		"operations":    operations,
+		"shapes":        shapes,
+		"documentation": service.Traits.string("smithy.api#documentation"),
+	}, nil
+}
+
+// serviceOperations returns the sorted IDs of all the operations bound to a
+// service, either directly or through its resources
+func (t *smithyTranslator) serviceOperations(service *smithyShape) []string {
+	seen := map[string]bool{}
+	var visit func(shape *smithyShape)
+	visit = func(shape *smithyShape) {
+		refs := append([]*smithyMember{}, shape.Operations...)
+		refs = append(refs, shape.CollectionOperations...)
+		for _, ref := range []*smithyMember{
+			shape.Create, shape.Put, shape.Read, shape.Update, shape.Delete, shape.List,
+		} {
+			if ref != nil {
+				refs = append(refs, ref)
+			}
+		}
+		for _, ref := range refs {
+			seen[ref.Target] = true
+		}
+		for _, ref := range shape.Resources {
+			if resource, found := t.model.Shapes[ref.Target]; found {
+				visit(resource)
+			}
+		}
+	}
+	visit(service)
+	res := make([]string, 0, len(seen))
+	for opID := range seen {
+		res = append(res, opID)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// metadata returns the api-2.json metadata of a service
+func (t *smithyTranslator) metadata(
+	serviceID string,
+	service *smithyShape,
+) map[string]interface{} {
+	awsService := struct {
+		SDKID          string `json:"sdkId"`
+		EndpointPrefix string `json:"endpointPrefix"`
+	}{}
+	service.Traits.decode("aws.api#service", &awsService)
+	sigv4 := struct {
+		Name string `json:"name"`
+	}{}
+	service.Traits.decode("aws.auth#sigv4", &sigv4)
+
+	sdkID := awsService.SDKID
+	if sdkID == "" {
+		sdkID = smithyShapeName(serviceID)
+	}
+	endpointPrefix := awsService.EndpointPrefix
+	if endpointPrefix == "" {
+		endpointPrefix = strings.ToLower(strings.Replace(sdkID, " ", "-", -1))
+	}
+	metadata := map[string]interface{}{
+		"apiVersion":      service.Version,
+		"endpointPrefix":  endpointPrefix,
+		"serviceFullName": service.Traits.string("smithy.api#title"),
+		// The aws-sdk-go derives the service package name from its
+		// abbreviation, the aws-sdk-go-v2 from its SDK ID.
+		"serviceAbbreviation": sdkID,
+		"serviceId":           sdkID,
+		"signatureVersion":    "v4",
+		"signingName":         sigv4.Name,
+		"uid": fmt.Sprintf(
+			"%s-%s", strings.ToLower(strings.Replace(sdkID, " ", "-", -1)), service.Version,
+		),
+	}
+	for _, protocol := range smithyProtocols {
+		if !service.Traits.has(protocol.traitID) {
+			continue
+		}
+		metadata["protocol"] = protocol.protocol
+		if protocol.jsonVersion != "" {
+			metadata["jsonVersion"] = protocol.jsonVersion
+			metadata["targetPrefix"] = smithyShapeName(serviceID)
+		}
+		break
+	}
+	return metadata
+}
+
+// operation returns the api-2.json representation of an operation and adds
+// the shapes it refers to
+func (t *smithyTranslator) operation(
+	opID string,
+	opShape *smithyShape,
+) (map[string]interface{}, error) {
+	http := struct {
+		Method string `json:"method"`
+		URI    string `json:"uri"`
+		Code   int    `json:"code"`
+	}{Method: "POST", URI: "/"}
+	opShape.Traits.decode("smithy.api#http", &http)
+	httpInfo := map[string]interface{}{
+		"method":     http.Method,
+		"requestUri": http.URI,
+	}
+	if http.Code != 0 {
+		httpInfo["responseCode"] = http.Code
+	}
+	op := map[string]interface{}{
+		"name": smithyShapeName(opID),
+		"http": httpInfo,
+	}
+	if doc := opShape.Traits.string("smithy.api#documentation"); doc != "" {
+		op["documentation"] = doc
+	}
+	if opShape.Traits.has("smithy.api#deprecated") {
+		op["deprecated"] = true
+	}
+	// The operation's pagination trait is merged over the service's one
+	pagination := t.servicePagination
+	if opShape.Traits.decode("smithy.api#paginated", &pagination) &&
+		pagination.InputToken != "" && pagination.OutputToken != "" {
+		t.paginators[smithyShapeName(opID)] = &awssdkmodel.Paginator{
+			InputTokens:  []string{pagination.InputToken},
+			OutputTokens: []string{pagination.OutputToken},
+			LimitKey:     pagination.PageSize,
+		}
+	}
+	for key, ref := range map[string]*smithyMember{
+		"input":  opShape.Input,
+		"output": opShape.Output,
+	} {
+		if ref == nil || ref.Target == smithyUnit {
+			continue
+		}
+		name, err := t.addShape(ref.Target)
+		if err != nil {
+			return nil, fmt.Errorf("operation %s: %v", opID, err)
+		}
+		op[key] = map[string]interface{}{"shape": name}
+	}
+	errors := []interface{}{}
+	for _, ref := range opShape.Errors {
+		name, err := t.addShape(ref.Target)
+		if err != nil {
+			return nil, fmt.Errorf("operation %s: %v", opID, err)
+		}
+		errors = append(errors, map[string]interface{}{"shape": name})
+	}
+	if len(errors) > 0 {
+		op["errors"] = errors
+	}
+	return op, nil
+}
+
+// addShape adds the api-2.json representation of a shape, and the shapes it
+// refers to, to the translated shapes. It returns the api-2.json shape name.
+func (t *smithyTranslator) addShape(shapeID string) (string, error) {
+	name := smithyShapeName(shapeID)
+	if strings.HasPrefix(shapeID, smithyPreludeNamespace+"#") {
+		return t.addPreludeShape(name)
+	}
+	if _, found := t.shapes[name]; found {
+		return name, nil
+	}
+	shape, found := t.model.Shapes[shapeID]
+	if !found {
+		return "", fmt.Errorf("cannot find shape %s", shapeID)
+	}
+	api2Type, found := smithyTypes[shape.Type]
+	if !found {
+		return "", fmt.Errorf("unsupported type %q of shape %s", shape.Type, shapeID)
+	}
+	res := map[string]interface{}{"type": api2Type}
+	// Register the shape before adding the shapes it refers to so that
+	// recursive shapes terminate.
+	t.shapes[name] = res
+	t.addShapeTraits(res, shape.Traits)
+
+	switch shape.Type {
+	case "structure", "union":
+		members := map[string]interface{}{}
+		required := []string{}
+		for memberName, member := range shape.Members {
+			memberRef, err := t.memberRef(member)
+			if err != nil {
+				return "", fmt.Errorf("member %s of shape %s: %v", memberName, shapeID, err)
+			}
+			members[memberName] = memberRef
+			if member.Traits.has("smithy.api#required") {
+				required = append(required, memberName)
+			}
+			if member.Traits.has("smithy.api#httpPayload") {
+				res["payload"] = memberName
+			}
+			if !t.isNullable(member) {
+				t.nonNullable[nullableMemberKey(name, memberName)] = true
+			}
+		}
+		res["members"] = members
+		if len(required) > 0 {
+			sort.Strings(required)
+			res["required"] = required
+		}
+		if shape.Traits.has("smithy.api#error") {
+			res["exception"] = true
+			res["error"] = t.errorInfo(shape.Traits)
+		}
+	case "enum":
+		enum := []string{}
+		for memberName, member := range shape.Members {
+			value := member.Traits.string("smithy.api#enumValue")
+			if value == "" {
+				value = memberName
+			}
+			enum = append(enum, value)
+		}
+		sort.Strings(enum)
+		res["enum"] = enum
+	case "list", "set":
+		if shape.Member == nil {
+			return "", fmt.Errorf("missing member of shape %s", shapeID)
+		}
+		memberRef, err := t.memberRef(shape.Member)
+		if err != nil {
+			return "", fmt.Errorf("member of shape %s: %v", shapeID, err)
+		}
+		res["member"] = memberRef
+	case "map":
+		if shape.Key == nil || shape.Value == nil {
+			return "", fmt.Errorf("missing key or value of shape %s", shapeID)
+		}
+		keyRef, err := t.memberRef(shape.Key)
+		if err != nil {
+			return "", fmt.Errorf("key of shape %s: %v", shapeID, err)
+		}
+		valueRef, err := t.memberRef(shape.Value)
+		if err != nil {
+			return "", fmt.Errorf("value of shape %s: %v", shapeID, err)
+		}
+		res["key"] = keyRef
+		res["value"] = valueRef
+	}
+	return name, nil
+}
+
+// addPreludeShape adds a shape of the Smithy prelude to the translated
+// shapes. Prelude shapes keep their name unless the model defines a shape
+// with the same name.
+func (t *smithyTranslator) addPreludeShape(name string) (string, error) {
+	api2Type, found := smithyPreludeTypes[name]
+	if !found {
+		return "", fmt.Errorf("unsupported prelude shape %s", name)
+	}
+	if _, found := t.shapeIDs[name]; found {
+		name = "Smithy" + name
+	}
+	if _, found := t.shapes[name]; !found {
+		t.shapes[name] = map[string]interface{}{"type": api2Type}
+	}
+	return name, nil
+}
+
+// memberRef returns the api-2.json shape reference of a member
+func (t *smithyTranslator) memberRef(
+	member *smithyMember,
+) (map[string]interface{}, error) {
+	name, err := t.addShape(member.Target)
+	if err != nil {
+		return nil, err
+	}
+	ref := map[string]interface{}{"shape": name}
+	traits := member.Traits
+	if doc := traits.string("smithy.api#documentation"); doc != "" {
+		ref["documentation"] = doc
+	}
+	for _, traitID := range []string{"smithy.api#jsonName", "smithy.api#xmlName"} {
+		if locationName := traits.string(traitID); locationName != "" {
+			ref["locationName"] = locationName
+		}
+	}
+	if header := traits.string("smithy.api#httpHeader"); header != "" {
+		ref["location"] = "header"
+		ref["locationName"] = header
+	}
+	if query := traits.string("smithy.api#httpQuery"); query != "" {
+		ref["location"] = "querystring"
+		ref["locationName"] = query
+	}
+	if traits.has("smithy.api#httpLabel") {
+		ref["location"] = "uri"
+	}
+	if traits.has("smithy.api#httpResponseCode") {
+		ref["location"] = "statusCode"
+	}
+	if traits.has("smithy.api#idempotencyToken") {
+		ref["idempotencyToken"] = true
+	}
+	if traits.has("smithy.api#xmlFlattened") {
+		ref["flattened"] = true
+	}
+	if traits.has("smithy.api#deprecated") {
+		ref["deprecated"] = true
+	}
+	if format := traits.string("smithy.api#timestampFormat"); format != "" {
+		ref["timestampFormat"] = format
+	}
+	return ref, nil
+}
+
+// addShapeTraits adds the api-2.json properties matching the traits of a
+// shape
+func (t *smithyTranslator) addShapeTraits(
+	shape map[string]interface{},
+	traits smithyTraits,
+) {
+	if doc := traits.string("smithy.api#documentation"); doc != "" {
+		shape["documentation"] = doc
+	}
+	bounds := struct {
+		Min *float64 `json:"min"`
+		Max *float64 `json:"max"`
+	}{}
+	if traits.decode("smithy.api#length", &bounds) || traits.decode("smithy.api#range", &bounds) {
+		if bounds.Min != nil {
+			shape["min"] = *bounds.Min
+		}
+		if bounds.Max != nil {
+			shape["max"] = *bounds.Max
+		}
+	}
+	if pattern := traits.string("smithy.api#pattern"); pattern != "" {
+		shape["pattern"] = pattern
+	}
+	// Smithy 1.0 enums are strings with an enum trait
+	enumDefs := []struct {
+		Value string `json:"value"`
+	}{}
+	if traits.decode("smithy.api#enum", &enumDefs) {
+		enum := []string{}
+		for _, def := range enumDefs {
+			enum = append(enum, def.Value)
+		}
+		shape["enum"] = enum
+	}
+	if traits.has("smithy.api#sensitive") {
+		shape["sensitive"] = true
+	}
+	if traits.has("smithy.api#streaming") {
+		shape["streaming"] = true
+	}
+	if traits.has("smithy.api#deprecated") {
+		shape["deprecated"] = true
+	}
+	if format := traits.string("smithy.api#timestampFormat"); format != "" {
+		shape["timestampFormat"] = format
+	}
+}
+
+// errorInfo returns the api-2.json error information of an error structure
+func (t *smithyTranslator) errorInfo(traits smithyTraits) map[string]interface{} {
+	statusCode := 500
+	if traits.string("smithy.api#error") == "client" {
+		statusCode = 400
+	}
+	traits.decode("smithy.api#httpError", &statusCode)
+	info := map[string]interface{}{
+		"httpStatusCode": statusCode,
+		"senderFault":    statusCode < 500,
+	}
+	queryError := struct {
+		Code             string `json:"code"`
+		HTTPResponseCode int    `json:"httpResponseCode"`
+	}{}
+	if traits.decode("aws.protocols#awsQueryError", &queryError) {
+		info["code"] = queryError.Code
+		if queryError.HTTPResponseCode != 0 {
+			info["httpStatusCode"] = queryError.HTTPResponseCode
+		}
+	}
+	return info
+}
+
+// isNullable returns true if the value of a structure member can be null.
+// Only boolean and number members can be non-nullable: the aws-sdk-go-v2
+// represents them with value types instead of pointers.
+//
+// In Smithy 1.0 models, boolean and number shapes are nullable when they are
+// boxed. In Smithy 2.0 models, members are nullable unless they have a
+// default value.
+func (t *smithyTranslator) isNullable(member *smithyMember) bool {
+	targetType := ""
+	targetBoxed := false
+	if strings.HasPrefix(member.Target, smithyPreludeNamespace+"#") {
+		name := smithyShapeName(member.Target)
+		targetType = smithyPreludeTypes[name]
+		for _, boxed := range smithyBoxedPreludeShapes {
+			if name == boxed {
+				targetBoxed = true
+			}
+		}
+	} else if target, found := t.model.Shapes[member.Target]; found {
+		targetType = smithyTypes[target.Type]
+		if target.Type == "intEnum" {
+			// intEnums are value types, like enums
+			return false
+		}
+		targetBoxed = target.Traits.has("smithy.api#box")
+	}
+	switch targetType {
+	case "boolean", "integer", "long", "float", "double":
+	default:
+		return true
+	}
+	if strings.HasPrefix(t.model.Version, "2") {
+		return !member.Traits.has("smithy.api#default") ||
+			member.Traits.has("smithy.api#clientOptional")
+	}
+	return targetBoxed || member.Traits.has("smithy.api#box")
+}
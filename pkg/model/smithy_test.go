@@ -0,0 +1,180 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model_test
+
+import (
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/model"
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestSDKHelper_SmithyModels(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	modelsPath := filepath.Join("..", "testdata", "aws-models")
+	h := model.NewSDKHelperFromSmithyModelsPath(modelsPath)
+	assert.Equal(model.ModelFormatSmithy, h.ModelFormat())
+	assert.NotNil(h.WithSDKVersion("v1.0.0"))
+
+	modelPath, err := h.SmithyModelPath("ecr")
+	require.Nil(err)
+	assert.Equal(filepath.Join(modelsPath, "ecr.json"), modelPath)
+
+	sdkAPI, err := h.API("ecr")
+	require.Nil(err)
+	assert.Equal(model.ModelFormatSmithy, sdkAPI.ModelFormat())
+	assert.Equal("ECR", sdkAPI.ServiceID())
+	assert.Equal("ecr", sdkAPI.ServiceIDClean())
+	assert.Equal("2015-09-21", sdkAPI.API.Metadata.APIVersion)
+	assert.Equal("json", sdkAPI.API.Metadata.Protocol)
+
+	opNames := []string{}
+	for opName := range sdkAPI.API.Operations {
+		opNames = append(opNames, opName)
+	}
+	sort.Strings(opNames)
+	assert.Equal(
+		[]string{
+			"CreateRepository",
+			"DeleteRepository",
+			"DescribeRepositories",
+			"PutImageScanningConfiguration",
+		},
+		opNames,
+	)
+
+	createOp := sdkAPI.API.Operations["CreateRepository"]
+	require.NotNil(createOp)
+	inputShape := createOp.InputRef.Shape
+	assert.Equal("CreateRepositoryInput", inputShape.ShapeName)
+	assert.Equal([]string{"RepositoryName"}, inputShape.Required)
+	assert.Equal(
+		[]string{
+			"ImageScanningConfiguration",
+			"ImageTagMutability",
+			"RepositoryName",
+			"ScanFrequency",
+			"Tags",
+		},
+		inputShape.MemberNames(),
+	)
+	assert.Equal(
+		[]string{"IMMUTABLE", "MUTABLE"},
+		sortedStrings(inputShape.MemberRefs["ImageTagMutability"].Shape.Enum),
+	)
+	assert.Equal("list", inputShape.MemberRefs["Tags"].Shape.Type)
+	assert.Equal("Tag", inputShape.MemberRefs["Tags"].Shape.MemberRef.Shape.ShapeName)
+
+	repoShape := sdkAPI.API.Shapes["Repository"]
+	require.NotNil(repoShape)
+	assert.Equal("timestamp", repoShape.MemberRefs["CreatedAt"].Shape.Type)
+
+	// Unboxed Smithy 1.0 booleans and numbers are value types in the
+	// aws-sdk-go-v2, boxed ones are pointers.
+	scanConfigShape := sdkAPI.API.Shapes["ImageScanningConfiguration"]
+	require.NotNil(scanConfigShape)
+	assert.False(sdkAPI.IsNullableMember(scanConfigShape, "ScanOnPush"))
+	assert.True(sdkAPI.IsNullableMember(inputShape, "ScanFrequency"))
+	assert.True(sdkAPI.IsNullableMember(inputShape, "RepositoryName"))
+
+	describeInputShape := sdkAPI.API.Operations["DescribeRepositories"].InputRef.Shape
+	assert.True(sdkAPI.IsNullableMember(describeInputShape, "MaxResults"))
+
+	deleteInputShape := sdkAPI.API.Operations["DeleteRepository"].InputRef.Shape
+	assert.False(sdkAPI.IsNullableMember(deleteInputShape, "Force"))
+
+	// The paginated trait is translated into the operation's paginator
+	paginator := sdkAPI.API.Operations["DescribeRepositories"].Paginator
+	require.NotNil(paginator)
+	assert.Equal([]string{"nextToken"}, paginator.InputTokens)
+	assert.Equal([]string{"nextToken"}, paginator.OutputTokens)
+	assert.Equal("maxResults", paginator.LimitKey)
+	assert.Nil(sdkAPI.API.Operations["CreateRepository"].Paginator)
+
+	// Errors are kept as exception shapes
+	notFoundShape := sdkAPI.API.Shapes["RepositoryNotFoundException"]
+	require.NotNil(notFoundShape)
+	assert.True(notFoundShape.Exception)
+}
+
+func TestECRRepository_Smithy(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	g := testutil.NewModelForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		SmithyModels: true,
+	})
+
+	crds, err := g.GetCRDs()
+	require.Nil(err)
+	require.Len(crds, 1)
+
+	crd := getCRDByName("Repository", crds)
+	require.NotNil(crd)
+	assert.NotNil(crd.Ops.Create)
+	assert.NotNil(crd.Ops.Delete)
+	assert.NotNil(crd.Ops.ReadMany)
+
+	specFields := crd.SpecFields
+	assert.Equal(
+		[]string{
+			"ImageScanningConfiguration",
+			"ImageTagMutability",
+			"RepositoryName",
+			"ScanFrequency",
+			"Tags",
+		},
+		attrCamelNames(specFields),
+	)
+	assert.Equal("*int64", specFields["ScanFrequency"].GoType)
+	assert.Equal("[]*Tag", specFields["Tags"].GoType)
+
+	statusFields := crd.StatusFields
+	assert.Equal(
+		[]string{
+			"CreatedAt",
+			"RegistryID",
+			"RepositoryURI",
+		},
+		attrCamelNames(statusFields),
+	)
+	assert.Equal("*metav1.Time", statusFields["CreatedAt"].GoType)
+
+	scanConfigShape := crd.SpecFields["ImageScanningConfiguration"].ShapeRef.Shape
+	assert.False(crd.IsNullableMember(scanConfigShape, "ScanOnPush"))
+
+	// Smithy length and pattern traits are translated into validation
+	// markers.
+	assert.Equal(
+		[]string{
+			"+kubebuilder:validation:MinLength=2",
+			"+kubebuilder:validation:MaxLength=256",
+			"+kubebuilder:validation:Pattern=`^(?:[a-z0-9]+(?:[._-][a-z0-9]+)*/)*[a-z0-9]+(?:[._-][a-z0-9]+)*$`",
+		},
+		specFields["RepositoryName"].ValidationMarkers(),
+	)
+}
+
+func sortedStrings(s []string) []string {
+	res := append([]string{}, s...)
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,190 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"sort"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+)
+
+// SubResource describes a Spec field containing a part of the resource's
+// configuration that the API manages with a dedicated group of operations,
+// e.g. the versioning configuration of an S3 Bucket
+type SubResource struct {
+	// Field is the Spec field containing the sub-resource
+	Field *Field
+	// MemberName is the name of the PutOp's input shape member containing
+	// the sub-resource
+	MemberName string
+	// PutOp is the operation setting the sub-resource
+	PutOp *ChildOperation
+	// GetOp is the operation reading the sub-resource
+	GetOp *ChildOperation
+	// DeleteOp is the operation removing the sub-resource, or nil if the
+	// sub-resource isn't removed when the field is unset
+	DeleteOp *ChildOperation
+	// UnsetErrorCodes are the codes of the errors GetOp returns when the
+	// sub-resource isn't set
+	UnsetErrorCodes []string
+}
+
+// ParentIsNil returns the Go condition, for the supplied CR variable, that is
+// true when a value identifying the resource in the sub-resource's operations
+// isn't known yet, or an empty string if the operations have no member
+// identifying the resource
+func (s *SubResource) ParentIsNil(koVarName string) string {
+	ops := []*ChildOperation{s.PutOp, s.GetOp}
+	if s.DeleteOp != nil {
+		ops = append(ops, s.DeleteOp)
+	}
+	return parentIsNil(koVarName, ops...)
+}
+
+// MemberShapeRef returns the shape of the PutOp's input shape member
+// containing the sub-resource
+func (s *SubResource) MemberShapeRef() *awssdkmodel.ShapeRef {
+	return s.PutOp.Operation.InputRef.Shape.MemberRefs[s.MemberName]
+}
+
+// SubResources returns the sub-resources of the resource, sorted by field
+// name
+func (r *CRD) SubResources() []*SubResource {
+	return r.subResources
+}
+
+// HasSubResources returns true if the resource has sub-resources
+func (r *CRD) HasSubResources() bool {
+	return len(r.subResources) > 0
+}
+
+// addSubResources resolves the operations of the resource's sub-resources
+// and adds their fields to the Spec
+func (r *CRD) addSubResources() ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
+	if r.cfg == nil {
+		return nil
+	}
+	resConfig, found := r.cfg.Resources[r.Names.Original]
+	if !found {
+		return nil
+	}
+	fieldNames := []string{}
+	for fieldName := range resConfig.SubResources {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+	for _, fieldName := range fieldNames {
+		sub, err := r.newSubResource(fieldName)
+		if err != nil {
+			errs = append(errs, r.newConfigError(
+				[]string{"sub_resources", fieldName}, "%v", err,
+			))
+			continue
+		}
+		r.subResources = append(r.subResources, sub)
+	}
+	return errs
+}
+
+// newSubResource returns the description of the sub-resource with the
+// supplied field name
+func (r *CRD) newSubResource(fieldName string) (*SubResource, error) {
+	cfg := r.cfg.Resources[r.Names.Original].SubResources[fieldName]
+	if cfg == nil {
+		return nil, fmt.Errorf("missing config")
+	}
+	if cfg.Member == "" {
+		return nil, fmt.Errorf("missing member")
+	}
+	res := &SubResource{
+		MemberName:      cfg.Member,
+		UnsetErrorCodes: cfg.UnsetErrorCodes,
+	}
+	var err error
+	if cfg.PutOperation == "" {
+		return nil, fmt.Errorf("missing put_operation")
+	}
+	if res.PutOp, err = r.subResourceOperation(cfg.PutOperation, cfg.Member); err != nil {
+		return nil, err
+	}
+	if cfg.GetOperation == "" {
+		return nil, fmt.Errorf("missing get_operation")
+	}
+	if res.GetOp, err = r.subResourceOperation(cfg.GetOperation, ""); err != nil {
+		return nil, err
+	}
+	if cfg.DeleteOperation != "" {
+		if res.DeleteOp, err = r.subResourceOperation(cfg.DeleteOperation, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	memberRef, found := res.PutOp.Operation.InputRef.Shape.MemberRefs[cfg.Member]
+	if !found {
+		return nil, fmt.Errorf(
+			"unknown member %s in the input shape of %s",
+			cfg.Member, cfg.PutOperation,
+		)
+	}
+	outputShape := res.GetOp.Operation.OutputRef.Shape
+	if outputShape == nil {
+		return nil, ErrNilShapePointer
+	}
+	if _, found := outputShape.MemberRefs[cfg.Member]; !found {
+		// The output shape may contain the members of the structure
+		shared := false
+		if memberRef.Shape.Type == "structure" {
+			for _, memberName := range outputShape.MemberNames() {
+				if _, found := memberRef.Shape.MemberRefs[memberName]; found {
+					shared = true
+					break
+				}
+			}
+		}
+		if !shared {
+			return nil, fmt.Errorf(
+				"output shape of %s contains neither member %s nor its members",
+				cfg.GetOperation, cfg.Member,
+			)
+		}
+	}
+	if _, found := r.SpecFields[fieldName]; found {
+		return nil, fmt.Errorf("a Spec field has the same name")
+	}
+	if _, found := r.StatusFields[fieldName]; found {
+		return nil, fmt.Errorf("a Status field has the same name")
+	}
+	r.AddSpecField(names.New(fieldName), memberRef)
+	res.Field = r.SpecFields[fieldName]
+	return res, nil
+}
+
+// subResourceOperation returns the description of the operation of a
+// sub-resource with the supplied name. The supplied member, containing the
+// sub-resource, isn't resolved to a CR field.
+func (r *CRD) subResourceOperation(
+	name string,
+	memberName string,
+) (*ChildOperation, error) {
+	op, found := r.sdkAPI.API.Operations[name]
+	if !found {
+		return nil, fmt.Errorf("unknown operation %s", name)
+	}
+	return r.newChildOperation(op, memberName, false)
+}
@@ -0,0 +1,163 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+)
+
+// SyncedRule is a rule of the resource's `synced` config, along with the
+// fields found at its path
+type SyncedRule struct {
+	*ackgenconfig.SyncedRule
+	// Fields are the fields found along the rule's path, from the Status
+	// field to the string field whose value is checked
+	Fields []*Field
+}
+
+// SyncedRules returns the rules of the resource's `synced` config, which set
+// the ACK.ResourceSynced condition from the values of Status fields. Rules
+// that can't be applied are left out, see checkSyncedRules.
+func (r *CRD) SyncedRules() []*SyncedRule {
+	cfg := r.syncedConfig()
+	if cfg == nil {
+		return nil
+	}
+	rules := []*SyncedRule{}
+	for i := range cfg.When {
+		rule := &cfg.When[i]
+		fields, err := r.statusStringFields(rule.Path)
+		if err != nil || len(rule.Ready) == 0 {
+			continue
+		}
+		rules = append(rules, &SyncedRule{rule, fields})
+	}
+	return rules
+}
+
+// syncedConfig returns the resource's `synced` config, if any
+func (r *CRD) syncedConfig() *ackgenconfig.SyncedConfig {
+	if r.cfg == nil {
+		return nil
+	}
+	rConfig, found := r.cfg.Resources[r.Names.Original]
+	if !found {
+		return nil
+	}
+	return rConfig.Synced
+}
+
+// statusStringFields returns the fields found along the path of a string
+// field of the resource's Status, e.g. "Status.DBInstanceStatus". The path
+// must lead to the string field through structure fields only.
+func (r *CRD) statusStringFields(
+	path string,
+) ([]*Field, error) {
+	if !strings.HasPrefix(path, "Status.") {
+		return nil, fmt.Errorf("must be the path of a Status field, e.g. Status.%s", path)
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "Status."), ".")
+	fields := []*Field{}
+	for i := range parts {
+		fieldPath := strings.Join(parts[:i+1], ".")
+		f, found := r.Fields[fieldPath]
+		if i == 0 {
+			found = found && r.isStatusField(f)
+		}
+		if !found {
+			candidates := []string{}
+			if i == 0 {
+				candidates = r.StatusFieldNames()
+			}
+			return nil, fmt.Errorf(
+				"unknown field %s%s", fieldPath, ackgenconfig.DidYouMean(parts[i], candidates),
+			)
+		}
+		if i < len(parts)-1 && f.ShapeRef.Shape.Type != "structure" {
+			return nil, fmt.Errorf(
+				"field %s has type %s: only structure fields can contain the field", fieldPath, f.GoType,
+			)
+		}
+		fields = append(fields, f)
+	}
+	leaf := fields[len(fields)-1]
+	if leaf.GoType != "*string" {
+		return nil, fmt.Errorf("field has type %s: only string fields are supported", leaf.GoType)
+	}
+	return fields, nil
+}
+
+// isStatusField returns true if the supplied field is a top-level field of
+// the resource's Status
+func (r *CRD) isStatusField(f *Field) bool {
+	for _, statusField := range r.StatusFields {
+		if statusField == f {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSyncedRules returns the problems found in the resource's `synced`
+// config
+func (r *CRD) checkSyncedRules() ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
+	cfg := r.syncedConfig()
+	if cfg == nil {
+		return errs
+	}
+	if len(cfg.When) == 0 {
+		errs = append(errs, r.newConfigError(
+			[]string{"synced", "when"}, "at least one rule is required",
+		))
+	}
+	for i := range cfg.When {
+		rule := &cfg.When[i]
+		rulePath := []string{"synced", "when", itoa(i)}
+		if _, err := r.statusStringFields(rule.Path); err != nil {
+			errs = append(errs, r.newConfigError(
+				append(rulePath, "path"), "%v", err,
+			))
+		}
+		if len(rule.Ready) == 0 {
+			errs = append(errs, r.newConfigError(
+				append(rulePath, "ready"), "at least one ready value is required",
+			))
+		}
+		seen := map[string]string{}
+		for _, values := range []struct {
+			key    string
+			values []string
+		}{
+			{"ready", rule.Ready},
+			{"in_progress", rule.InProgress},
+			{"terminal", rule.Terminal},
+		} {
+			for _, value := range values.values {
+				if otherKey, found := seen[value]; found {
+					errs = append(errs, r.newConfigError(
+						append(rulePath, values.key),
+						"value %q is already listed in %s", value, otherKey,
+					))
+					continue
+				}
+				seen[value] = values.key
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,320 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+)
+
+// TagsFormat is the representation of tags in the API model
+type TagsFormat string
+
+const (
+	// TagsFormatList is a list of structures with a key and a value member,
+	// e.g. `[{"Key": "team", "Value": "ack"}]`
+	TagsFormatList TagsFormat = "list"
+	// TagsFormatMap is a map of strings, e.g. `{"team": "ack"}`
+	TagsFormatMap TagsFormat = "map"
+)
+
+const (
+	// defaultTagsPath is the default name of the Spec field containing the
+	// tags
+	defaultTagsPath = "Tags"
+	// defaultTagKeyMemberName is the default name of the key member of a tag
+	// structure
+	defaultTagKeyMemberName = "Key"
+	// defaultTagValueMemberName is the default name of the value member of a
+	// tag structure
+	defaultTagValueMemberName = "Value"
+)
+
+var (
+	// tagOperationPatterns are the names of the operations adding tags, by
+	// order of preference. "%s" stands for the resource name.
+	tagOperationPatterns = []string{
+		"Tag%s", "TagResource", "AddTagsTo%s", "AddTagsToResource", "AddTags", "CreateTags",
+	}
+	// untagOperationPatterns are the names of the operations removing tags,
+	// by order of preference
+	untagOperationPatterns = []string{
+		"Untag%s", "UntagResource", "RemoveTagsFrom%s", "RemoveTagsFromResource", "RemoveTags", "DeleteTags",
+	}
+	// listTagsOperationPatterns are the names of the operations returning
+	// the tags of a resource, by order of preference
+	listTagsOperationPatterns = []string{
+		"List%sTags", "ListTagsFor%s", "ListTagsForResource", "ListTagsOfResource", "ListTags",
+	}
+)
+
+// ResourceTagging describes how the tags of a resource are represented and
+// updated in the API model
+type ResourceTagging struct {
+	// Field is the Spec field containing the tags
+	Field *Field
+	// Format is the representation of the tags in the API model
+	Format TagsFormat
+	// TagShapeName is the name of the tag structure shape when Format is
+	// TagsFormatList, e.g. "Tag"
+	TagShapeName string
+	// KeyMemberName is the name of the key member of the tag structure
+	KeyMemberName string
+	// ValueMemberName is the name of the value member of the tag structure
+	ValueMemberName string
+	// IsNormalized is true when the resource has a `tags` config: the Spec
+	// field is then a `map[string]*string` and the tags are synchronized with
+	// the tagging operations
+	IsNormalized bool
+	// TagOp is the operation adding or overwriting tags, or nil
+	TagOp *TagsOperation
+	// UntagOp is the operation removing tags by key, or nil
+	UntagOp *TagsOperation
+	// ListTagsOp is the operation returning the tags of the resource, or nil
+	ListTagsOp *TagsOperation
+}
+
+// TagsOperation describes an operation of the API reading or writing the
+// tags of a resource
+type TagsOperation struct {
+	Operation *awssdkmodel.Operation
+	// ResourceMemberName is the name of the input shape member identifying
+	// the resource, e.g. "ResourceArn"
+	ResourceMemberName string
+	// TagsMemberName is the name of the member containing the tags: in the
+	// input shape of the tag operation, in the output shape of the list tags
+	// operation. For the untag operation, it is the member of the input shape
+	// containing the keys of the tags to remove.
+	TagsMemberName string
+	// Format is the representation of the tags in TagsMemberName. Unset for
+	// the untag operation.
+	Format TagsFormat
+	// resourceFieldPath is the path of the CR field identifying the resource,
+	// e.g. "Status.QueueURL", or empty if the resource is identified by its
+	// ARN
+	resourceFieldPath string
+}
+
+// ResourceVarPath returns the Go expression, as a `*string`, of the value
+// identifying the resource in the supplied CR variable, e.g.
+// `(*string)(ko.Status.ACKResourceMetadata.ARN)`
+func (op *TagsOperation) ResourceVarPath(koVarName string) string {
+	return identifierVarPath(koVarName, op.resourceFieldPath)
+}
+
+// ResourceVarIsNil returns the Go condition, for the supplied CR variable,
+// that is true when the value identifying the resource isn't known yet, e.g.
+// `ko.Status.ACKResourceMetadata == nil || ko.Status.ACKResourceMetadata.ARN == nil`
+func (op *TagsOperation) ResourceVarIsNil(koVarName string) string {
+	return identifierVarIsNil(koVarName, op.resourceFieldPath)
+}
+
+// identifierVarPath returns the Go expression, as a `*string`, of the value
+// at the supplied field path of a CR variable, or of the resource's ARN if the
+// field path is empty
+func identifierVarPath(koVarName string, fieldPath string) string {
+	if fieldPath == "" {
+		return fmt.Sprintf("(*string)(%s.Status.ACKResourceMetadata.ARN)", koVarName)
+	}
+	return koVarName + "." + fieldPath
+}
+
+// identifierVarIsNil returns the Go condition that is true when the value at
+// the supplied field path of a CR variable, or the resource's ARN if the field
+// path is empty, is nil
+func identifierVarIsNil(koVarName string, fieldPath string) string {
+	if fieldPath == "" {
+		return fmt.Sprintf(
+			"%s.Status.ACKResourceMetadata == nil || %s.Status.ACKResourceMetadata.ARN == nil",
+			koVarName, koVarName,
+		)
+	}
+	return koVarName + "." + fieldPath + " == nil"
+}
+
+// Tagging returns how the tags of the resource are represented and updated,
+// or nil if the resource has no tags field whose shape is either a list of key
+// and value structures or a map of strings
+func (r *CRD) Tagging() *ResourceTagging {
+	tagsCfg := r.cfg.ResourceTagsConfig(r.Names.Original)
+	cfg := ackgenconfig.TagsConfig{}
+	if tagsCfg != nil {
+		cfg = *tagsCfg
+	}
+	path := stringOrDefault(cfg.Path, defaultTagsPath)
+	field, found := r.SpecFields[path]
+	if !found || field.ShapeRef == nil {
+		return nil
+	}
+	res := &ResourceTagging{
+		Field:           field,
+		KeyMemberName:   stringOrDefault(cfg.KeyMemberName, defaultTagKeyMemberName),
+		ValueMemberName: stringOrDefault(cfg.ValueMemberName, defaultTagValueMemberName),
+		IsNormalized:    tagsCfg != nil,
+	}
+	res.Format, res.TagShapeName = res.tagsFormat(field.ShapeRef.Shape)
+	if res.Format == "" {
+		return nil
+	}
+
+	if op := r.findTagsOperation(cfg.TagOperation, tagOperationPatterns); op != nil {
+		res.TagOp = res.newTagsOperation(r, op, op.InputRef.Shape, false)
+	}
+	if op := r.findTagsOperation(cfg.UntagOperation, untagOperationPatterns); op != nil {
+		res.UntagOp = res.newTagsOperation(r, op, op.InputRef.Shape, true)
+	}
+	if op := r.findTagsOperation(cfg.ListTagsOperation, listTagsOperationPatterns); op != nil {
+		res.ListTagsOp = res.newTagsOperation(r, op, op.OutputRef.Shape, false)
+	}
+	return res
+}
+
+// HasNormalizedTags returns true if the resource has a `tags` config and a
+// tags field supported by the generated tag synchronization code
+func (r *CRD) HasNormalizedTags() bool {
+	tagging := r.Tagging()
+	return tagging != nil && tagging.IsNormalized
+}
+
+// findTagsOperation returns the operation with the supplied name, or the
+// first one matching the supplied patterns
+func (r *CRD) findTagsOperation(
+	name string,
+	patterns []string,
+) *awssdkmodel.Operation {
+	if name != "" {
+		return r.sdkAPI.API.Operations[name]
+	}
+	for _, pattern := range patterns {
+		opName := pattern
+		if strings.Contains(pattern, "%s") {
+			opName = fmt.Sprintf(pattern, r.Names.Original)
+		}
+		if op, found := r.sdkAPI.API.Operations[opName]; found {
+			return op
+		}
+	}
+	return nil
+}
+
+// newTagsOperation returns the description of a tagging operation, or nil if
+// the operation's shapes aren't supported. The tags (or keys) member is looked
+// up in the supplied shape and the resource member in the input shape.
+func (t *ResourceTagging) newTagsOperation(
+	r *CRD,
+	op *awssdkmodel.Operation,
+	tagsShape *awssdkmodel.Shape,
+	isUntag bool,
+) *TagsOperation {
+	inputShape := op.InputRef.Shape
+	if inputShape == nil || tagsShape == nil {
+		return nil
+	}
+	res := &TagsOperation{Operation: op}
+	for _, memberName := range tagsShape.MemberNames() {
+		memberShape := tagsShape.MemberRefs[memberName].Shape
+		if memberShape == nil {
+			continue
+		}
+		if isUntag {
+			if memberShape.Type == "list" && memberShape.MemberRef.Shape.Type == "string" {
+				res.TagsMemberName = memberName
+				break
+			}
+			continue
+		}
+		format, tagShapeName := t.tagsFormat(memberShape)
+		if format == "" || tagShapeName != t.TagShapeName {
+			// The conversion helpers only handle the tag structure of the
+			// Spec field
+			continue
+		}
+		res.TagsMemberName = memberName
+		res.Format = format
+		break
+	}
+	if res.TagsMemberName == "" {
+		return nil
+	}
+
+	// The resource is identified by the only other required string member
+	// of the input shape
+	for _, memberName := range inputShape.MemberNames() {
+		if inputShape == tagsShape && memberName == res.TagsMemberName {
+			continue
+		}
+		memberShape := inputShape.MemberRefs[memberName].Shape
+		if !inputShape.IsRequired(memberName) || memberShape == nil {
+			continue
+		}
+		if res.ResourceMemberName != "" || memberShape.Type != "string" {
+			return nil
+		}
+		res.ResourceMemberName = memberName
+	}
+	if res.ResourceMemberName == "" {
+		return nil
+	}
+	if !strings.Contains(strings.ToLower(res.ResourceMemberName), "arn") {
+		// e.g. SQS TagQueue's QueueUrl. Tagging APIs generally identify
+		// resources by ARN otherwise (e.g. RDS AddTagsToResource's
+		// ResourceName).
+		if path, err := r.GetSanitizedMemberPath(res.ResourceMemberName, op, ""); err == nil {
+			res.resourceFieldPath = strings.TrimPrefix(path, ".")
+		}
+	}
+	return res
+}
+
+// tagsFormat returns the format of the supplied tags shape and, for lists, the
+// name of the tag structure shape. The format is empty if the shape isn't a
+// supported representation of tags.
+func (t *ResourceTagging) tagsFormat(shape *awssdkmodel.Shape) (TagsFormat, string) {
+	if shape == nil {
+		return "", ""
+	}
+	switch shape.Type {
+	case "map":
+		if shape.KeyRef.Shape != nil && shape.KeyRef.Shape.Type == "string" &&
+			shape.ValueRef.Shape != nil && shape.ValueRef.Shape.Type == "string" {
+			return TagsFormatMap, ""
+		}
+	case "list":
+		elemShape := shape.MemberRef.Shape
+		if elemShape == nil || elemShape.Type != "structure" {
+			return "", ""
+		}
+		for _, memberName := range []string{t.KeyMemberName, t.ValueMemberName} {
+			memberRef, found := elemShape.MemberRefs[memberName]
+			if !found || memberRef.Shape == nil || memberRef.Shape.Type != "string" {
+				return "", ""
+			}
+		}
+		return TagsFormatList, elemShape.ShapeName
+	}
+	return "", ""
+}
+
+// stringOrDefault returns the supplied string, or the default value if it's
+// empty
+func stringOrDefault(s string, defaultValue string) string {
+	if s == "" {
+		return defaultValue
+	}
+	return s
+}
@@ -0,0 +1,188 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"sort"
+	"strings"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
+)
+
+// TypeOverrideKind identifies how the value of a field whose Go type is
+// overridden converts to and from the value of its shape
+type TypeOverrideKind string
+
+const (
+	// TypeOverrideJSON is an `apiextensionsv1.JSON` containing the JSON
+	// document of a string shape
+	TypeOverrideJSON TypeOverrideKind = "json"
+	// TypeOverrideDuration is a `metav1.Duration` containing the number of
+	// seconds of an integer shape
+	TypeOverrideDuration TypeOverrideKind = "duration"
+	// TypeOverrideQuantity is a `resource.Quantity` containing the value of
+	// an integer shape
+	TypeOverrideQuantity TypeOverrideKind = "quantity"
+	// TypeOverrideIntOrString is an `intstr.IntOrString` containing the value
+	// of a string or integer shape
+	TypeOverrideIntOrString TypeOverrideKind = "int_or_string"
+	// TypeOverrideCustom is a Go type declared in the API package of the
+	// service controller, (un)marshalled to and from the JSON document of a
+	// string shape
+	TypeOverrideCustom TypeOverrideKind = "custom"
+)
+
+// TypeOverride describes the Go type replacing the Go type derived from the
+// shape of a field
+type TypeOverride struct {
+	Kind TypeOverrideKind
+	// GoType is the Go type of the field's value, e.g. "apiextensionsv1.JSON"
+	GoType string
+	// PackagePath is the path of the package the API types need to import
+	// for the Go type, empty if none is needed
+	PackagePath string
+	// PackageAlias is the alias of the package import, empty if none is
+	// needed
+	PackageAlias string
+	// ShapeTypes are the types of the shapes the Go type can replace
+	ShapeTypes []string
+}
+
+// knownTypeOverrides contains, keyed by Go type, the overrides whose types
+// are declared outside of the API package of the service controller
+var knownTypeOverrides = map[string]TypeOverride{
+	"apiextensionsv1.JSON": {
+		Kind:         TypeOverrideJSON,
+		GoType:       "apiextensionsv1.JSON",
+		PackagePath:  "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1",
+		PackageAlias: "apiextensionsv1",
+		ShapeTypes:   []string{"string"},
+	},
+	"metav1.Duration": {
+		Kind:       TypeOverrideDuration,
+		GoType:     "metav1.Duration",
+		ShapeTypes: []string{"integer", "long"},
+	},
+	"resource.Quantity": {
+		Kind:        TypeOverrideQuantity,
+		GoType:      "resource.Quantity",
+		PackagePath: "k8s.io/apimachinery/pkg/api/resource",
+		ShapeTypes:  []string{"integer", "long"},
+	},
+	"intstr.IntOrString": {
+		Kind:        TypeOverrideIntOrString,
+		GoType:      "intstr.IntOrString",
+		PackagePath: "k8s.io/apimachinery/pkg/util/intstr",
+		ShapeTypes:  []string{"string", "integer", "long"},
+	},
+}
+
+// KnownTypeOverrides returns the sorted Go types of the overrides declared
+// outside of the API package of the service controller
+func KnownTypeOverrides() []string {
+	res := make([]string, 0, len(knownTypeOverrides))
+	for goType := range knownTypeOverrides {
+		res = append(res, goType)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// IsCustomTypeOverride returns true if the supplied Go type is the name of a
+// type declared in the API package of the service controller
+func IsCustomTypeOverride(goType string) bool {
+	_, known := knownTypeOverrides[goType]
+	return !known && goType != "" && !strings.ContainsAny(goType, ".*[]")
+}
+
+// newTypeOverride returns the TypeOverride for the supplied Go type, or nil
+// if the Go type is empty or unknown
+func newTypeOverride(goType string) *TypeOverride {
+	if override, known := knownTypeOverrides[goType]; known {
+		return &override
+	}
+	if !IsCustomTypeOverride(goType) {
+		return nil
+	}
+	return &TypeOverride{
+		Kind:       TypeOverrideCustom,
+		GoType:     goType,
+		ShapeTypes: []string{"string"},
+	}
+}
+
+// TypeOverride returns the override of the Go type of the field, or nil if
+// the field's Go type is derived from its shape
+func (f *Field) TypeOverride() *TypeOverride {
+	if f.FieldConfig == nil {
+		return nil
+	}
+	return newTypeOverride(f.FieldConfig.Type)
+}
+
+// checkTypeOverrides returns the Spec and Status fields of the CRD whose Go
+// type is overridden by an unknown type or by a type that can't replace the
+// field's shape, and adds the imports of the overriding types to
+// the API types
+func (r *CRD) checkTypeOverrides() ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
+	for _, fields := range []map[string]*Field{r.SpecFields, r.StatusFields} {
+		fieldNames := make([]string, 0, len(fields))
+		for fieldName := range fields {
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+		for _, fieldName := range fieldNames {
+			f := fields[fieldName]
+			if f.FieldConfig == nil || f.FieldConfig.Type == "" {
+				continue
+			}
+			typePath := []string{"fields", fieldName, "type"}
+			override := f.TypeOverride()
+			if override == nil {
+				errs = append(errs, r.newConfigError(
+					typePath, "unknown type %s", f.FieldConfig.Type,
+				))
+				continue
+			}
+			if f.ShapeRef == nil || f.ShapeRef.Shape == nil {
+				continue
+			}
+			shapeType := f.ShapeRef.Shape.Type
+			if !util.InStrings(shapeType, override.ShapeTypes) {
+				errs = append(errs, r.newConfigError(
+					typePath, "type %s can't replace a %s shape",
+					override.GoType, shapeType,
+				))
+				continue
+			}
+			if override.PackagePath != "" {
+				r.AddTypeImport(override.PackagePath, override.PackageAlias)
+			}
+		}
+	}
+	return errs
+}
+
+// HasTypeOverride returns true if the Go type of any field of the CRD is
+// overridden by the supplied kind of type
+func (r *CRD) HasTypeOverride(kind TypeOverrideKind) bool {
+	for _, f := range r.Fields {
+		if override := f.TypeOverride(); override != nil && override.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -35,6 +35,11 @@ func cleanGoType(
 	// fields in a DBProxy CRD... we need to ensure the type names don't
 	// conflict. Also, the name of the Go type in the generated code is
 	// Camel-cased and normalized, so we use that as the Go type
+	if fieldCfg != nil {
+		if override := newTypeOverride(fieldCfg.Type); override != nil {
+			return override.GoType, "*" + override.GoType, "*" + override.GoType
+		}
+	}
 	gt := shape.GoType()
 	gte := shape.GoTypeElem()
 	gtwp := shape.GoTypeWithPkgName()
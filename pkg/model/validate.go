@@ -0,0 +1,540 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+	"github.com/aws-controllers-k8s/code-generator/pkg/names"
+	"github.com/aws-controllers-k8s/code-generator/pkg/util"
+)
+
+// ValidateConfig cross-checks a generator config against an AWS service API
+// and returns all the problems found, sorted by their position in the
+// generator config file. It must be called before the shape ignore rules are
+// applied to the SDKAPI.
+//
+// On top of the unknown keys reported as warnings by
+// `ackgenconfig.Config.Validate`, it reports:
+//
+// * resources that don't match any resource of the API
+// * `from` operations and paths that don't exist
+// * unsupported hook identifiers
+// * operations and operation types that don't exist
+// * unknown field type overrides
+//
+// Fields that don't match any member of the resource's operation shapes,
+// renames of operations or members that don't exist, ignore rules that match
+// nothing and immutable fields nested in list or map elements are reported as
+// warnings.
+func ValidateConfig(
+	sdkAPI *SDKAPI,
+	cfg *ackgenconfig.Config,
+) ackgenconfig.ValidationErrors {
+	if cfg == nil {
+		return nil
+	}
+	v := &configValidator{
+		sdkAPI: sdkAPI,
+		cfg:    cfg,
+		errs:   append(ackgenconfig.ValidationErrors{}, cfg.Validate()...),
+	}
+	v.validateOperations()
+	v.validateResources()
+	v.validateIgnore()
+	v.errs.Sort()
+	return v.errs
+}
+
+// configValidator collects the problems found in a generator config
+type configValidator struct {
+	sdkAPI *SDKAPI
+	cfg    *ackgenconfig.Config
+	errs   ackgenconfig.ValidationErrors
+}
+
+// addError adds a validation error for the key found at the supplied path
+func (v *configValidator) addError(
+	path []string,
+	format string,
+	args ...interface{},
+) {
+	v.errs = append(v.errs, v.cfg.NewValidationError(path, format, args...))
+}
+
+// addWarning adds a validation warning for the key found at the supplied path
+func (v *configValidator) addWarning(
+	path []string,
+	format string,
+	args ...interface{},
+) {
+	v.errs = append(v.errs, v.cfg.NewValidationWarning(path, format, args...))
+}
+
+// operationNames returns the sorted names of all the API operations
+func (v *configValidator) operationNames() []string {
+	res := make([]string, 0, len(v.sdkAPI.API.Operations))
+	for opID := range v.sdkAPI.API.Operations {
+		res = append(res, opID)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// resourceOperations returns the API operations of each resource, keyed by
+// resource name. Unlike `SDKAPI.GetOperationMap`, the result isn't cached on
+// the SDKAPI.
+func (v *configValidator) resourceOperations() map[string][]*awssdkmodel.Operation {
+	res := map[string][]*awssdkmodel.Operation{}
+	for _, opID := range v.operationNames() {
+		_, resName := getOpTypeAndResourceName(opID, v.cfg)
+		res[resName] = append(res[resName], v.sdkAPI.API.Operations[opID])
+	}
+	return res
+}
+
+// resourceNames returns the sorted names of the resources the API operations
+// act upon
+func (v *configValidator) resourceNames() []string {
+	res := []string{}
+	for resName := range v.resourceOperations() {
+		res = append(res, resName)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// validateOperations checks the `operations` generator config
+func (v *configValidator) validateOperations() {
+	opNames := v.operationNames()
+	for opID, opConfig := range v.cfg.Operations {
+		path := []string{"operations", opID}
+		if _, found := v.sdkAPI.API.Operations[opID]; !found {
+			v.addError(
+				path, "unknown operation %q%s",
+				opID, ackgenconfig.DidYouMean(opID, opNames),
+			)
+			continue
+		}
+		for _, opTypeString := range opConfig.OperationType {
+			if OpTypeFromString(opTypeString) == OpTypeUnknown {
+				v.addError(
+					append(path, "operation_type"),
+					"unknown operation type %q", opTypeString,
+				)
+			}
+		}
+	}
+}
+
+// validateResources checks the `resources` generator config
+func (v *configValidator) validateResources() {
+	resNames := v.resourceNames()
+	resOps := v.resourceOperations()
+	for resName, resConfig := range v.cfg.Resources {
+		path := []string{"resources", resName}
+		if !util.InStrings(resName, resNames) {
+			v.addError(
+				path, "unknown resource %q%s",
+				resName, ackgenconfig.DidYouMean(resName, resNames),
+			)
+			continue
+		}
+		v.validateRenames(path, resConfig)
+		v.validateFields(path, resConfig, resOps[resName])
+		v.validateHooks(path, resConfig)
+		v.validateTags(path, resConfig)
+		v.validateSubResources(path, resConfig)
+	}
+}
+
+// validateSubResources checks the operation names of the sub-resources of a
+// resource
+func (v *configValidator) validateSubResources(
+	resPath []string,
+	resConfig ackgenconfig.ResourceConfig,
+) {
+	opNames := v.operationNames()
+	for subName, subConfig := range resConfig.SubResources {
+		path := append(resPath, "sub_resources", subName)
+		if subConfig == nil {
+			continue
+		}
+		if subConfig.Member == "" {
+			v.addError(path, "missing member")
+		}
+		for _, subOp := range []struct{ key, name string }{
+			{"put_operation", subConfig.PutOperation},
+			{"get_operation", subConfig.GetOperation},
+			{"delete_operation", subConfig.DeleteOperation},
+		} {
+			if subOp.name == "" {
+				if subOp.key != "delete_operation" {
+					v.addError(append(path, subOp.key), "missing operation")
+				}
+				continue
+			}
+			if _, found := v.sdkAPI.API.Operations[subOp.name]; !found {
+				v.addError(
+					append(path, subOp.key), "unknown operation %q%s",
+					subOp.name, ackgenconfig.DidYouMean(subOp.name, opNames),
+				)
+			}
+		}
+	}
+}
+
+// validateTags checks the operation names of the tags config of a resource
+func (v *configValidator) validateTags(
+	resPath []string,
+	resConfig ackgenconfig.ResourceConfig,
+) {
+	if resConfig.Tags == nil {
+		return
+	}
+	opNames := v.operationNames()
+	path := append(resPath, "tags")
+	for key, opName := range map[string]string{
+		"tag_operation":       resConfig.Tags.TagOperation,
+		"untag_operation":     resConfig.Tags.UntagOperation,
+		"list_tags_operation": resConfig.Tags.ListTagsOperation,
+	} {
+		if opName == "" {
+			continue
+		}
+		if _, found := v.sdkAPI.API.Operations[opName]; !found {
+			v.addError(
+				append(path, key), "unknown operation %q%s",
+				opName, ackgenconfig.DidYouMean(opName, opNames),
+			)
+		}
+	}
+}
+
+// validateRenames checks the renames of a resource
+func (v *configValidator) validateRenames(
+	path []string,
+	resConfig ackgenconfig.ResourceConfig,
+) {
+	if resConfig.Renames == nil {
+		return
+	}
+	opNames := v.operationNames()
+	for opID, renames := range resConfig.Renames.Operations {
+		opPath := append(path, "renames", "operations", opID)
+		op, found := v.sdkAPI.API.Operations[opID]
+		if !found {
+			v.addWarning(
+				opPath, "unknown operation %q%s",
+				opID, ackgenconfig.DidYouMean(opID, opNames),
+			)
+			continue
+		}
+		if renames == nil {
+			continue
+		}
+		v.validateMemberNames(
+			append(opPath, "input_fields"), op.InputRef.Shape,
+			renames.InputFields,
+		)
+		v.validateMemberNames(
+			append(opPath, "output_fields"), op.OutputRef.Shape,
+			renames.OutputFields,
+		)
+	}
+}
+
+// validateMemberNames checks that the keys of a renames map are members of
+// the supplied shape
+func (v *configValidator) validateMemberNames(
+	path []string,
+	shape *awssdkmodel.Shape,
+	renames map[string]string,
+) {
+	if shape == nil {
+		return
+	}
+	memberNames := shape.MemberNames()
+	for memberName := range renames {
+		if _, found := shape.MemberRefs[memberName]; !found {
+			v.addWarning(
+				append(path, memberName),
+				"unknown member %q of shape %s%s",
+				memberName, shape.ShapeName,
+				ackgenconfig.DidYouMean(memberName, memberNames),
+			)
+		}
+	}
+}
+
+// validateFields checks the field configs of a resource
+func (v *configValidator) validateFields(
+	path []string,
+	resConfig ackgenconfig.ResourceConfig,
+	ops []*awssdkmodel.Operation,
+) {
+	// The fields of a resource can come from any member of the input and
+	// output shapes of its operations, either with their original or
+	// renamed names.
+	knownFields := []string{}
+	addKnownField := func(name string) {
+		if !util.InStrings(name, knownFields) {
+			knownFields = append(knownFields, name)
+		}
+	}
+	for _, op := range ops {
+		for _, shapeRef := range []*awssdkmodel.ShapeRef{&op.InputRef, &op.OutputRef} {
+			if shapeRef.Shape == nil {
+				continue
+			}
+			for _, memberName := range shapeRef.Shape.MemberNames() {
+				addKnownField(memberName)
+				addKnownField(names.New(memberName).Camel)
+				memberShape := shapeRef.Shape.MemberRefs[memberName].Shape
+				// Output shapes often wrap the resource in a single
+				// structure member.
+				if shapeRef == &op.OutputRef && memberShape != nil && memberShape.Type == "structure" {
+					for _, nestedName := range memberShape.MemberNames() {
+						addKnownField(nestedName)
+						addKnownField(names.New(nestedName).Camel)
+					}
+				}
+			}
+		}
+		if resConfig.Renames != nil {
+			if renames, found := resConfig.Renames.Operations[op.Name]; found && renames != nil {
+				for _, renamed := range renames.InputFields {
+					addKnownField(renamed)
+				}
+				for _, renamed := range renames.OutputFields {
+					addKnownField(renamed)
+				}
+			}
+		}
+	}
+	for subName := range resConfig.SubResources {
+		addKnownField(subName)
+	}
+	sort.Strings(knownFields)
+
+	opNames := v.operationNames()
+	for fieldName, fieldConfig := range resConfig.Fields {
+		fieldPath := append(path, "fields", fieldName)
+		if fieldConfig == nil {
+			continue
+		}
+		if fieldConfig.Validation != nil {
+			v.validateFieldValidation(append(fieldPath, "validation"), fieldConfig.Validation)
+		}
+		if fieldConfig.IsImmutable && strings.Contains(fieldName, "..") {
+			// The old and new elements of lists and maps can't be matched
+			v.addWarning(
+				append(fieldPath, "is_immutable"),
+				"changes to fields nested in list or map elements can't be rejected by the Kubernetes API server",
+			)
+		}
+		if refs := fieldConfig.References; refs != nil {
+			if refs.Resource == "" {
+				v.addError(append(fieldPath, "references"), "missing resource")
+			}
+			if refs.Path == "" {
+				v.addError(append(fieldPath, "references"), "missing path")
+			}
+		}
+		if fieldConfig.Type != "" {
+			knownTypes := KnownTypeOverrides()
+			if strings.Contains(fieldName, ".") {
+				v.addError(append(fieldPath, "type"), "type overrides are only supported on top-level fields")
+			} else if fieldConfig.IsSecret {
+				v.addError(append(fieldPath, "type"), "type can't be overridden on secret fields")
+			} else if !util.InStrings(fieldConfig.Type, knownTypes) &&
+				!IsCustomTypeOverride(fieldConfig.Type) {
+				v.addError(
+					append(fieldPath, "type"), "unknown type %q%s",
+					fieldConfig.Type, ackgenconfig.DidYouMean(fieldConfig.Type, knownTypes),
+				)
+			}
+		}
+		if child := fieldConfig.Child; child != nil {
+			childPath := append(fieldPath, "child")
+			if child.Path == "" {
+				v.addError(childPath, "missing path")
+			}
+			for _, childOp := range []struct{ key, name string }{
+				{"add_operation", child.AddOperation},
+				{"remove_operation", child.RemoveOperation},
+				{"list_operation", child.ListOperation},
+			} {
+				if childOp.name == "" {
+					continue
+				}
+				if _, found := v.sdkAPI.API.Operations[childOp.name]; !found {
+					v.addError(
+						append(childPath, childOp.key), "unknown operation %q%s",
+						childOp.name, ackgenconfig.DidYouMean(childOp.name, opNames),
+					)
+				}
+			}
+			// Child fields are added to the Spec when they aren't members
+			// of the Create operation's input shape
+			continue
+		}
+		if fieldConfig.From != nil {
+			fromPath := append(fieldPath, "from")
+			op, found := v.sdkAPI.API.Operations[fieldConfig.From.Operation]
+			if !found {
+				v.addError(
+					append(fromPath, "operation"), "unknown operation %q%s",
+					fieldConfig.From.Operation,
+					ackgenconfig.DidYouMean(fieldConfig.From.Operation, opNames),
+				)
+				continue
+			}
+			shape := op.InputRef.Shape
+			if fieldConfig.IsReadOnly {
+				shape = op.OutputRef.Shape
+			}
+			if _, found := getMemberByPath(shape, fieldConfig.From.Path); !found {
+				v.addError(
+					append(fromPath, "path"), "unknown path %q in shape %s",
+					fieldConfig.From.Path, shape.ShapeName,
+				)
+			}
+			continue
+		}
+		if fieldConfig.IsAttribute {
+			continue
+		}
+		// Nested field paths (e.g. "Code.S3Bucket") are only checked on their
+		// top-level member.
+		topLevelName := strings.Split(fieldName, ".")[0]
+		if !util.InStrings(topLevelName, knownFields) {
+			v.addWarning(
+				fieldPath, "unknown field %q in resource %s%s",
+				fieldName, path[len(path)-1],
+				ackgenconfig.DidYouMean(topLevelName, knownFields),
+			)
+		}
+	}
+}
+
+// validateFieldValidation checks the constraint names and the pattern of the
+// validation config of a field
+func (v *configValidator) validateFieldValidation(
+	path []string,
+	cfg *ackgenconfig.ValidationFieldConfig,
+) {
+	for i, name := range cfg.Ignore {
+		if !util.InStrings(name, ackgenconfig.SupportedValidationConstraints) {
+			v.addError(
+				append(path, "ignore", itoa(i)), "unsupported constraint %q%s",
+				name, ackgenconfig.DidYouMean(name, ackgenconfig.SupportedValidationConstraints),
+			)
+		}
+	}
+	if cfg.Pattern != nil {
+		if _, err := regexp.Compile(*cfg.Pattern); err != nil {
+			v.addError(append(path, "pattern"), "invalid pattern: %v", err)
+		}
+	}
+}
+
+// validateHooks checks the hook identifiers of a resource
+func (v *configValidator) validateHooks(
+	path []string,
+	resConfig ackgenconfig.ResourceConfig,
+) {
+	for hookID := range resConfig.Hooks {
+		if !util.InStrings(hookID, ackgenconfig.SupportedHookIDs) {
+			v.addError(
+				append(path, "hooks", hookID), "unsupported hook %q%s",
+				hookID, ackgenconfig.DidYouMean(hookID, ackgenconfig.SupportedHookIDs),
+			)
+		}
+	}
+}
+
+// validateIgnore checks that the ignore rules match something in the API
+func (v *configValidator) validateIgnore() {
+	ignore := v.cfg.Ignore
+	opNames := v.operationNames()
+	for i, opID := range ignore.Operations {
+		if _, found := v.sdkAPI.API.Operations[opID]; !found {
+			v.addWarning(
+				[]string{"ignore", "operations", itoa(i)},
+				"unknown operation %q%s",
+				opID, ackgenconfig.DidYouMean(opID, opNames),
+			)
+		}
+	}
+	resNames := v.resourceNames()
+	for i, resName := range ignore.ResourceNames {
+		if !util.InStrings(resName, resNames) {
+			v.addWarning(
+				[]string{"ignore", "resource_names", itoa(i)},
+				"unknown resource %q%s",
+				resName, ackgenconfig.DidYouMean(resName, resNames),
+			)
+		}
+	}
+	shapeNames := v.sdkAPI.API.ShapeNames()
+	for i, shapeName := range ignore.ShapeNames {
+		if _, found := v.sdkAPI.API.Shapes[shapeName]; !found {
+			v.addWarning(
+				[]string{"ignore", "shape_names", itoa(i)},
+				"unknown shape %q%s",
+				shapeName, ackgenconfig.DidYouMean(shapeName, shapeNames),
+			)
+		}
+	}
+	for i, fieldPath := range ignore.FieldPaths {
+		path := []string{"ignore", "field_paths", itoa(i)}
+		parts := strings.SplitN(fieldPath, ".", 2)
+		if len(parts) != 2 {
+			v.addWarning(
+				path, "invalid field path %q, expected <shape_name>.<field_name>",
+				fieldPath,
+			)
+			continue
+		}
+		shape, found := v.sdkAPI.API.Shapes[parts[0]]
+		if !found {
+			v.addWarning(
+				path, "field path %q matches nothing: unknown shape %q%s",
+				fieldPath, parts[0],
+				ackgenconfig.DidYouMean(parts[0], shapeNames),
+			)
+			continue
+		}
+		if _, found := getMemberByPath(shape, parts[1]); !found {
+			v.addWarning(
+				path, "field path %q matches nothing: unknown member %q of shape %s%s",
+				fieldPath, parts[1], parts[0],
+				ackgenconfig.DidYouMean(parts[1], shape.MemberNames()),
+			)
+		}
+	}
+}
+
+// itoa returns the string representation of a sequence index in a generator
+// config path
+func itoa(i int) string {
+	return strconv.Itoa(i)
+}
@@ -0,0 +1,59 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//	 http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/code-generator/pkg/testutil"
+)
+
+func TestValidateConfig_ECR(t *testing.T) {
+	assert := assert.New(t)
+
+	errs := testutil.ValidateConfigForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{})
+	assert.Empty(errs.Errors())
+}
+
+func TestValidateConfig_ECR_Invalid(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	errs := testutil.ValidateConfigForServiceWithOptions(t, "ecr", &testutil.TestingModelOptions{
+		GeneratorConfigFile: "generator-invalid.yaml",
+	})
+	require.Len(errs, 8)
+	for _, err := range errs {
+		assert.Equal("generator-invalid.yaml", filepath.Base(err.File))
+	}
+
+	expected := []string{
+		`5:9: resources.Repository.fields.RepositoryName: warning: unknown key "is_primay_key" (did you mean "is_primary_key"?)`,
+		`8:15: resources.Repository.fields.RepositoryName.validation.ignore.0: unsupported constraint "patern" (did you mean "pattern"?)`,
+		`12:11: resources.Repository.fields.PolicyText.from.path: unknown path "PolicyTxt" in shape SetRepositoryPolicyInput`,
+		`13:9: resources.Repository.fields.PolicyText.type: unknown type "apiextensionsv1.Json" (did you mean "apiextensionsv1.JSON"?)`,
+		`15:7: resources.Repository.hooks.sdk_create_post_set_ouptut: unsupported hook "sdk_create_post_set_ouptut" (did you mean "sdk_create_post_set_output"?)`,
+		`17:3: resources.Repositry: unknown resource "Repositry" (did you mean "Repository"?)`,
+		`23:5: operations.PutLifecyclePolicy.operation_type: unknown operation type "Upsert"`,
+		`26:7: ignore.field_paths.0: warning: field path "CreateRepositoryInput.Tag" matches nothing: unknown member "Tag" of shape CreateRepositoryInput (did you mean "Tags"?)`,
+	}
+	for i, err := range errs {
+		assert.Equal(expected[i], err.Error()[len(err.File)+1:])
+	}
+	assert.Len(errs.Errors(), 6)
+}
@@ -0,0 +1,168 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	awssdkmodel "github.com/aws/aws-sdk-go/private/model/api"
+
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
+)
+
+// readinessWaiterSuffixes are the suffixes of the names of the waiters that
+// tell whether a resource is ready, in order of preference. For example, the
+// RDS DBInstance resource is ready when its DBInstanceAvailable waiter
+// succeeds.
+var readinessWaiterSuffixes = []string{
+	"Available",
+	"InService",
+	"Active",
+	"Running",
+	"Ready",
+	"Exists",
+}
+
+// Waiter is an aws-sdk-go waiter telling whether a resource is ready
+type Waiter struct {
+	*awssdkmodel.Waiter
+	// PathAcceptors are the waiter's acceptors matching the output of its
+	// operation. Acceptors matching errors or HTTP status codes are left out
+	// since the waiter is only evaluated when the operation succeeds.
+	PathAcceptors []awssdkmodel.WaiterAcceptor
+}
+
+// ReadinessWaiter returns the aws-sdk-go waiter telling whether the resource
+// is ready, or nil if there isn't any. The waiter is either named in the
+// resource's `waiter` config or named after the resource, and its operation
+// must be the one sdkFind reads the resource with.
+func (r *CRD) ReadinessWaiter() *Waiter {
+	if r.syncedConfig() != nil {
+		return nil
+	}
+	cfg := r.waiterConfig()
+	if cfg != nil && cfg.IsIgnored {
+		return nil
+	}
+	if cfg != nil && cfg.Name != "" {
+		w, err := r.namedWaiter(cfg.Name)
+		if err != nil {
+			return nil
+		}
+		return w
+	}
+	for _, suffix := range readinessWaiterSuffixes {
+		w, err := r.namedWaiter(r.Names.Original + suffix)
+		if err == nil {
+			return w
+		}
+	}
+	return nil
+}
+
+// waiterConfig returns the resource's `waiter` config, if any
+func (r *CRD) waiterConfig() *ackgenconfig.WaiterConfig {
+	if r.cfg == nil {
+		return nil
+	}
+	rConfig, found := r.cfg.Resources[r.Names.Original]
+	if !found {
+		return nil
+	}
+	return rConfig.Waiter
+}
+
+// findOperation returns the operation sdkFind reads the resource with
+func (r *CRD) findOperation() *awssdkmodel.Operation {
+	switch {
+	case r.Ops.ReadOne != nil:
+		return r.Ops.ReadOne
+	case r.Ops.GetAttributes != nil:
+		return r.Ops.GetAttributes
+	default:
+		return r.Ops.ReadMany
+	}
+}
+
+// namedWaiter returns the waiter with the supplied name if it can tell
+// whether the resource is ready
+func (r *CRD) namedWaiter(name string) (*Waiter, error) {
+	var found *awssdkmodel.Waiter
+	names := []string{}
+	for i := range r.sdkAPI.API.Waiters {
+		w := &r.sdkAPI.API.Waiters[i]
+		if w.Name == name {
+			found = w
+		}
+		names = append(names, w.Name)
+	}
+	if found == nil {
+		return nil, fmt.Errorf(
+			"unknown waiter %s%s", name, ackgenconfig.DidYouMean(name, names),
+		)
+	}
+	findOp := r.findOperation()
+	if findOp == nil {
+		return nil, fmt.Errorf(
+			"waiter %s cannot be used: %s has no operation to read it with",
+			name, r.Names.Original,
+		)
+	}
+	if found.OperationName != findOp.ExportedName {
+		return nil, fmt.Errorf(
+			"waiter %s uses the %s operation, but %s is read with the %s operation",
+			name, found.OperationName, r.Names.Original, findOp.ExportedName,
+		)
+	}
+	w := &Waiter{Waiter: found}
+	succeeds := false
+	for _, a := range found.Acceptors {
+		switch a.Matcher {
+		case "path", "pathAll", "pathAny":
+			w.PathAcceptors = append(w.PathAcceptors, a)
+			succeeds = succeeds || a.State == "success"
+		}
+	}
+	if !succeeds {
+		return nil, fmt.Errorf(
+			"waiter %s has no acceptor matching the output of %s in the success state",
+			name, found.OperationName,
+		)
+	}
+	return w, nil
+}
+
+// checkWaiterConfig returns the problems found in the resource's `waiter`
+// config
+func (r *CRD) checkWaiterConfig() ackgenconfig.ValidationErrors {
+	errs := ackgenconfig.ValidationErrors{}
+	cfg := r.waiterConfig()
+	if cfg == nil || cfg.IsIgnored {
+		return errs
+	}
+	if r.syncedConfig() != nil {
+		errs = append(errs, r.newConfigError(
+			[]string{"waiter"}, "cannot be used with synced, which sets the ACK.ResourceSynced condition as well",
+		))
+	}
+	if cfg.Name == "" {
+		return errs
+	}
+	if _, err := r.namedWaiter(cfg.Name); err != nil {
+		errs = append(errs, r.newConfigError(
+			[]string{"waiter", "name"}, "%v", err,
+		))
+	}
+	return errs
+}
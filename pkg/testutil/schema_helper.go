@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	ackgenerate "github.com/aws-controllers-k8s/code-generator/pkg/generate/ack"
+	ackgenconfig "github.com/aws-controllers-k8s/code-generator/pkg/generate/config"
 	"github.com/aws-controllers-k8s/code-generator/pkg/model"
 	ackmodel "github.com/aws-controllers-k8s/code-generator/pkg/model"
 )
@@ -33,6 +34,9 @@ type TestingModelOptions struct {
 	GeneratorConfigFile string
 	// The AWS Service's API version. Defaults to 00-00-0000
 	ServiceAPIVersion string
+	// If true, the AWS Service's API is read from the Smithy model in the
+	// testdata/aws-models directory instead of the api-2.json model
+	SmithyModels bool
 }
 
 // SetDefaults sets the empty fields to a default value.
@@ -55,6 +59,29 @@ func NewModelForService(t *testing.T, serviceAlias string) *ackmodel.Model {
 
 // NewModelForServiceWithOptions returns a new *ackmodel.Model used for testing purposes.
 func NewModelForServiceWithOptions(t *testing.T, serviceAlias string, options *TestingModelOptions) *ackmodel.Model {
+	sdkAPI, generatorConfigPath := loadSDKAPIForServiceWithOptions(t, serviceAlias, options)
+	m, err := ackmodel.New(sdkAPI, options.APIVersion, generatorConfigPath, ackgenerate.DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// ValidateConfigForServiceWithOptions returns the problems found in a testing
+// generator config file for the supplied service.
+func ValidateConfigForServiceWithOptions(t *testing.T, serviceAlias string, options *TestingModelOptions) ackgenconfig.ValidationErrors {
+	sdkAPI, generatorConfigPath := loadSDKAPIForServiceWithOptions(t, serviceAlias, options)
+	cfg, err := ackgenconfig.New(generatorConfigPath, ackgenerate.DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ackmodel.ValidateConfig(sdkAPI, &cfg)
+}
+
+// loadSDKAPIForServiceWithOptions returns the testing *ackmodel.SDKAPI of the
+// supplied service along with the path of its generator config file, which
+// is empty if the file doesn't exist.
+func loadSDKAPIForServiceWithOptions(t *testing.T, serviceAlias string, options *TestingModelOptions) (*ackmodel.SDKAPI, string) {
 	path, err := os.Getwd()
 	if err != nil {
 		t.Fatal(err)
@@ -73,6 +100,9 @@ func NewModelForServiceWithOptions(t *testing.T, serviceAlias string, options *T
 	}
 	options.SetDefaults()
 	sdkHelper := model.NewSDKHelper(path)
+	if options.SmithyModels {
+		sdkHelper = model.NewSDKHelperFromSmithyModelsPath(filepath.Join(path, "aws-models"))
+	}
 	sdkHelper.WithAPIVersion(options.ServiceAPIVersion)
 	sdkAPI, err := sdkHelper.API(serviceAlias)
 	if err != nil {
@@ -82,9 +112,5 @@ func NewModelForServiceWithOptions(t *testing.T, serviceAlias string, options *T
 	if _, err := os.Stat(generatorConfigPath); os.IsNotExist(err) {
 		generatorConfigPath = ""
 	}
-	m, err := ackmodel.New(sdkAPI, options.APIVersion, generatorConfigPath, ackgenerate.DefaultConfig)
-	if err != nil {
-		t.Fatal(err)
-	}
-	return m
+	return sdkAPI, generatorConfigPath
 }
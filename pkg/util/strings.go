@@ -0,0 +1,78 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"sort"
+	"strings"
+)
+
+// ClosestString returns the string in the supplied slice of strings that is
+// the closest to the subject string, or an empty string if none of them is
+// close enough to be a likely typo of the subject. Comparison is case
+// insensitive and uses the Levenshtein edit distance.
+func ClosestString(subject string, collection []string) string {
+	// Sort the candidates to return a deterministic result when two of
+	// them are at the same distance.
+	candidates := append([]string{}, collection...)
+	sort.Strings(candidates)
+
+	maxDistance := len(subject) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+	closest := ""
+	closestDistance := maxDistance + 1
+	lowerSubject := strings.ToLower(subject)
+	for _, candidate := range candidates {
+		d := levenshtein(lowerSubject, strings.ToLower(candidate))
+		if d < closestDistance {
+			closest = candidate
+			closestDistance = d
+		}
+	}
+	return closest
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -14,14 +14,31 @@ import (
 )
 
 {{ .CRD.Documentation }}
+{{- range $marker := .CRD.ImmutableMarkers }}
+// {{ $marker }}
+{{- end }}
 type {{ .CRD.Kind }}Spec struct {
 	{{- range $fieldName, $field := .CRD.SpecFields }}
 	{{- if $field.ShapeRef }}
 	{{ $field.ShapeRef.Documentation }}
 	{{- end }}
+	{{- range $marker := $field.ValidationMarkers }}
+	// {{ $marker }}
+	{{- end }}
+	{{- if $marker := $field.DefaultMarker }}
+	// {{ $marker }}
+	{{- end }}
 	{{ if $field.IsRequired }} // +kubebuilder:validation:Required
 	{{ $field.Names.Camel }} {{ $field.GoType }} `json:"{{ $field.Names.CamelLower }}"`
 	{{- else }} {{ $field.Names.Camel }} {{ $field.GoType }} `json:"{{ $field.Names.CamelLower }},omitempty"` {{ end }}
+	{{- if $ref := $field.Reference }}
+	// {{ $ref.RefNames.Camel }} refers by name to the {{ $ref.Kind }} custom resource(s)
+	// {{ $field.Names.Camel }} is read from
+	{{ $ref.RefNames.Camel }} {{ $ref.RefGoType }} `json:"{{ $ref.RefNames.CamelLower }},omitempty"`
+	// {{ $ref.SelectorNames.Camel }} selects by labels the {{ $ref.Kind }} custom resource(s)
+	// {{ $field.Names.Camel }} is read from
+	{{ $ref.SelectorNames.Camel }} *ResourceSelector `json:"{{ $ref.SelectorNames.CamelLower }},omitempty"`
+	{{- end }}
 {{- end }}
 }
 
@@ -0,0 +1,17 @@
+{{- template "boilerplate" }}
+
+package {{ .APIVersion }}
+
+// ResourceReference refers by name to a custom resource in the namespace of
+// the referencing resource
+type ResourceReference struct {
+	// Name of the referenced custom resource
+	Name string `json:"name"`
+}
+
+// ResourceSelector selects custom resources by labels in the namespace of the
+// referencing resource
+type ResourceSelector struct {
+	// MatchLabels contains the labels the selected custom resources must have
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+}
@@ -7,6 +7,12 @@ type {{ .Names.Camel }} struct {
 	{{- if $attr.Shape.Documentation }}
 	{{ $attr.Shape.Documentation }}
 	{{- end }}
+	{{- range $marker := $attr.ValidationMarkers }}
+	// {{ $marker }}
+	{{- end }}
+	{{- if $marker := $attr.DefaultMarker }}
+	// {{ $marker }}
+	{{- end }}
 	{{ $attr.Names.Camel }} {{ $attr.GoType }} `json:"{{ $attr.Names.CamelLower }},omitempty"`
 {{- end }}
 }
@@ -0,0 +1,58 @@
+{{- template "boilerplate" }}
+
+package {{ .APIVersion }}
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// conversionDataAnnotation is the annotation holding the values of the fields
+// that cannot be converted between this version and the hub version, keyed by
+// field path. The values are restored when the resource is converted back.
+const conversionDataAnnotation = ackv1alpha1.AnnotationPrefix + "conversion-data"
+
+// getConversionData returns the field values kept in the conversion data
+// annotation of the supplied object
+func getConversionData(obj metav1.Object) (map[string]json.RawMessage, error) {
+	data := map[string]json.RawMessage{}
+	raw, ok := obj.GetAnnotations()[conversionDataAnnotation]
+	if !ok {
+		return data, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		return nil, fmt.Errorf(
+			"cannot parse annotation %s: %v", conversionDataAnnotation, err,
+		)
+	}
+	return data, nil
+}
+
+// setConversionData stores the supplied field values in the conversion data
+// annotation of the supplied object, removing the annotation when there are
+// no values to keep
+func setConversionData(obj metav1.Object, data map[string]interface{}) error {
+	// The annotations map can be shared with the source object of the
+	// conversion, never modify it in place
+	annotations := map[string]string{}
+	for k, v := range obj.GetAnnotations() {
+		if k != conversionDataAnnotation {
+			annotations[k] = v
+		}
+	}
+	if len(data) > 0 {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		annotations[conversionDataAnnotation] = string(raw)
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	obj.SetAnnotations(annotations)
+	return nil
+}
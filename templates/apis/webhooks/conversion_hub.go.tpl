@@ -0,0 +1,31 @@
+{{- template "boilerplate" }}
+
+package {{ .APIVersion }}
+
+import (
+	"fmt"
+
+	ackrtwebhook "github.com/aws-controllers-k8s/runtime/pkg/webhook"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+)
+
+func init() {
+	webhook := ackrtwebhook.New(
+		"{{ .APIVersion }}",
+		"{{ .CRD.Kind }}",
+		string(ackrtwebhook.WebhookTypeConversion),
+		func(mgr ctrlrt.Manager) error {
+			return ctrlrt.NewWebhookManagedBy(mgr).
+				For(&{{ .CRD.Kind }}{}).
+				Complete()
+		},
+	)
+	if err := ackrtwebhook.RegisterWebhook(webhook); err != nil {
+		msg := fmt.Sprintf("cannot register webhook: %v", err)
+		panic(msg)
+	}
+}
+
+// Hub marks this type as the conversion hub. All the other API versions
+// of {{ .CRD.Kind }} are converted from and to this version.
+func (*{{ .CRD.Kind }}) Hub() {}
@@ -0,0 +1,37 @@
+{{- template "boilerplate" }}
+
+package {{ .APIVersion }}
+
+import (
+{{- if GoCodeConversionKeepsFields .Delta }}
+	"encoding/json"
+
+{{ end -}}
+	ctrlrtconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	{{ .HubVersion }} "github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/apis/{{ .HubVersion }}"
+)
+
+var _ = ctrlrtconversion.Convertible(&{{ .CRD.Kind }}{})
+
+// ConvertTo converts this {{ .CRD.Kind }} to the Hub version ({{ .HubVersion }}).
+func (src *{{ .CRD.Kind }}) ConvertTo(dstRaw ctrlrtconversion.Hub) error {
+	dst := dstRaw.(*{{ .HubVersion }}.{{ .CRD.Kind }})
+	kept := map[string]interface{}{}
+{{- GoCodeConvertTo .HubCRD .Delta .HubVersion "src" "dst" "restored" "kept" 1 }}
+	dst.Status.ACKResourceMetadata = src.Status.ACKResourceMetadata
+	dst.Status.Conditions = src.Status.Conditions
+	dst.ObjectMeta = src.ObjectMeta
+	return setConversionData(dst, kept)
+}
+
+// ConvertFrom converts from the Hub version ({{ .HubVersion }}) to this version.
+func (dst *{{ .CRD.Kind }}) ConvertFrom(srcRaw ctrlrtconversion.Hub) error {
+	src := srcRaw.(*{{ .HubVersion }}.{{ .CRD.Kind }})
+	kept := map[string]interface{}{}
+{{- GoCodeConvertFrom .CRD .Delta "src" "dst" "restored" "kept" 1 }}
+	dst.Status.ACKResourceMetadata = src.Status.ACKResourceMetadata
+	dst.Status.Conditions = src.Status.Conditions
+	dst.ObjectMeta = src.ObjectMeta
+	return setConversionData(dst, kept)
+}
@@ -0,0 +1,65 @@
+{{- template "boilerplate" }}
+
+package {{ .APIVersion }}
+
+import (
+	"fmt"
+
+	ackrtwebhook "github.com/aws-controllers-k8s/runtime/pkg/webhook"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path={{ .WebhookPath }},mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1,groups={{ .APIGroup }},resources={{ .Resource }},verbs=update,versions={{ .APIVersion }},name={{ .WebhookName }}
+
+var _ webhook.Validator = &{{ .CRD.Kind }}{}
+
+func init() {
+	webhook := ackrtwebhook.New(
+		"{{ .APIVersion }}",
+		"{{ .CRD.Kind }}",
+		"validating",
+		func(mgr ctrlrt.Manager) error {
+			return ctrlrt.NewWebhookManagedBy(mgr).
+				For(&{{ .CRD.Kind }}{}).
+				Complete()
+		},
+	)
+	if err := ackrtwebhook.RegisterWebhook(webhook); err != nil {
+		msg := fmt.Sprintf("cannot register webhook: %v", err)
+		panic(msg)
+	}
+}
+
+// ValidateCreate accepts the creation of any {{ .CRD.Kind }}
+func (r *{{ .CRD.Kind }}) ValidateCreate() error {
+	return nil
+}
+
+// ValidateUpdate rejects the changes to the fields of a {{ .CRD.Kind }} that
+// can't be changed once set, including clearing them. It enforces the same
+// rules as the `x-kubernetes-validations` of the {{ .CRD.Kind }}Spec struct on
+// clusters that don't support CEL.
+func (r *{{ .CRD.Kind }}) ValidateUpdate(old runtime.Object) error {
+	oldR, ok := old.(*{{ .CRD.Kind }})
+	if !ok {
+		return fmt.Errorf("expected a {{ .CRD.Kind }} but got a %T", old)
+	}
+	errs := field.ErrorList{}
+{{ GoCodeValidateImmutableFields .CRD "oldR" "r" "errs" 1 }}
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		GroupVersion.WithKind("{{ .CRD.Kind }}").GroupKind(), r.Name, errs,
+	)
+}
+
+// ValidateDelete accepts the deletion of any {{ .CRD.Kind }}
+func (r *{{ .CRD.Kind }}) ValidateDelete() error {
+	return nil
+}
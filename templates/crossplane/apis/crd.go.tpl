@@ -17,6 +17,9 @@ import (
 )
 
 // {{ .CRD.Kind }}Parameters defines the desired state of {{ .CRD.Kind }}
+{{- range $marker := .CRD.ImmutableMarkers }}
+// {{ $marker }}
+{{- end }}
 type {{ .CRD.Kind }}Parameters struct {
 	// Region is which region the {{ .CRD.Kind }} will be created.
 	// +kubebuilder:validation:Required
@@ -25,6 +28,12 @@ type {{ .CRD.Kind }}Parameters struct {
 	{{- if $field.ShapeRef }}
 	{{ $field.ShapeRef.Documentation }}
 	{{- end }}
+	{{- range $marker := $field.ValidationMarkers }}
+	// {{ $marker }}
+	{{- end }}
+	{{- if $marker := $field.DefaultMarker }}
+	// {{ $marker }}
+	{{- end }}
 	{{ if $field.IsRequired }} // +kubebuilder:validation:Required
 	{{ $field.Names.Camel }} {{ $field.GoType }} `json:"{{ $field.Names.CamelLower }}"`
 	{{- else }} {{ $field.Names.Camel }} {{ $field.GoType }} `json:"{{ $field.Names.CamelLower }},omitempty"` {{ end }}
@@ -4,6 +4,12 @@ type {{ .Names.Camel }} struct {
 	{{- if $attr.Shape }}
 	{{ $attr.Shape.Documentation }}
 	{{- end }}
+	{{- range $marker := $attr.ValidationMarkers }}
+	// {{ $marker }}
+	{{- end }}
+	{{- if $marker := $attr.DefaultMarker }}
+	// {{ $marker }}
+	{{- end }}
 	{{ $attr.Names.Camel }} {{ $attr.GoType }} `json:"{{ $attr.Names.CamelLower }},omitempty"`
 {{- end }}
 }
@@ -0,0 +1,160 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"context"
+
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}"
+)
+
+// Hack to avoid import errors during build...
+var (
+	_ = &svcsdk.{{ .SDKAPIInterfaceTypeName }}{}
+)
+
+// diffChildren returns the desired child identifiers missing from the latest
+// ones, and the latest child identifiers that aren't desired
+func diffChildren(
+	desired []*string,
+	latest []*string,
+) (toAdd []*string, toRemove []*string) {
+	latestSet := map[string]bool{}
+	for _, child := range latest {
+		if child != nil {
+			latestSet[*child] = true
+		}
+	}
+	desiredSet := map[string]bool{}
+	for _, child := range desired {
+		if child == nil || desiredSet[*child] {
+			continue
+		}
+		desiredSet[*child] = true
+		if !latestSet[*child] {
+			toAdd = append(toAdd, child)
+		}
+	}
+	for _, child := range latest {
+		if child != nil && !desiredSet[*child] {
+			toRemove = append(toRemove, child)
+		}
+	}
+	return toAdd, toRemove
+}
+{{- range $child := .CRD.ChildFields }}
+{{- $fieldName := $child.Field.Names.Camel }}
+
+// get{{ $fieldName }} returns the identifiers of the children of the resource
+// that the {{ $fieldName }} field contains
+func (rm *resourceManager) get{{ $fieldName }}(
+	ctx context.Context,
+	r *resource,
+) (children []*string, err error) {
+{{- if $op := $child.ListOp }}
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.get{{ $fieldName }}")
+	defer exit(err)
+{{ if $op.ParentMembers }}
+	if {{ $op.ParentIsNil "r.ko" }} {
+		return r.ko.Spec.{{ $fieldName }}, nil
+	}
+{{- end }}
+	input := &svcsdk.{{ $op.Operation.InputRef.Shape.ShapeName }}{}
+{{- range $member := $op.ParentMembers }}
+	input.Set{{ $member.MemberName }}(*{{ $member.VarPath "r.ko" }})
+{{- end }}
+	resp, err := rm.sdkapi.{{ $op.Operation.ExportedName }}WithContext(ctx, input)
+	rm.metrics.RecordAPICall("READ_MANY", "{{ $op.Operation.ExportedName }}", err)
+	if err != nil {
+		return nil, err
+	}
+{{ GoCodeGetChildren $.CRD $child "resp" "children" 1 }}
+{{- else }}
+{{ GoCodeGetChildren $.CRD $child "r.ko" "children" 1 }}
+{{- end }}
+	return children, nil
+}
+
+// sync{{ $fieldName }} adds and removes children of the resource so that they
+// match the desired identifiers
+func (rm *resourceManager) sync{{ $fieldName }}(
+	ctx context.Context,
+	r *resource,
+	desired []*string,
+	latest []*string,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.sync{{ $fieldName }}")
+	defer exit(err)
+{{ if $parentIsNil := $child.ParentIsNil "r.ko" }}
+	if {{ $parentIsNil }} {
+		// The resource can't be identified yet, its children will be
+		// synchronized by a later reconciliation
+		return nil
+	}
+{{- end }}
+	toAdd, toRemove := diffChildren(desired, latest)
+{{- with $op := $child.RemoveOp }}
+{{- if $op.IsBatch }}
+	if len(toRemove) > 0 {
+		input := &svcsdk.{{ $op.Operation.InputRef.Shape.ShapeName }}{}
+{{- range $member := $op.ParentMembers }}
+		input.Set{{ $member.MemberName }}(*{{ $member.VarPath "r.ko" }})
+{{- end }}
+		input.Set{{ $op.MemberName }}(toRemove)
+		_, err = rm.sdkapi.{{ $op.Operation.ExportedName }}WithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "{{ $op.Operation.ExportedName }}", err)
+		if err != nil {
+			return err
+		}
+	}
+{{- else }}
+	for _, child := range toRemove {
+		input := &svcsdk.{{ $op.Operation.InputRef.Shape.ShapeName }}{}
+{{- range $member := $op.ParentMembers }}
+		input.Set{{ $member.MemberName }}(*{{ $member.VarPath "r.ko" }})
+{{- end }}
+		input.Set{{ $op.MemberName }}(*child)
+		_, err = rm.sdkapi.{{ $op.Operation.ExportedName }}WithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "{{ $op.Operation.ExportedName }}", err)
+		if err != nil {
+			return err
+		}
+	}
+{{- end }}
+{{- end }}
+{{- with $op := $child.AddOp }}
+{{- if $op.IsBatch }}
+	if len(toAdd) > 0 {
+		input := &svcsdk.{{ $op.Operation.InputRef.Shape.ShapeName }}{}
+{{- range $member := $op.ParentMembers }}
+		input.Set{{ $member.MemberName }}(*{{ $member.VarPath "r.ko" }})
+{{- end }}
+		input.Set{{ $op.MemberName }}(toAdd)
+		_, err = rm.sdkapi.{{ $op.Operation.ExportedName }}WithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "{{ $op.Operation.ExportedName }}", err)
+		if err != nil {
+			return err
+		}
+	}
+{{- else }}
+	for _, child := range toAdd {
+		input := &svcsdk.{{ $op.Operation.InputRef.Shape.ShapeName }}{}
+{{- range $member := $op.ParentMembers }}
+		input.Set{{ $member.MemberName }}(*{{ $member.VarPath "r.ko" }})
+{{- end }}
+		input.Set{{ $op.MemberName }}(*child)
+		_, err = rm.sdkapi.{{ $op.Operation.ExportedName }}WithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "{{ $op.Operation.ExportedName }}", err)
+		if err != nil {
+			return err
+		}
+	}
+{{- end }}
+{{- end }}
+	return nil
+}
+{{- end }}
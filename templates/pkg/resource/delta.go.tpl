@@ -4,9 +4,18 @@ package {{ .CRD.Names.Snake }}
 
 import (
 	"bytes"
+{{- if .CRD.HasTypeOverride "json" }}
+	"encoding/json"
+{{- end }}
 	"reflect"
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+{{- if $tagging := .CRD.Tagging }}
+{{- if and (not $tagging.IsNormalized) (eq $tagging.Format "list") }}
+
+	svcapitypes "github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/apis/{{ .APIVersion }}"
+{{- end }}
+{{- end }}
 )
 
 // Hack to avoid import errors during build...
@@ -37,3 +46,48 @@ func newResourceDelta(
 {{- end }}
 	return delta
 }
+{{- if $tagging := .CRD.Tagging }}
+{{- if and (not $tagging.IsNormalized) (eq $tagging.Format "list") }}
+
+// equalTags returns true if the supplied tags have the same keys and values,
+// regardless of their order
+func equalTags(
+	a []*svcapitypes.{{ $tagging.Field.GoTypeElem }},
+	b []*svcapitypes.{{ $tagging.Field.GoTypeElem }},
+) bool {
+	return ackcompare.MapStringStringPEqual(tagsToMap(a), tagsToMap(b))
+}
+
+// tagsToMap returns the supplied tags as a map of keys to values. Missing
+// values are empty strings.
+func tagsToMap(
+	tags []*svcapitypes.{{ $tagging.Field.GoTypeElem }},
+) map[string]*string {
+	res := map[string]*string{}
+	for _, tag := range tags {
+		if tag == nil || tag.{{ $tagging.KeyMemberName }} == nil {
+			continue
+		}
+		value := ""
+		if tag.{{ $tagging.ValueMemberName }} != nil {
+			value = *tag.{{ $tagging.ValueMemberName }}
+		}
+		res[*tag.{{ $tagging.KeyMemberName }}] = &value
+	}
+	return res
+}
+{{- end }}
+{{- end }}
+{{- if .CRD.HasTypeOverride "json" }}
+
+// equalJSON returns true if the supplied JSON documents contain the same JSON
+// value, regardless of their formatting and of the order of their object keys.
+// Documents that aren't valid JSON are compared byte by byte.
+func equalJSON(a []byte, b []byte) bool {
+	var aValue, bValue interface{}
+	if json.Unmarshal(a, &aValue) != nil || json.Unmarshal(b, &bValue) != nil {
+		return bytes.Equal(a, b)
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
+{{- end }}
@@ -85,6 +85,25 @@ func (rm *resourceManager) ReadOne(
 		}
 		return rm.onError(r, err)
 	}
+{{- if .CRD.HasNormalizedTags }}
+{{- if .CRD.Tagging.ListTagsOp }}
+	tags, err := rm.getTags(ctx, observed)
+	if err != nil {
+		return rm.onError(observed, err)
+	}
+	observed.ko.Spec.{{ .CRD.Tagging.Field.Names.Camel }} = tags
+{{- end }}
+{{- end }}
+{{- range $child := .CRD.ChildFields }}
+	if observed.ko.Spec.{{ $child.Field.Names.Camel }}, err = rm.get{{ $child.Field.Names.Camel }}(ctx, observed); err != nil {
+		return rm.onError(observed, err)
+	}
+{{- end }}
+{{- range $sub := .CRD.SubResources }}
+	if err = rm.get{{ $sub.Field.Names.Camel }}(ctx, observed); err != nil {
+		return rm.onError(observed, err)
+	}
+{{- end }}
 	return rm.onSuccess(observed)
 }
 
@@ -100,10 +119,32 @@ func (rm *resourceManager) Create(
 		// Should never happen... if it does, it's buggy code.
 		panic("resource manager's Create() method received resource with nil CR object")
 	}
+{{- if .CRD.HasReferenceFields }}
+	resolved, err := rm.resolveReferences(ctx, r)
+	if err != nil {
+		return rm.onReferenceNotResolved(r, err)
+	}
+	r = resolved
+{{- end }}
 	created, err := rm.sdkCreate(ctx, r)
 	if err != nil {
 		return rm.onError(r, err)
 	}
+{{- if .CRD.ReadinessWaiter }}
+	rm.setNotReady(created)
+{{- end }}
+{{- range $child := .CRD.ChildFields }}
+	if err = rm.sync{{ $child.Field.Names.Camel }}(ctx, created, created.ko.Spec.{{ $child.Field.Names.Camel }}, nil); err != nil {
+		return rm.onError(created, err)
+	}
+{{- end }}
+{{- range $sub := .CRD.SubResources }}
+	if created.ko.Spec.{{ $sub.Field.Names.Camel }} != nil {
+		if err = rm.sync{{ $sub.Field.Names.Camel }}(ctx, created, created); err != nil {
+			return rm.onError(created, err)
+		}
+	}
+{{- end }}
 	return rm.onSuccess(created)
 }
 
@@ -127,10 +168,52 @@ func (rm *resourceManager) Update(
 		// Should never happen... if it does, it's buggy code.
 		panic("resource manager's Update() method received resource with nil CR object")
 	}
+{{- if .CRD.HasReferenceFields }}
+	resolved, err := rm.resolveReferences(ctx, desired)
+	if err != nil {
+		return rm.onReferenceNotResolved(latest, err)
+	}
+	desired = resolved
+{{- end }}
+{{- if .CRD.HasNormalizedTags }}
+{{- if or .CRD.Tagging.TagOp .CRD.Tagging.UntagOp }}
+	if delta.DifferentAt("Spec.{{ .CRD.Tagging.Field.Names.Camel }}") {
+		if err := rm.syncTags(ctx, desired, latest); err != nil {
+			return rm.onError(latest, err)
+		}
+	}
+{{- end }}
+{{- end }}
+{{- range $child := .CRD.ChildFields }}
+	if delta.DifferentAt("Spec.{{ $child.Field.Names.Camel }}") {
+		if err := rm.sync{{ $child.Field.Names.Camel }}(ctx, latest, desired.ko.Spec.{{ $child.Field.Names.Camel }}, latest.ko.Spec.{{ $child.Field.Names.Camel }}); err != nil {
+			return rm.onError(latest, err)
+		}
+	}
+{{- end }}
+{{- range $sub := .CRD.SubResources }}
+	if delta.DifferentAt("Spec.{{ $sub.Field.Names.Camel }}") {
+		if err := rm.sync{{ $sub.Field.Names.Camel }}(ctx, latest, desired); err != nil {
+			return rm.onError(latest, err)
+		}
+	}
+{{- end }}
+{{- if .CRD.SyncedFieldPaths }}
+	if onlySyncedFieldsDiffer(delta) {
+		// The fields synchronized above are the only differences, no need
+		// to call the update operation
+		return rm.onSuccess(&resource{desired.ko.DeepCopy()})
+	}
+{{- end }}
 	updated, err := rm.sdkUpdate(ctx, desired, latest, delta)
 	if err != nil {
 		return rm.onError(latest, err)
 	}
+{{- if .CRD.ReadinessWaiter }}
+	if updated != nil {
+		rm.setNotReady(updated)
+	}
+{{- end }}
 	return rm.onSuccess(updated)
 }
 
@@ -295,3 +378,17 @@ func (rm *resourceManager) onSuccess(
 	}
 	return r1, nil
 }
+{{- if .CRD.SyncedFieldPaths }}
+
+// onlySyncedFieldsDiffer returns true if the supplied delta only contains
+// differences in the fields synchronized with dedicated API operations
+func onlySyncedFieldsDiffer(delta *ackcompare.Delta) bool {
+	for _, diff := range delta.Differences {
+		if {{ range $i, $path := .CRD.SyncedFieldPaths }}{{ if $i }} &&
+			{{ end }}!diff.Path.Contains("{{ $path }}"){{ end }} {
+			return false
+		}
+	}
+	return true
+}
+{{- end }}
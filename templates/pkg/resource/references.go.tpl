@@ -0,0 +1,194 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/apis/{{ .APIVersion }}"
+)
+
+// referenceRequeueDelay is the delay after which a resource whose references
+// couldn't be resolved is reconciled again
+const referenceRequeueDelay = 10 * time.Second
+
+var (
+	referenceClientOnce sync.Once
+	referenceClient     dynamic.Interface
+	referenceClientErr  error
+)
+
+// getReferenceClient returns the client reading the referenced custom
+// resources. Unstructured objects are read so that the API types of other
+// service controllers aren't needed.
+func getReferenceClient() (dynamic.Interface, error) {
+	referenceClientOnce.Do(func() {
+		cfg, err := ctrlrt.GetConfig()
+		if err != nil {
+			referenceClientErr = err
+			return
+		}
+		referenceClient, referenceClientErr = dynamic.NewForConfig(cfg)
+	})
+	return referenceClient, referenceClientErr
+}
+{{ range $field := .CRD.ReferenceFields }}
+{{- $ref := $field.Reference }}
+// +kubebuilder:rbac:groups={{ $ref.APIGroup }},resources={{ $ref.Resource }},verbs=get;list
+{{- end }}
+
+// resolveReferences returns a copy of the supplied resource whose fields
+// referring to other custom resources are filled in with the identifiers read
+// from the referenced custom resources. An error is returned when a referenced
+// custom resource doesn't exist or isn't synced yet.
+func (rm *resourceManager) resolveReferences(
+	ctx context.Context,
+	r *resource,
+) (*resource, error) {
+	ko := r.ko.DeepCopy()
+	namespace := ko.GetNamespace()
+{{- range $field := .CRD.ReferenceFields }}
+{{- $ref := $field.Reference }}
+	{{- if $ref.IsList }}
+	if len(ko.Spec.{{ $ref.RefNames.Camel }}) > 0 || ko.Spec.{{ $ref.SelectorNames.Camel }} != nil {
+		ids, err := resolveReferenceIDs(
+			ctx,
+			schema.GroupVersionResource{Group: "{{ $ref.APIGroup }}", Version: "{{ or $ref.APIVersion $.APIVersion }}", Resource: "{{ $ref.Resource }}"},
+			namespace,
+			ko.Spec.{{ $ref.RefNames.Camel }},
+			ko.Spec.{{ $ref.SelectorNames.Camel }},
+			{{ range $i, $part := $ref.JSONPath }}{{ if $i }}, {{ end }}"{{ $part }}"{{ end }},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve the reference of {{ $field.Names.Camel }}: %v", err)
+		}
+		ko.Spec.{{ $field.Names.Camel }} = ids
+	}
+	{{- else }}
+	if ko.Spec.{{ $ref.RefNames.Camel }} != nil || ko.Spec.{{ $ref.SelectorNames.Camel }} != nil {
+		refs := []*svcapitypes.ResourceReference{}
+		if ko.Spec.{{ $ref.RefNames.Camel }} != nil {
+			refs = append(refs, ko.Spec.{{ $ref.RefNames.Camel }})
+		}
+		ids, err := resolveReferenceIDs(
+			ctx,
+			schema.GroupVersionResource{Group: "{{ $ref.APIGroup }}", Version: "{{ or $ref.APIVersion $.APIVersion }}", Resource: "{{ $ref.Resource }}"},
+			namespace,
+			refs,
+			ko.Spec.{{ $ref.SelectorNames.Camel }},
+			{{ range $i, $part := $ref.JSONPath }}{{ if $i }}, {{ end }}"{{ $part }}"{{ end }},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve the reference of {{ $field.Names.Camel }}: %v", err)
+		}
+		if len(ids) != 1 {
+			return nil, fmt.Errorf(
+				"cannot resolve the reference of {{ $field.Names.Camel }}: expected exactly one {{ $ref.Resource }} but found %d", len(ids),
+			)
+		}
+		ko.Spec.{{ $field.Names.Camel }} = ids[0]
+	}
+	{{- end }}
+{{- end }}
+	return &resource{ko}, nil
+}
+
+// onReferenceNotResolved returns a copy of the supplied resource whose
+// ResourceSynced condition is False, and an error requeuing it until the
+// referenced custom resources are ready
+func (rm *resourceManager) onReferenceNotResolved(
+	r *resource,
+	err error,
+) (*resource, error) {
+	res := &resource{r.ko.DeepCopy()}
+	reason := "ReferenceNotResolved"
+	msg := err.Error()
+	ackcondition.SetSynced(res, corev1.ConditionFalse, &msg, &reason)
+	return res, ackrequeue.NeededAfter(err, referenceRequeueDelay)
+}
+
+// resolveReferenceIDs returns the identifiers found at the supplied JSON field
+// path of the custom resources either referred to by name or selected by
+// labels. All the custom resources must be synced.
+func resolveReferenceIDs(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	namespace string,
+	refs []*svcapitypes.ResourceReference,
+	selector *svcapitypes.ResourceSelector,
+	path ...string,
+) ([]*string, error) {
+	client, err := getReferenceClient()
+	if err != nil {
+		return nil, err
+	}
+	objs := []unstructured.Unstructured{}
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		obj, err := client.Resource(gvr).Namespace(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("cannot get %s %s/%s: %v", gvr.Resource, namespace, ref.Name, err)
+		}
+		objs = append(objs, *obj)
+	}
+	if selector != nil {
+		list, err := client.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(selector.MatchLabels).String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot list %s in %s: %v", gvr.Resource, namespace, err)
+		}
+		objs = append(objs, list.Items...)
+	}
+	if len(objs) == 0 {
+		return nil, fmt.Errorf("no %s found", gvr.Resource)
+	}
+	ids := []*string{}
+	for i := range objs {
+		obj := &objs[i]
+		if !isReferenceSynced(obj) {
+			return nil, fmt.Errorf("%s %s/%s is not synced", gvr.Resource, namespace, obj.GetName())
+		}
+		id, found, err := unstructured.NestedString(obj.Object, path...)
+		if err != nil || !found || id == "" {
+			return nil, fmt.Errorf(
+				"%s %s/%s has no %s", gvr.Resource, namespace, obj.GetName(), strings.Join(path, "."),
+			)
+		}
+		ids = append(ids, &id)
+	}
+	return ids, nil
+}
+
+// isReferenceSynced returns true if the ResourceSynced condition of the
+// supplied custom resource is True
+func isReferenceSynced(obj *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == string(ackv1alpha1.ConditionTypeResourceSynced) {
+			return condition["status"] == string(corev1.ConditionTrue)
+		}
+	}
+	return false
+}
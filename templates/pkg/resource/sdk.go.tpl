@@ -4,17 +4,43 @@ package {{ .CRD.Names.Snake }}
 
 import (
 	"context"
+{{- if .CRD.DeleteWaitsUntilNotFound }}
+	"errors"
+{{- end }}
+{{- if or .CRD.ReadinessWaiter .CRD.IsReadManyPaginated }}
+	"fmt"
+{{- end }}
+{{- if .CRD.HasExceptionMessagePatterns }}
+	"regexp"
+{{- end }}
 	"strings"
+{{- if .CRD.HasExceptionOutcome "retry" }}
+	"sync"
+{{- end }}
+{{- if or .CRD.DeleteRequeueAfterSeconds .CRD.HasExceptionRequeueDurations }}
+	"time"
+{{- end }}
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+{{- if or .CRD.DeleteWaitsUntilNotFound (.CRD.HasExceptionOutcome "retry") (.CRD.HasExceptionOutcome "requeue") }}
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+{{- end }}
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
 	"github.com/aws/aws-sdk-go/aws"
+{{- if .CRD.HasExceptionOutcome "terminal" }}
+	"github.com/aws/aws-sdk-go/aws/awserr"
+{{- end }}
+{{- if .CRD.ReadinessWaiter }}
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+{{- end }}
 	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+{{- template "type_override_imports" . }}
 
 	svcapitypes "github.com/aws-controllers-k8s/{{.ServiceIDClean }}-controller/apis/{{ .APIVersion }}"
 )
@@ -29,6 +55,7 @@ var (
 	_ = ackv1alpha1.AWSAccountID("")
 	_ = &ackerr.NotFound
 	_ = &ackcondition.NotManagedMessage
+{{- template "type_override_import_hacks" . }}
 )
 
 // sdkFind returns SDK-specific information about a supplied resource
@@ -76,6 +103,9 @@ func (rm *resourceManager) sdkCreate(
 {{ $hookCode }}
 {{- end }}
 	rm.metrics.RecordAPICall("CREATE", "{{ .CRD.Ops.Create.ExportedName }}", err)
+{{- if .CRD.ExceptionRules }}
+	err = rm.classifyAWSError(desired, "{{ .CRD.Ops.Create.ExportedName }}", err)
+{{- end }}
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +163,13 @@ func (rm *resourceManager) sdkDelete(
 	defer exit(err)
 
 {{- if .CRD.Ops.Delete }}
+{{- if .CRD.DeletingStatus }}
+	if rm.isDeleting(r) {
+		// The Delete operation was already called, wait until the resource
+		// is gone
+		return r, requeueWaitWhileDeleting
+	}
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_delete_pre_build_request" }}
 {{ $hookCode }}
 {{- end }}
@@ -140,6 +177,11 @@ func (rm *resourceManager) sdkDelete(
 	if err = rm.{{ $customMethod }}(ctx, r); err != nil {
 		return nil, err
 	}
+{{- end }}
+{{- if .CRD.PreDeleteUpdates }}
+	if r, err = rm.preDeleteUpdate(ctx, r); err != nil {
+		return nil, err
+	}
 {{- end }}
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
@@ -151,10 +193,38 @@ func (rm *resourceManager) sdkDelete(
 	var resp {{ .CRD.GetOutputShapeGoType .CRD.Ops.Delete }}; _ = resp;
 	resp, err = rm.sdkapi.{{ .CRD.Ops.Delete.Name }}WithContext(ctx, input)
 	rm.metrics.RecordAPICall("DELETE", "{{ .CRD.Ops.Delete.Name }}", err)
+{{- if .CRD.ExceptionRules }}
+	err = rm.classifyAWSError(r, "{{ .CRD.Ops.Delete.ExportedName }}", err)
+{{- if .CRD.HasExceptionOutcome "retry" }}
+{{- if .CRD.DeleteWaitsUntilNotFound }}
+	if err == ackerr.NotFound {
+{{- else }}
+	if err == nil || err == ackerr.NotFound {
+{{- end }}
+		// The resource is gone, its API operations won't be retried
+		forgetRetryAttempts(r)
+	}
+{{- end }}
+{{- if .CRD.HasExceptionOutcome "not_found" }}
+	if err == ackerr.NotFound {
+		// The resource is already gone
+		return nil, nil
+	}
+{{- end }}
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_delete_post_request" }}
 {{ $hookCode }}
 {{- end }}
+{{- if .CRD.DeleteWaitsUntilNotFound }}
+	if err != nil {
+		return nil, err
+	}
+	// The resource is deleted asynchronously, its finalizer is kept until
+	// ReadOne no longer finds it
+	return r, requeueWaitWhileDeleting
+{{- else }}
 	return nil, err
+{{- end }}
 {{- else }}
 	// TODO(jaypipes): Figure this out...
 	return nil, nil
@@ -173,6 +243,51 @@ func (rm *resourceManager) newDeleteRequestPayload(
 }
 {{- end }}
 
+{{- if .CRD.DeleteWaitsUntilNotFound }}
+
+// requeueWaitWhileDeleting requeues a resource being deleted until ReadOne no
+// longer finds it
+var requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+	errors.New("{{ .CRD.Names.Camel }} is being deleted"),
+{{- if $seconds := .CRD.DeleteRequeueAfterSeconds }}
+	{{ $seconds }}*time.Second,
+{{- else }}
+	ackrequeue.DefaultRequeueAfterDuration,
+{{- end }}
+)
+{{- end }}
+{{- if .CRD.DeletingStatus }}
+
+// isDeleting returns true if the supplied resource is being deleted
+func (rm *resourceManager) isDeleting(
+	r *resource,
+) bool {
+{{ GoCodeIsDeleting .CRD "r.ko" 1 -}}
+}
+{{- end }}
+{{- if .CRD.PreDeleteUpdates }}
+
+// preDeleteUpdate updates the Spec fields of the supplied resource that must
+// have given values for the resource to be deleted, e.g. to disable its
+// deletion protection
+func (rm *resourceManager) preDeleteUpdate(
+	ctx context.Context,
+	latest *resource,
+) (*resource, error) {
+	desired := &resource{latest.ko.DeepCopy()}
+{{ GoCodeSetPreDeleteUpdates .CRD "desired.ko" 1 -}}
+	delta := newResourceDelta(desired, latest)
+	if len(delta.Differences) == 0 {
+		return latest, nil
+	}
+	updated, err := rm.sdkUpdate(ctx, desired, latest, delta)
+	if err != nil || updated == nil {
+		return latest, err
+	}
+	return updated, nil
+}
+{{- end }}
+
 // setStatusDefaults sets default properties into supplied custom resource
 func (rm *resourceManager) setStatusDefaults (
 	ko *svcapitypes.{{ .CRD.Names.Camel }},
@@ -258,7 +373,46 @@ func (rm *resourceManager) updateConditions (
 		}
 	}
 
-{{- if $reconcileRequeuOnSuccessSeconds := .CRD.ReconcileRequeuOnSuccessSeconds }}
+{{- if .CRD.SyncedRules }}
+	if err == nil {
+		syncStatus, terminal, syncMessage := rm.syncedStatus(ko)
+		if syncCondition == nil {
+			syncCondition = &ackv1alpha1.Condition{
+				Type:   ackv1alpha1.ConditionTypeResourceSynced,
+			}
+			ko.Status.Conditions = append(ko.Status.Conditions, syncCondition)
+		}
+		syncCondition.Status = syncStatus
+		if syncMessage != "" {
+			syncCondition.Message = &syncMessage
+		} else {
+			syncCondition.Message = nil
+		}
+		if terminal {
+			if terminalCondition == nil {
+				terminalCondition = &ackv1alpha1.Condition{
+					Type:   ackv1alpha1.ConditionTypeTerminal,
+				}
+				ko.Status.Conditions = append(ko.Status.Conditions, terminalCondition)
+			}
+			terminalCondition.Status = corev1.ConditionTrue
+			terminalCondition.Message = &syncMessage
+		}
+	}
+{{- else if .CRD.ReadinessWaiter }}
+	if onSuccess && syncCondition != nil && syncCondition.Reason != nil &&
+		*syncCondition.Reason == waiterFailureReason {
+		// sdkFind found the resource in a failure state of its waiter
+		if terminalCondition == nil {
+			terminalCondition = &ackv1alpha1.Condition{
+				Type:   ackv1alpha1.ConditionTypeTerminal,
+			}
+			ko.Status.Conditions = append(ko.Status.Conditions, terminalCondition)
+		}
+		terminalCondition.Status = corev1.ConditionTrue
+		terminalCondition.Message = syncCondition.Message
+	}
+{{- else if $reconcileRequeuOnSuccessSeconds := .CRD.ReconcileRequeuOnSuccessSeconds }}
 	if syncCondition == nil && onSuccess {
 		syncCondition = &ackv1alpha1.Condition{
 			Type:   ackv1alpha1.ConditionTypeResourceSynced,
@@ -285,10 +439,200 @@ func (rm *resourceManager) updateConditions (
 	return nil, false // not updated
 }
 
+{{ if .CRD.SyncedRules -}}
+// syncedStatus returns the status of the ACK.ResourceSynced condition of the
+// supplied resource as derived from the values of its Status fields, whether
+// the resource is in a terminal state and a message explaining why the
+// resource isn't synced
+func (rm *resourceManager) syncedStatus(
+	ko *svcapitypes.{{ .CRD.Names.Camel }},
+) (corev1.ConditionStatus, bool, string) {
+{{ GoCodeSyncedStatus .CRD "ko" 1 -}}
+}
+
+{{ end -}}
+{{ if $waiter := .CRD.ReadinessWaiter -}}
+// readinessAcceptors are the acceptors of the aws-sdk-go's
+// {{ $waiter.Name }} waiter matching the output of the
+// {{ $waiter.OperationName }} operation
+var readinessAcceptors = []request.WaiterAcceptor{
+{{ GoCodeWaiterAcceptors .CRD 1 -}}
+}
+
+// waiterFailureReason is the reason of the ACK.ResourceSynced condition of
+// resources found in a failure state of the {{ $waiter.Name }} waiter
+const waiterFailureReason = "WaiterFailure"
+
+// setReadiness sets the ACK.ResourceSynced condition of the supplied resource
+// from the first acceptor of the aws-sdk-go's {{ $waiter.Name }} waiter
+// matching the supplied output of the {{ $waiter.OperationName }} operation
+func (rm *resourceManager) setReadiness(
+	r *resource,
+	resp interface{},
+) {
+	for _, a := range readinessAcceptors {
+		vals, _ := awsutil.ValuesAtPath(resp, a.Argument)
+		matched := false
+		switch a.Matcher {
+		case request.PathAllWaiterMatch, request.PathWaiterMatch:
+			matched = len(vals) > 0
+			for _, val := range vals {
+				if !awsutil.DeepEqual(val, a.Expected) {
+					matched = false
+					break
+				}
+			}
+		case request.PathAnyWaiterMatch:
+			for _, val := range vals {
+				if awsutil.DeepEqual(val, a.Expected) {
+					matched = true
+					break
+				}
+			}
+		}
+		if !matched {
+			continue
+		}
+		msg := fmt.Sprintf("%s is %v", a.Argument, a.Expected)
+		switch a.State {
+		case request.SuccessWaiterState:
+			ackcondition.SetSynced(r, corev1.ConditionTrue, nil, nil)
+		case request.FailureWaiterState:
+			reason := waiterFailureReason
+			ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, &reason)
+		default:
+			ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+		}
+		return
+	}
+	rm.setNotReady(r)
+}
+
+// setNotReady marks the supplied resource as not synced until sdkFind finds
+// it in a success state of the aws-sdk-go's {{ $waiter.Name }} waiter
+func (rm *resourceManager) setNotReady(
+	r *resource,
+) {
+	msg := "waiting for the {{ $waiter.Name }} waiter to succeed"
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+}
+
+{{ end -}}
+{{ if .CRD.ExceptionRules -}}
+// classifyAWSError returns the error the reconciler acts upon for the supplied
+// error returned by the opName API operation for the supplied resource,
+// according to the exception rules in generator config
+func (rm *resourceManager) classifyAWSError(
+	r *resource,
+	opName string,
+	err error,
+) error {
+{{- if .CRD.HasExceptionOutcome "retry" }}
+	key := retryAttemptsKey(r, opName)
+	if err == nil {
+		resetRetryAttempts(key)
+		return nil
+	}
+{{- else }}
+	if err == nil {
+		return nil
+	}
+{{- end }}
+	awsErr, ok := ackerr.AWSError(err)
+	if !ok {
+		return err
+	}
+{{ GoCodeClassifyAWSError .CRD "opName" "awsErr" "key" 1 -}}
+	return err
+}
+{{- if .CRD.HasExceptionMessagePatterns }}
+
+// exceptionMessagePatterns are the regular expressions the messages of errors
+// must match, keyed by the index of the exception rule in generator config
+var exceptionMessagePatterns = map[int]*regexp.Regexp{
+{{- range $rule := .CRD.ExceptionRules }}
+{{- if $rule.MessagePattern }}
+	{{ $rule.Index }}: regexp.MustCompile({{ printf "%q" $rule.MessagePattern }}),
+{{- end }}
+{{- end }}
+}
+{{- end }}
+{{- if .CRD.HasExceptionOutcome "terminal" }}
+
+// terminalError is an aws-sdk-go error matched by a terminal exception rule
+type terminalError struct {
+	awsErr awserr.Error
+}
+
+func (e *terminalError) Error() string   { return e.awsErr.Error() }
+func (e *terminalError) Code() string    { return e.awsErr.Code() }
+func (e *terminalError) Message() string { return e.awsErr.Message() }
+func (e *terminalError) OrigErr() error  { return e.awsErr.OrigErr() }
+{{- end }}
+{{- if .CRD.HasExceptionOutcome "retry" }}
+
+// retryAttempts counts the consecutive errors matched by retry exception
+// rules, keyed by resource and API operation
+var retryAttempts = struct {
+	sync.Mutex
+	counts map[string]int
+}{counts: map[string]int{}}
+
+// retryAttemptsKey returns the key of the errors of the supplied API operation
+// for the supplied resource in retryAttempts
+func retryAttemptsKey(r *resource, opName string) string {
+	return r.ko.Namespace + "/" + r.ko.Name + "/" + opName
+}
+
+// retryAfter returns the duration after which to retry the API operation
+// identified by the supplied key, doubling the supplied duration on each
+// consecutive error up to the supplied maximum
+func retryAfter(key string, after, max time.Duration) time.Duration {
+	retryAttempts.Lock()
+	defer retryAttempts.Unlock()
+	attempts := retryAttempts.counts[key]
+	retryAttempts.counts[key] = attempts + 1
+	for i := 0; i < attempts && after < max; i++ {
+		after *= 2
+	}
+	if after > max {
+		return max
+	}
+	return after
+}
+
+// resetRetryAttempts forgets the errors of the API operation identified by
+// the supplied key once it succeeds
+func resetRetryAttempts(key string) {
+	retryAttempts.Lock()
+	defer retryAttempts.Unlock()
+	delete(retryAttempts.counts, key)
+}
+
+// forgetRetryAttempts forgets the errors of all the API operations for the
+// supplied resource once it's deleted
+func forgetRetryAttempts(r *resource) {
+	prefix := retryAttemptsKey(r, "")
+	retryAttempts.Lock()
+	defer retryAttempts.Unlock()
+	for key := range retryAttempts.counts {
+		if strings.HasPrefix(key, prefix) {
+			delete(retryAttempts.counts, key)
+		}
+	}
+}
+{{- end }}
+
+{{ end -}}
 // terminalAWSError returns awserr, true; if the supplied error is an aws Error type
 // and if the exception indicates that it is a Terminal exception
 // 'Terminal' exception are specified in generator configuration
 func (rm *resourceManager) terminalAWSError(err error) bool {
+{{- if .CRD.HasExceptionOutcome "terminal" }}
+	if _, ok := err.(*terminalError); ok {
+		return true
+	}
+{{- end }}
 {{- if .CRD.TerminalExceptionCodes }}
 	if err == nil {
 		return false
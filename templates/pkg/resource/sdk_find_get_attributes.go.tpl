@@ -6,6 +6,15 @@ func (rm *resourceManager) sdkFind(
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.sdkFind")
 	defer exit(err)
+{{- if and .CRD.DeleteWaitsUntilNotFound (.CRD.HasExceptionOutcome "retry") }}
+	defer func() {
+		if err == ackerr.NotFound && r.ko.DeletionTimestamp != nil {
+			// The deleted resource is gone, its API operations won't be
+			// retried
+			forgetRetryAttempts(r)
+		}
+	}()
+{{- end }}
 
 {{- if $hookCode := Hook .CRD "sdk_get_attributes_pre_build_request" }}
 {{ $hookCode }}
@@ -30,6 +39,9 @@ func (rm *resourceManager) sdkFind(
 {{ $hookCode }}
 {{- end }}
 	rm.metrics.RecordAPICall("GET_ATTRIBUTES", "{{ .CRD.Ops.GetAttributes.ExportedName }}", err)
+{{- if .CRD.ExceptionRules }}
+	err = rm.classifyAWSError(r, "{{ .CRD.Ops.GetAttributes.ExportedName }}", err)
+{{- end }}
 	if err != nil {
 		if awsErr, ok := ackerr.AWSError(err); ok && awsErr.Code() == "{{ ResourceExceptionCode .CRD 404 }}" {{ GoCodeSetExceptionMessageCheck .CRD 404 }}{
 			return nil, ackerr.NotFound
@@ -45,6 +57,9 @@ func (rm *resourceManager) sdkFind(
 {{ $hookCode }}
 {{- end }}
 	rm.setStatusDefaults(ko)
+{{- if .CRD.ReadinessWaiter }}
+	rm.setReadiness(&resource{ko}, resp)
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_get_attributes_post_set_output" }}
 {{ $hookCode }}
 {{- end }}
@@ -6,6 +6,15 @@ func (rm *resourceManager) sdkFind(
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.sdkFind")
 	defer exit(err)
+{{- if and .CRD.DeleteWaitsUntilNotFound (.CRD.HasExceptionOutcome "retry") }}
+	defer func() {
+		if err == ackerr.NotFound && r.ko.DeletionTimestamp != nil {
+			// The deleted resource is gone, its API operations won't be
+			// retried
+			forgetRetryAttempts(r)
+		}
+	}()
+{{- end }}
 
 {{- if $hookCode := Hook .CRD "sdk_read_many_pre_build_request" }}
 {{ $hookCode }}
@@ -25,17 +34,50 @@ func (rm *resourceManager) sdkFind(
 {{ $hookCode }}
 {{- end }}
 	var resp {{ .CRD.GetOutputShapeGoType .CRD.Ops.ReadMany }}
+{{- if .CRD.IsReadManyPaginated }}
+	// Read the pages of results until one contains the resource, which is
+	// then set from that page. Otherwise it's looked for in the last page
+	// read and not found, unless pages were left unread.
+	pages := 0
+	capped := false
+	err = rm.sdkapi.{{ .CRD.Ops.ReadMany.ExportedName }}PagesWithContext(
+		ctx, input,
+		func(page {{ .CRD.GetOutputShapeGoType .CRD.Ops.ReadMany }}, lastPage bool) bool {
+			pages++
+			resp = page
+			if rm.readManyPageHasMatch(r, page) {
+				return false
+			}
+			capped = !lastPage && pages >= {{ .CRD.ListOpMaxPages }}
+			return !capped
+		},
+	)
+{{- else }}
 	resp, err = rm.sdkapi.{{ .CRD.Ops.ReadMany.ExportedName }}WithContext(ctx, input)
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_read_many_post_request" }}
 {{ $hookCode }}
 {{- end }}
 	rm.metrics.RecordAPICall("READ_MANY", "{{ .CRD.Ops.ReadMany.ExportedName }}", err)
+{{- if .CRD.ExceptionRules }}
+	err = rm.classifyAWSError(r, "{{ .CRD.Ops.ReadMany.ExportedName }}", err)
+{{- end }}
 	if err != nil {
 		if awsErr, ok := ackerr.AWSError(err); ok && awsErr.Code() == "{{ ResourceExceptionCode .CRD 404 }}" {{ GoCodeSetExceptionMessageCheck .CRD 404 }}{
 			return nil, ackerr.NotFound
 		}
 		return nil, err
 	}
+{{- if .CRD.IsReadManyPaginated }}
+	if capped {
+		// The resource may be in the pages left unread, so it can't be
+		// reported as not found
+		return nil, fmt.Errorf(
+			"cannot find resource in the first %d pages of {{ .CRD.Ops.ReadMany.ExportedName }} results",
+			pages,
+		)
+	}
+{{- end }}
 
 	// Merge in the information we read from the API call above to the copy of
 	// the original Kubernetes object we passed to the function
@@ -52,6 +94,9 @@ func (rm *resourceManager) sdkFind(
 		return nil, err
 	}
 {{- end }}
+{{- if .CRD.ReadinessWaiter }}
+	rm.setReadiness(&resource{ko}, resp)
+{{- end }}
 {{- if $hookCode := Hook .CRD "sdk_read_many_post_set_output" }}
 {{ $hookCode }}
 {{- end }}
@@ -71,6 +116,18 @@ func (rm *resourceManager) requiredFieldsMissingFromReadManyInput(
 {{- end }}
 }
 
+{{- if .CRD.IsReadManyPaginated }}
+
+// readManyPageHasMatch returns true if the supplied page of results of the
+// List API call contains the resource
+func (rm *resourceManager) readManyPageHasMatch(
+	r *resource,
+	page {{ .CRD.GetOutputShapeGoType .CRD.Ops.ReadMany }},
+) bool {
+{{ GoCodeReadManyHasMatch .CRD "r.ko" "page" 1 }}
+}
+{{- end }}
+
 // newListRequestPayload returns SDK-specific struct for the HTTP request
 // payload of the List API call for the resource
 func (rm *resourceManager) newListRequestPayload(
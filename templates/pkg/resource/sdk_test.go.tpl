@@ -0,0 +1,355 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}/{{ .ServiceIDClean }}iface"
+
+	svcapitypes "github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/apis/{{ .APIVersion }}"
+)
+
+// Hack to avoid import errors during build...
+var (
+	_ = strings.ToLower("")
+	_ = &ackerr.NotFound
+	_ = &aws.JSONValue{}
+)
+
+var (
+	testAccountID = ackv1alpha1.AWSAccountID("111111111111")
+	testRegion    = ackv1alpha1.AWSRegion("us-west-2")
+)
+
+// sdkTestCase compares a value set by the resource manager to the value it
+// was set from
+type sdkTestCase struct {
+	path string
+	got  interface{}
+	want interface{}
+}
+
+// checkSDKTestCases reports every test case whose values differ
+func checkSDKTestCases(t *testing.T, cases []sdkTestCase) {
+	t.Helper()
+	for _, tc := range cases {
+		if !reflect.DeepEqual(tc.got, tc.want) {
+			t.Errorf(
+				"%s: got %s, want %s",
+				tc.path, awsutil.Prettify(tc.got), awsutil.Prettify(tc.want),
+			)
+		}
+	}
+}
+
+// fakeSDKAPI is a fake of the {{ .ServiceIDClean }} API client that records the
+// input of the calls made by the resource manager and returns canned outputs.
+// Calling any other method of the API panics.
+type fakeSDKAPI struct {
+	svcsdkapi.{{ .SDKAPIInterfaceTypeName }}API
+{{- if SDKTestable .CRD "Create" }}
+
+	createInput  *svcsdk.{{ .CRD.Ops.Create.InputRef.Shape.ShapeName }}
+	createOutput {{ .CRD.GetOutputShapeGoType .CRD.Ops.Create }}
+{{- end }}
+{{- if SDKTestable .CRD "Get" }}
+
+	readOneInput  *svcsdk.{{ .CRD.Ops.ReadOne.InputRef.Shape.ShapeName }}
+	readOneOutput {{ .CRD.GetOutputShapeGoType .CRD.Ops.ReadOne }}
+{{- end }}
+{{- if SDKTestable .CRD "List" }}
+
+	readManyInput  *svcsdk.{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }}
+	readManyOutput {{ .CRD.GetOutputShapeGoType .CRD.Ops.ReadMany }}
+{{- end }}
+{{- if SDKTestable .CRD "GetAttributes" }}
+
+	getAttributesInput  *svcsdk.{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }}
+	getAttributesOutput {{ .CRD.GetOutputShapeGoType .CRD.Ops.GetAttributes }}
+{{- end }}
+{{- if SDKTestable .CRD "Update" }}
+
+	updateInput  *svcsdk.{{ .CRD.Ops.Update.InputRef.Shape.ShapeName }}
+	updateOutput {{ .CRD.GetOutputShapeGoType .CRD.Ops.Update }}
+{{- end }}
+}
+{{- if SDKTestable .CRD "Create" }}
+
+func (f *fakeSDKAPI) {{ .CRD.Ops.Create.ExportedName }}WithContext(
+	_ aws.Context,
+	input *svcsdk.{{ .CRD.Ops.Create.InputRef.Shape.ShapeName }},
+	_ ...request.Option,
+) ({{ .CRD.GetOutputShapeGoType .CRD.Ops.Create }}, error) {
+	f.createInput = input
+	return f.createOutput, nil
+}
+{{- end }}
+{{- if SDKTestable .CRD "Get" }}
+
+func (f *fakeSDKAPI) {{ .CRD.Ops.ReadOne.ExportedName }}WithContext(
+	_ aws.Context,
+	input *svcsdk.{{ .CRD.Ops.ReadOne.InputRef.Shape.ShapeName }},
+	_ ...request.Option,
+) ({{ .CRD.GetOutputShapeGoType .CRD.Ops.ReadOne }}, error) {
+	f.readOneInput = input
+	return f.readOneOutput, nil
+}
+{{- end }}
+{{- if SDKTestable .CRD "List" }}
+{{- if .CRD.IsReadManyPaginated }}
+
+func (f *fakeSDKAPI) {{ .CRD.Ops.ReadMany.ExportedName }}PagesWithContext(
+	_ aws.Context,
+	input *svcsdk.{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }},
+	fn func({{ .CRD.GetOutputShapeGoType .CRD.Ops.ReadMany }}, bool) bool,
+	_ ...request.Option,
+) error {
+	f.readManyInput = input
+	fn(f.readManyOutput, true)
+	return nil
+}
+{{- else }}
+
+func (f *fakeSDKAPI) {{ .CRD.Ops.ReadMany.ExportedName }}WithContext(
+	_ aws.Context,
+	input *svcsdk.{{ .CRD.Ops.ReadMany.InputRef.Shape.ShapeName }},
+	_ ...request.Option,
+) ({{ .CRD.GetOutputShapeGoType .CRD.Ops.ReadMany }}, error) {
+	f.readManyInput = input
+	return f.readManyOutput, nil
+}
+{{- end }}
+{{- end }}
+{{- if SDKTestable .CRD "GetAttributes" }}
+
+func (f *fakeSDKAPI) {{ .CRD.Ops.GetAttributes.ExportedName }}WithContext(
+	_ aws.Context,
+	input *svcsdk.{{ .CRD.Ops.GetAttributes.InputRef.Shape.ShapeName }},
+	_ ...request.Option,
+) ({{ .CRD.GetOutputShapeGoType .CRD.Ops.GetAttributes }}, error) {
+	f.getAttributesInput = input
+	return f.getAttributesOutput, nil
+}
+{{- end }}
+{{- if SDKTestable .CRD "Update" }}
+
+func (f *fakeSDKAPI) {{ .CRD.Ops.Update.ExportedName }}WithContext(
+	_ aws.Context,
+	input *svcsdk.{{ .CRD.Ops.Update.InputRef.Shape.ShapeName }},
+	_ ...request.Option,
+) ({{ .CRD.GetOutputShapeGoType .CRD.Ops.Update }}, error) {
+	f.updateInput = input
+	return f.updateOutput, nil
+}
+{{- end }}
+
+// newTestResourceManager returns a resource manager that calls the supplied
+// fake API client
+func newTestResourceManager(api *fakeSDKAPI) *resourceManager {
+	return &resourceManager{
+		metrics:      ackmetrics.NewMetrics("{{ .ServiceIDClean }}"),
+		awsAccountID: testAccountID,
+		awsRegion:    testRegion,
+		sdkapi:       api,
+	}
+}
+
+// newTestResource returns a resource whose scalar fields are set to test
+// values derived from the supplied seed
+func newTestResource(seed int) *resource {
+	ko := &svcapitypes.{{ .CRD.Names.Camel }}{}
+	switch seed {
+	case 1:
+	{{- GoCodeSDKTestSetResource .CRD "ko" 1 2 }}
+	default:
+	{{- GoCodeSDKTestSetResource .CRD "ko" 2 2 }}
+	}
+	return &resource{ko}
+}
+{{- if SDKTestable .CRD "Create" }}
+
+func TestSDKCreate(t *testing.T) {
+	resp := &svcsdk.{{ .CRD.Ops.Create.OutputRef.Shape.ShapeName }}{}
+	{{- GoCodeSDKTestSetOutput .CRD "Create" "resp" 2 1 }}
+	api := &fakeSDKAPI{createOutput: resp}
+	rm := newTestResourceManager(api)
+	r := newTestResource(1)
+
+	created, err := rm.sdkCreate(context.Background(), r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if api.createInput == nil {
+		t.Fatalf("{{ .CRD.Ops.Create.ExportedName }} was not called")
+	}
+	input := api.createInput
+	ko := created.ko
+	_, _ = input, ko
+
+	t.Run("spec to input", func(t *testing.T) {
+		checkSDKTestCases(t, []sdkTestCase{
+			{{- GoCodeSDKTestInputCases .CRD "Create" "input" "r.ko" 3 }}
+		})
+	})
+	t.Run("output to resource", func(t *testing.T) {
+		checkSDKTestCases(t, []sdkTestCase{
+			{{- GoCodeSDKTestOutputCases .CRD "Create" "resp" "ko" 3 }}
+		})
+	})
+}
+{{- end }}
+{{- if SDKTestable .CRD "Get" }}
+
+func TestSDKFind(t *testing.T) {
+	resp := &svcsdk.{{ .CRD.Ops.ReadOne.OutputRef.Shape.ShapeName }}{}
+	{{- GoCodeSDKTestSetOutput .CRD "Get" "resp" 2 1 }}
+	api := &fakeSDKAPI{readOneOutput: resp}
+	rm := newTestResourceManager(api)
+	r := newTestResource(1)
+
+	latest, err := rm.sdkFind(context.Background(), r)
+	if err == ackerr.NotFound {
+		t.Skip("the test resource lacks required fields of the ReadOne input")
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if api.readOneInput == nil {
+		t.Fatalf("{{ .CRD.Ops.ReadOne.ExportedName }} was not called")
+	}
+	input := api.readOneInput
+	ko := latest.ko
+	_, _ = input, ko
+
+	t.Run("spec to input", func(t *testing.T) {
+		checkSDKTestCases(t, []sdkTestCase{
+			{{- GoCodeSDKTestInputCases .CRD "Get" "input" "r.ko" 3 }}
+		})
+	})
+	t.Run("output to resource", func(t *testing.T) {
+		checkSDKTestCases(t, []sdkTestCase{
+			{{- GoCodeSDKTestOutputCases .CRD "Get" "resp" "ko" 3 }}
+		})
+	})
+}
+{{- end }}
+{{- if SDKTestable .CRD "List" }}
+
+func TestSDKFind(t *testing.T) {
+	r := newTestResource(1)
+	resp := &svcsdk.{{ .CRD.Ops.ReadMany.OutputRef.Shape.ShapeName }}{}
+	{{- GoCodeSDKTestSetOutput .CRD "List" "resp" 2 1 }}
+{{- if .CRD.ListOpMatchFieldNames }}
+	// sdkFind looks for the resource among the results by these fields
+	{{- GoCodeSDKTestSetReadManyMatchFields .CRD "resp" "r.ko" 1 }}
+{{- end }}
+	api := &fakeSDKAPI{readManyOutput: resp}
+	rm := newTestResourceManager(api)
+
+	latest, err := rm.sdkFind(context.Background(), r)
+	if err == ackerr.NotFound {
+		t.Skip("the test resource lacks required fields of the ReadMany input")
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if api.readManyInput == nil {
+		t.Fatalf("{{ .CRD.Ops.ReadMany.ExportedName }} was not called")
+	}
+	input := api.readManyInput
+	ko := latest.ko
+	_, _ = input, ko
+
+	t.Run("spec to input", func(t *testing.T) {
+		checkSDKTestCases(t, []sdkTestCase{
+			{{- GoCodeSDKTestInputCases .CRD "List" "input" "r.ko" 3 }}
+		})
+	})
+	t.Run("output to resource", func(t *testing.T) {
+		checkSDKTestCases(t, []sdkTestCase{
+			{{- GoCodeSDKTestOutputCases .CRD "List" "resp" "ko" 3 }}
+		})
+	})
+}
+{{- end }}
+{{- if SDKTestable .CRD "GetAttributes" }}
+
+func TestSDKFind(t *testing.T) {
+	resp := &svcsdk.{{ .CRD.Ops.GetAttributes.OutputRef.Shape.ShapeName }}{}
+	{{- GoCodeSDKTestSetOutput .CRD "GetAttributes" "resp" 2 1 }}
+	api := &fakeSDKAPI{getAttributesOutput: resp}
+	rm := newTestResourceManager(api)
+	r := newTestResource(1)
+
+	latest, err := rm.sdkFind(context.Background(), r)
+	if err == ackerr.NotFound {
+		t.Skip("the test resource lacks required fields of the GetAttributes input")
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if api.getAttributesInput == nil {
+		t.Fatalf("{{ .CRD.Ops.GetAttributes.ExportedName }} was not called")
+	}
+	input := api.getAttributesInput
+	ko := latest.ko
+	_, _ = input, ko
+
+	t.Run("spec to input", func(t *testing.T) {
+		checkSDKTestCases(t, []sdkTestCase{
+			{{- GoCodeSDKTestInputCases .CRD "GetAttributes" "input" "r.ko" 3 }}
+		})
+	})
+	t.Run("output to resource", func(t *testing.T) {
+		checkSDKTestCases(t, []sdkTestCase{
+			{{- GoCodeSDKTestOutputCases .CRD "GetAttributes" "resp" "ko" 3 }}
+		})
+	})
+}
+{{- end }}
+{{- if SDKTestable .CRD "Update" }}
+
+func TestSDKUpdate(t *testing.T) {
+	resp := &svcsdk.{{ .CRD.Ops.Update.OutputRef.Shape.ShapeName }}{}
+	{{- GoCodeSDKTestSetOutput .CRD "Update" "resp" 2 1 }}
+	api := &fakeSDKAPI{updateOutput: resp}
+	rm := newTestResourceManager(api)
+	latest := newTestResource(1)
+	desired := newTestResource(2)
+	delta := newResourceDelta(desired, latest)
+
+	if _, err := rm.sdkUpdate(context.Background(), desired, latest, delta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if api.updateInput == nil {
+		t.Fatalf("{{ .CRD.Ops.Update.ExportedName }} was not called")
+	}
+	input := api.updateInput
+	_ = input
+
+	cases := []sdkTestCase{
+		{{- GoCodeSDKTestInputCases .CRD "Update" "input" "desired.ko" 2 }}
+	}
+	t.Run("delta", func(t *testing.T) {
+		for _, tc := range cases {
+			if strings.HasPrefix(tc.path, "Spec.") && !delta.DifferentAt(tc.path) {
+				t.Errorf("%s: expected a difference between desired and latest", tc.path)
+			}
+		}
+	})
+	t.Run("desired to input", func(t *testing.T) {
+		checkSDKTestCases(t, cases)
+	})
+}
+{{- end }}
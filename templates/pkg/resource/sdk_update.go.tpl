@@ -32,6 +32,9 @@ func (rm *resourceManager) sdkUpdate(
 {{ $hookCode }}
 {{- end }}
 	rm.metrics.RecordAPICall("UPDATE", "{{ .CRD.Ops.Update.ExportedName }}", err)
+{{- if .CRD.ExceptionRules }}
+	err = rm.classifyAWSError(desired, "{{ .CRD.Ops.Update.ExportedName }}", err)
+{{- end }}
 	if err != nil {
 		return nil, err
 	}
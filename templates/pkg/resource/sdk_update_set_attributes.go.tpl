@@ -23,6 +23,9 @@ func (rm *resourceManager) sdkUpdate(
 	// that desired state has been constructed from a call to GetAttributes...
 	_, respErr := rm.sdkapi.{{ .CRD.Ops.SetAttributes.ExportedName }}WithContext(ctx, input)
 	rm.metrics.RecordAPICall("SET_ATTRIBUTES", "{{ .CRD.Ops.SetAttributes.ExportedName }}", respErr)
+{{- if .CRD.ExceptionRules }}
+	respErr = rm.classifyAWSError(desired, "{{ .CRD.Ops.SetAttributes.ExportedName }}", respErr)
+{{- end }}
 	if respErr != nil {
 		if awsErr, ok := ackerr.AWSError(respErr); ok && awsErr.Code() == "{{ ResourceExceptionCode .CRD 404 }}" {{ GoCodeSetExceptionMessageCheck .CRD 404 }}{
 			// Technically, this means someone deleted the backend resource in
@@ -0,0 +1,105 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"context"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+{{- template "type_override_imports" . }}
+
+	svcapitypes "github.com/aws-controllers-k8s/{{ .ServiceIDClean }}-controller/apis/{{ .APIVersion }}"
+)
+
+// Hack to avoid import errors during build...
+var (
+	_ = &metav1.Time{}
+	_ = &ackerr.NotFound
+	_ = &svcsdk.{{ .SDKAPIInterfaceTypeName }}{}
+	_ = &svcapitypes.{{ .CRD.Names.Camel }}{}
+{{- template "type_override_import_hacks" . }}
+)
+{{- range $sub := .CRD.SubResources }}
+{{- $fieldName := $sub.Field.Names.Camel }}
+
+// get{{ $fieldName }} sets the {{ $fieldName }} field of the supplied resource
+// from the {{ $sub.GetOp.Operation.ExportedName }} operation
+func (rm *resourceManager) get{{ $fieldName }}(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.get{{ $fieldName }}")
+	defer exit(err)
+{{ if $sub.GetOp.ParentMembers }}
+	if {{ $sub.GetOp.ParentIsNil "r.ko" }} {
+		return nil
+	}
+{{- end }}
+	input := &svcsdk.{{ $sub.GetOp.Operation.InputRef.Shape.ShapeName }}{}
+{{- range $member := $sub.GetOp.ParentMembers }}
+	input.Set{{ $member.MemberName }}(*{{ $member.VarPath "r.ko" }})
+{{- end }}
+	resp, err := rm.sdkapi.{{ $sub.GetOp.Operation.ExportedName }}WithContext(ctx, input)
+	rm.metrics.RecordAPICall("READ_ONE", "{{ $sub.GetOp.Operation.ExportedName }}", err)
+	if err != nil {
+{{- if $sub.UnsetErrorCodes }}
+		if awsErr, ok := ackerr.AWSError(err); ok && ({{ range $i, $code := $sub.UnsetErrorCodes }}{{ if $i }} || {{ end }}awsErr.Code() == "{{ $code }}"{{ end }}) {
+			r.ko.Spec.{{ $fieldName }} = nil
+			return nil
+		}
+{{- end }}
+		return err
+	}
+	ko := r.ko
+{{ GoCodeSetResourceSubResource $.CRD $sub "resp" "ko" 1 }}
+	return nil
+}
+
+// sync{{ $fieldName }} updates the {{ $fieldName }} of the resource to the
+// desired value, with the {{ $sub.PutOp.Operation.ExportedName }} operation
+{{- if $sub.DeleteOp }} or, when the desired
+// value is nil, with the {{ $sub.DeleteOp.Operation.ExportedName }} operation
+{{- end }}
+func (rm *resourceManager) sync{{ $fieldName }}(
+	ctx context.Context,
+	r *resource,
+	desired *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.sync{{ $fieldName }}")
+	defer exit(err)
+{{ if $parentIsNil := $sub.ParentIsNil "r.ko" }}
+	if {{ $parentIsNil }} {
+		// The resource can't be identified yet, the sub-resource will be
+		// synchronized by a later reconciliation
+		return nil
+	}
+{{- end }}
+	if desired.ko.Spec.{{ $fieldName }} == nil {
+{{- with $op := $sub.DeleteOp }}
+		input := &svcsdk.{{ $op.Operation.InputRef.Shape.ShapeName }}{}
+{{- range $member := $op.ParentMembers }}
+		input.Set{{ $member.MemberName }}(*{{ $member.VarPath "r.ko" }})
+{{- end }}
+		_, err = rm.sdkapi.{{ $op.Operation.ExportedName }}WithContext(ctx, input)
+		rm.metrics.RecordAPICall("DELETE", "{{ $op.Operation.ExportedName }}", err)
+		return err
+{{- else }}
+		// The sub-resource can't be removed
+		return nil
+{{- end }}
+	}
+	input := &svcsdk.{{ $sub.PutOp.Operation.InputRef.Shape.ShapeName }}{}
+{{- range $member := $sub.PutOp.ParentMembers }}
+	input.Set{{ $member.MemberName }}(*{{ $member.VarPath "r.ko" }})
+{{- end }}
+{{ GoCodeSetSDKSubResource $.CRD $sub "desired.ko" "input" 1 }}
+	_, err = rm.sdkapi.{{ $sub.PutOp.Operation.ExportedName }}WithContext(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "{{ $sub.PutOp.Operation.ExportedName }}", err)
+	return err
+}
+{{- end }}
@@ -0,0 +1,164 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+import (
+	"context"
+	"sort"
+
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/{{ .ServiceIDClean }}"
+)
+{{- $tagging := .CRD.Tagging }}
+{{- $tagsField := $tagging.Field.Names.Camel }}
+
+// Hack to avoid import errors during build...
+var (
+	_ = context.Background
+	_ = ackrtlog.FromContext
+	_ = &svcsdk.{{ .SDKAPIInterfaceTypeName }}{}
+)
+{{- if eq $tagging.Format "list" }}
+
+// toACKTags converts the tags returned by the {{ .SDKAPIInterfaceTypeName }} API
+// into the tags of the Spec
+func toACKTags(tags []*svcsdk.{{ $tagging.TagShapeName }}) map[string]*string {
+	if tags == nil {
+		return nil
+	}
+	res := map[string]*string{}
+	for _, tag := range tags {
+		if tag == nil || tag.{{ $tagging.KeyMemberName }} == nil {
+			continue
+		}
+		res[*tag.{{ $tagging.KeyMemberName }}] = tag.{{ $tagging.ValueMemberName }}
+	}
+	return res
+}
+
+// fromACKTags converts the tags of the Spec into the tags expected by the
+// {{ .SDKAPIInterfaceTypeName }} API, sorted by key
+func fromACKTags(tags map[string]*string) []*svcsdk.{{ $tagging.TagShapeName }} {
+	if tags == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	res := make([]*svcsdk.{{ $tagging.TagShapeName }}, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, &svcsdk.{{ $tagging.TagShapeName }}{
+			{{ $tagging.KeyMemberName }}: aws.String(key),
+			{{ $tagging.ValueMemberName }}: tags[key],
+		})
+	}
+	return res
+}
+{{- end }}
+
+// computeTagsDelta returns the tags to add or overwrite and the keys of the
+// tags to remove so that the latest tags match the desired ones
+func computeTagsDelta(
+	desired map[string]*string,
+	latest map[string]*string,
+) (toAdd map[string]*string, toRemove []*string) {
+	toAdd = map[string]*string{}
+	for key, value := range desired {
+		latestValue, found := latest[key]
+		if !found || aws.StringValue(latestValue) != aws.StringValue(value) {
+			toAdd[key] = value
+		}
+	}
+	keys := []string{}
+	for key := range latest {
+		if _, found := desired[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		toRemove = append(toRemove, aws.String(key))
+	}
+	return toAdd, toRemove
+}
+
+{{- if or $tagging.TagOp $tagging.UntagOp }}
+
+// syncTags updates the tags of the resource in the backend AWS service API
+// so that they match the desired tags
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTags")
+	defer exit(err)
+
+	toAdd, toRemove := computeTagsDelta(
+		desired.ko.Spec.{{ $tagsField }}, latest.ko.Spec.{{ $tagsField }},
+	)
+{{- if $op := $tagging.UntagOp }}
+	if len(toRemove) > 0 && !({{ $op.ResourceVarIsNil "latest.ko" }}) {
+		input := &svcsdk.{{ $op.Operation.InputRef.Shape.ShapeName }}{}
+		input.Set{{ $op.ResourceMemberName }}(*{{ $op.ResourceVarPath "latest.ko" }})
+		input.Set{{ $op.TagsMemberName }}(toRemove)
+		_, err = rm.sdkapi.{{ $op.Operation.ExportedName }}WithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "{{ $op.Operation.ExportedName }}", err)
+		if err != nil {
+			return err
+		}
+	}
+{{- end }}
+{{- if $op := $tagging.TagOp }}
+	if len(toAdd) > 0 && !({{ $op.ResourceVarIsNil "latest.ko" }}) {
+		input := &svcsdk.{{ $op.Operation.InputRef.Shape.ShapeName }}{}
+		input.Set{{ $op.ResourceMemberName }}(*{{ $op.ResourceVarPath "latest.ko" }})
+{{- if eq $op.Format "list" }}
+		input.Set{{ $op.TagsMemberName }}(fromACKTags(toAdd))
+{{- else }}
+		input.Set{{ $op.TagsMemberName }}(toAdd)
+{{- end }}
+		_, err = rm.sdkapi.{{ $op.Operation.ExportedName }}WithContext(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "{{ $op.Operation.ExportedName }}", err)
+		if err != nil {
+			return err
+		}
+	}
+{{- end }}
+	return nil
+}
+{{- end }}
+{{- if $op := $tagging.ListTagsOp }}
+
+// getTags returns the tags of the resource in the backend AWS service API, or
+// the tags of the Spec when the resource can't be identified yet
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	r *resource,
+) (tags map[string]*string, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.getTags")
+	defer exit(err)
+
+	if {{ $op.ResourceVarIsNil "r.ko" }} {
+		return r.ko.Spec.{{ $tagsField }}, nil
+	}
+	input := &svcsdk.{{ $op.Operation.InputRef.Shape.ShapeName }}{}
+	input.Set{{ $op.ResourceMemberName }}(*{{ $op.ResourceVarPath "r.ko" }})
+	resp, err := rm.sdkapi.{{ $op.Operation.ExportedName }}WithContext(ctx, input)
+	rm.metrics.RecordAPICall("READ_ONE", "{{ $op.Operation.ExportedName }}", err)
+	if err != nil {
+		return nil, err
+	}
+{{- if eq $op.Format "list" }}
+	return toACKTags(resp.{{ $op.TagsMemberName }}), nil
+{{- else }}
+	return resp.{{ $op.TagsMemberName }}, nil
+{{- end }}
+}
+{{- end }}
@@ -0,0 +1,35 @@
+{{- define "type_override_imports" -}}
+{{- if .CRD.HasTypeOverride "custom" }}
+	"encoding/json"
+{{- end }}
+{{- if .CRD.HasTypeOverride "duration" }}
+	"time"
+{{- end }}
+{{- if .CRD.HasTypeOverride "json" }}
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+{{- end }}
+{{- if .CRD.HasTypeOverride "quantity" }}
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+{{- end }}
+{{- if .CRD.HasTypeOverride "int_or_string" }}
+	"k8s.io/apimachinery/pkg/util/intstr"
+{{- end }}
+{{- end -}}
+
+{{- define "type_override_import_hacks" -}}
+{{- if .CRD.HasTypeOverride "custom" }}
+	_ = json.Marshal
+{{- end }}
+{{- if .CRD.HasTypeOverride "duration" }}
+	_ = time.Second
+{{- end }}
+{{- if .CRD.HasTypeOverride "json" }}
+	_ = &apiextensionsv1.JSON{}
+{{- end }}
+{{- if .CRD.HasTypeOverride "quantity" }}
+	_ = k8sresource.DecimalSI
+{{- end }}
+{{- if .CRD.HasTypeOverride "int_or_string" }}
+	_ = intstr.Int
+{{- end }}
+{{- end -}}